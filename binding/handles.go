@@ -0,0 +1,145 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/gnames/gnfmt"
+	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/ent/parsed"
+)
+
+// errHandle is returned when a handle does not exist or was destroyed.
+var errHandle = errors.New("unknown parser handle")
+
+// handleOptions are settings of a parser handle. They are the same as
+// options of REST API with addition of the number of parsers in the pool.
+type handleOptions struct {
+	gnparser.RequestOptions
+
+	// JobsNum is the number of parsers in the pool of a handle. It limits
+	// how many threads can parse with the handle at the same time. It also
+	// sets the number of concurrent jobs for parsing of many names.
+	JobsNum int `json:"jobsNum,omitempty"`
+}
+
+// parserHandle keeps a pool of parsers with the same settings. Every
+// parser has its own parsing engine, so each of them can be used by only
+// one thread at a time.
+type parserHandle struct {
+	pool chan gnparser.GNparser
+	cfg  gnparser.Config
+}
+
+// handles is a registry of parser handles. C code gets an ID of a handle,
+// not a Go pointer.
+var handles = struct {
+	sync.RWMutex
+	last int64
+	byID map[int64]*parserHandle
+}{byID: make(map[int64]*parserHandle)}
+
+// newHandle creates a parser handle from options encoded as a JSON object.
+// An empty string means default options. The default format is CSV.
+func newHandle(optsJSON string) (int64, error) {
+	var ho handleOptions
+	if strings.TrimSpace(optsJSON) != "" {
+		dec := json.NewDecoder(bytes.NewReader([]byte(optsJSON)))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&ho); err != nil {
+			return 0, fmt.Errorf("cannot decode options: %w", err)
+		}
+	}
+
+	opts, err := ho.Options()
+	if err != nil {
+		return 0, err
+	}
+	opts = append([]gnparser.Option{gnparser.OptFormat(gnfmt.CSV)}, opts...)
+	if ho.JobsNum > 0 {
+		opts = append(opts, gnparser.OptJobsNum(ho.JobsNum))
+	}
+	cfg := gnparser.NewConfig(opts...)
+
+	h := parserHandle{
+		pool: gnparser.NewPool(cfg, cfg.JobsNum),
+		cfg:  cfg,
+	}
+
+	handles.Lock()
+	defer handles.Unlock()
+	handles.last++
+	handles.byID[handles.last] = &h
+	return handles.last, nil
+}
+
+// getHandle returns a parser handle by its ID.
+func getHandle(id int64) (*parserHandle, error) {
+	handles.RLock()
+	defer handles.RUnlock()
+	h, ok := handles.byID[id]
+	if !ok {
+		return nil, fmt.Errorf("%w: %d", errHandle, id)
+	}
+	return h, nil
+}
+
+// destroyHandle removes a parser handle from the registry. Parsers that
+// are in use at the moment finish their work normally.
+func destroyHandle(id int64) error {
+	handles.Lock()
+	defer handles.Unlock()
+	if _, ok := handles.byID[id]; !ok {
+		return fmt.Errorf("%w: %d", errHandle, id)
+	}
+	delete(handles.byID, id)
+	return nil
+}
+
+// parseName parses one name-string with a parser from the pool.
+func (h *parserHandle) parseName(name string) string {
+	gnp := <-h.pool
+	defer func() { h.pool <- gnp }()
+	return gnp.ParseName(name).Output(h.cfg.Format, h.cfg.WithFlatOutput)
+}
+
+// parseNames parses many name-strings. CSV and TSV results are separated
+// by new lines, JSON results are returned as an array.
+func (h *parserHandle) parseNames(names []string) (string, error) {
+	gnp := <-h.pool
+	defer func() { h.pool <- gnp }()
+	res := gnp.ParseNames(names)
+	return outputNames(res, h.cfg)
+}
+
+func outputNames(res []parsed.Parsed, cfg gnparser.Config) (string, error) {
+	switch cfg.Format {
+	case gnfmt.CSV, gnfmt.TSV:
+		out := make([]string, len(res))
+		for i := range res {
+			out[i] = res[i].Output(cfg.Format, cfg.WithFlatOutput)
+		}
+		return strings.Join(out, "\n"), nil
+	}
+
+	enc := gnfmt.GNjson{Pretty: cfg.Format == gnfmt.PrettyJSON}
+	var out []byte
+	var err error
+	if cfg.WithFlatOutput {
+		flat := make([]parsed.ParsedFlat, len(res))
+		for i := range res {
+			flat[i] = res[i].Flatten()
+		}
+		out, err = enc.Encode(flat)
+	} else {
+		out, err = enc.Encode(res)
+	}
+	if err != nil {
+		return "", fmt.Errorf("cannot encode results: %w", err)
+	}
+	return string(out), nil
+}
@@ -0,0 +1,171 @@
+package main
+
+import (
+	"encoding/json"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gnames/gnfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewHandle(t *testing.T) {
+	assert := assert.New(t)
+	tests := []struct {
+		msg, opts string
+		format    gnfmt.Format
+		jobs      int
+		err       bool
+	}{
+		{"default", "", gnfmt.CSV, 0, false},
+		{"spaces", "  ", gnfmt.CSV, 0, false},
+		{"format", `{"format":"compact"}`, gnfmt.CompactJSON, 0, false},
+		{"jobs", `{"format":"tsv","jobsNum":2}`, gnfmt.TSV, 2, false},
+		{"bad json", `{"format":`, gnfmt.FormatNone, 0, true},
+		{"unknown field", `{"color":"red"}`, gnfmt.FormatNone, 0, true},
+		{"bad code", `{"code":"unknown"}`, gnfmt.FormatNone, 0, true},
+	}
+
+	for _, v := range tests {
+		id, err := newHandle(v.opts)
+		if v.err {
+			assert.NotNil(err, v.msg)
+			assert.Zero(id, v.msg)
+			continue
+		}
+		require.Nil(t, err, v.msg)
+		h, err := getHandle(id)
+		require.Nil(t, err, v.msg)
+		assert.Equal(v.format, h.cfg.Format, v.msg)
+		if v.jobs > 0 {
+			assert.Equal(v.jobs, h.cfg.JobsNum, v.msg)
+			assert.Equal(v.jobs, cap(h.pool), v.msg)
+		}
+		assert.Nil(destroyHandle(id), v.msg)
+	}
+
+	id1, err := newHandle("")
+	assert.Nil(err)
+	id2, err := newHandle("")
+	assert.Nil(err)
+	assert.NotEqual(id1, id2)
+	assert.Nil(destroyHandle(id1))
+	assert.Nil(destroyHandle(id2))
+}
+
+func TestParseHandle(t *testing.T) {
+	assert := assert.New(t)
+	id, err := newHandle(`{"format":"compact","withDetails":true}`)
+	require.Nil(t, err)
+	defer func() { _ = destroyHandle(id) }()
+	h, err := getHandle(id)
+	require.Nil(t, err)
+
+	var p struct {
+		Canonical struct{ Simple string }
+		Details   map[string]any
+	}
+	err = json.Unmarshal([]byte(h.parseName("Bubo bubo (L., 1758)")), &p)
+	assert.Nil(err)
+	assert.Equal("Bubo bubo", p.Canonical.Simple)
+	assert.NotNil(p.Details)
+
+	res, err := h.parseNames([]string{"Aus bus", "Cus dus"})
+	assert.Nil(err)
+	var ps []struct{ Verbatim string }
+	assert.Nil(json.Unmarshal([]byte(res), &ps))
+	assert.Len(ps, 2)
+	assert.Equal("Cus dus", ps[1].Verbatim)
+
+	id, err = newHandle(`{"format":"compact","flattenOutput":true}`)
+	require.Nil(t, err)
+	defer func() { _ = destroyHandle(id) }()
+	h, err = getHandle(id)
+	require.Nil(t, err)
+	res, err = h.parseNames([]string{"Aus bus"})
+	assert.Nil(err)
+	var flat []struct{ CanonicalSimple string }
+	assert.Nil(json.Unmarshal([]byte(res), &flat))
+	assert.Equal("Aus bus", flat[0].CanonicalSimple)
+
+	id, err = newHandle("")
+	require.Nil(t, err)
+	defer func() { _ = destroyHandle(id) }()
+	h, err = getHandle(id)
+	require.Nil(t, err)
+	csv := h.parseName("Aus bus")
+	assert.Contains(csv, "Aus bus")
+	res, err = h.parseNames([]string{"Aus bus", "Cus dus"})
+	assert.Nil(err)
+	assert.Len(strings.Split(res, "\n"), 2)
+}
+
+func TestDestroyHandle(t *testing.T) {
+	assert := assert.New(t)
+	id, err := newHandle("")
+	require.Nil(t, err)
+	assert.Nil(destroyHandle(id))
+
+	_, err = getHandle(id)
+	assert.ErrorIs(err, errHandle)
+	assert.ErrorIs(destroyHandle(id), errHandle)
+
+	_, err = getHandle(-1)
+	assert.ErrorIs(err, errHandle)
+	assert.ErrorIs(destroyHandle(0), errHandle)
+}
+
+// TestHandleConcurrent uses one handle from many goroutines. Run it with
+// -race flag (`just test` does it).
+func TestHandleConcurrent(t *testing.T) {
+	assert := assert.New(t)
+	id, err := newHandle(`{"jobsNum":2}`)
+	require.Nil(t, err)
+	h, err := getHandle(id)
+	require.Nil(t, err)
+
+	names := []string{"Aus bus L.", "Cus dus var. eus", "Bubo bubo (L., 1758)"}
+	want := make([]string, len(names))
+	for i := range names {
+		want[i] = h.parseName(names[i])
+	}
+	wantBatch, err := h.parseNames(names)
+	require.Nil(t, err)
+
+	var wg sync.WaitGroup
+	errs := make(chan string, 16)
+	for i := range 8 {
+		wg.Go(func() {
+			h, err := getHandle(id)
+			if err != nil {
+				errs <- err.Error()
+				return
+			}
+			for j := range 50 {
+				k := (i + j) % len(names)
+				if res := h.parseName(names[k]); res != want[k] {
+					errs <- res
+				}
+			}
+			if res, _ := h.parseNames(names); res != wantBatch {
+				errs <- res
+			}
+		})
+	}
+	wg.Wait()
+	close(errs)
+	for e := range errs {
+		assert.Fail("unexpected result", e)
+	}
+
+	// A parser that is in use finishes its work after the handle is
+	// destroyed.
+	done := make(chan string)
+	go func() { done <- h.parseName(names[0]) }()
+	assert.Nil(destroyHandle(id))
+	assert.Equal(want[0], <-done)
+	_, err = getHandle(id)
+	assert.ErrorIs(err, errHandle)
+}
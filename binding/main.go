@@ -4,10 +4,20 @@ package main
 
 /*
   #include "stdlib.h"
+
+  // Error codes returned by functions that use parser handles.
+  enum {
+    GNP_OK = 0,           // success
+    GNP_ERR_OPTIONS = 1,  // options cannot be decoded or are invalid
+    GNP_ERR_HANDLE = 2,   // parser handle does not exist or is destroyed
+    GNP_ERR_ARGUMENT = 3, // required pointer is NULL or length is negative
+    GNP_ERR_OUTPUT = 4    // results cannot be encoded
+  };
 */
 import "C"
 
 import (
+	"errors"
 	"strings"
 	"unsafe"
 
@@ -93,15 +103,7 @@ func ParseAryToString(
 		gnparser.OptWithCompactAuthors(int(compactAuthors) > 0),
 		gnparser.OptWithFlatOutput(int(flatten) > 0),
 	}
-	start := unsafe.Pointer(in)
-	pointerSize := unsafe.Sizeof(in)
-
-	for i := 0; i < int(length); i++ {
-		// Copy each input string into a Go string and add it to the slice.
-		pointer := (**C.char)(unsafe.Pointer(uintptr(start) + uintptr(i)*pointerSize))
-		name := C.GoString(*pointer)
-		names[i] = name
-	}
+	copy(names, goStrings(in, length))
 
 	cfg := gnparser.NewConfig(opts...)
 	gnp := gnparser.New(cfg)
@@ -121,4 +123,123 @@ func ParseAryToString(
 	return C.CString(res)
 }
 
+// NewParser creates a parser handle. Options are given as a JSON object
+// with the same fields as options of REST API POST requests, and an
+// additional 'jobsNum' field that sets how many threads can use the handle
+// at the same time. NULL or an empty string mean default options with CSV
+// output. The handle is written to the handle argument.
+//
+// The function returns GNP_OK on success, or an error code. In case of an
+// error the message is written to errMsg, it has to be freed with
+// FreeMemory.
+//
+//export NewParser
+func NewParser(options *C.char, handle *C.longlong, errMsg **C.char) C.int {
+	if handle == nil {
+		return setError(errMsg, C.GNP_ERR_ARGUMENT, errors.New("handle is NULL"))
+	}
+	var opts string
+	if options != nil {
+		opts = C.GoString(options)
+	}
+	id, err := newHandle(opts)
+	if err != nil {
+		return setError(errMsg, C.GNP_ERR_OPTIONS, err)
+	}
+	*handle = C.longlong(id)
+	return setOK(errMsg)
+}
+
+// ParseName parses a name-string with a parser handle. The output is
+// written to result in the format given by the handle options, it has to
+// be freed with FreeMemory. The function is safe to call from several
+// threads with the same handle.
+//
+//export ParseName
+func ParseName(
+	handle C.longlong,
+	name *C.char,
+	result **C.char,
+	errMsg **C.char,
+) C.int {
+	if name == nil || result == nil {
+		return setError(errMsg, C.GNP_ERR_ARGUMENT,
+			errors.New("name or result is NULL"))
+	}
+	h, err := getHandle(int64(handle))
+	if err != nil {
+		return setError(errMsg, C.GNP_ERR_HANDLE, err)
+	}
+	*result = C.CString(h.parseName(C.GoString(name)))
+	return setOK(errMsg)
+}
+
+// ParseNames parses an array of name-strings with a parser handle. CSV and
+// TSV results are separated by new lines, JSON results are encoded as an
+// array. The output is written to result, it has to be freed with
+// FreeMemory. The function is safe to call from several threads with the
+// same handle.
+//
+//export ParseNames
+func ParseNames(
+	handle C.longlong,
+	names **C.char,
+	length C.int,
+	result **C.char,
+	errMsg **C.char,
+) C.int {
+	if result == nil || length < 0 || (names == nil && length > 0) {
+		return setError(errMsg, C.GNP_ERR_ARGUMENT,
+			errors.New("names or result is NULL, or length is negative"))
+	}
+	h, err := getHandle(int64(handle))
+	if err != nil {
+		return setError(errMsg, C.GNP_ERR_HANDLE, err)
+	}
+	res, err := h.parseNames(goStrings(names, length))
+	if err != nil {
+		return setError(errMsg, C.GNP_ERR_OUTPUT, err)
+	}
+	*result = C.CString(res)
+	return setOK(errMsg)
+}
+
+// DestroyParser releases a parser handle. The handle cannot be used after
+// that.
+//
+//export DestroyParser
+func DestroyParser(handle C.longlong, errMsg **C.char) C.int {
+	if err := destroyHandle(int64(handle)); err != nil {
+		return setError(errMsg, C.GNP_ERR_HANDLE, err)
+	}
+	return setOK(errMsg)
+}
+
+// goStrings copies an array of C strings to a slice of Go strings.
+func goStrings(in **C.char, length C.int) []string {
+	if length <= 0 {
+		return nil
+	}
+	ptrs := unsafe.Slice(in, int(length))
+	res := make([]string, len(ptrs))
+	for i := range ptrs {
+		res[i] = C.GoString(ptrs[i])
+	}
+	return res
+}
+
+func setError(errMsg **C.char, code C.int, err error) C.int {
+	if errMsg != nil {
+		*errMsg = C.CString(err.Error())
+	}
+	return code
+}
+
+func setOK(errMsg **C.char) C.int {
+	if errMsg != nil {
+		*errMsg = nil
+	}
+	return C.GNP_OK
+}
+
 func main() {}
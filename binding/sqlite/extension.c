@@ -0,0 +1,342 @@
+//go:build sqlite
+
+// SQLite part of the extension. It registers SQL functions and the
+// gnparse_words table-valued function, parsing is done by Go functions
+// from main.go.
+#include <stdlib.h>
+#include <string.h>
+
+#include <sqlite3ext.h>
+SQLITE_EXTENSION_INIT1
+
+#include "_cgo_export.h"
+#include "extension.h"
+
+// Kinds of canonical forms, the same as in main.go.
+enum { CANONICAL_SIMPLE = 0, CANONICAL_FULL = 1, CANONICAL_STEMMED = 2 };
+
+// gnp_conn keeps ID of a Go parser of a connection. All functions of the
+// connection share it, the parser is closed when the last of them is
+// destroyed.
+typedef struct {
+  long long id;
+  int refs;
+} gnp_conn;
+
+static gnp_conn *gnp_conn_ref(gnp_conn *c) {
+  c->refs++;
+  return c;
+}
+
+static void gnp_conn_unref(void *p) {
+  gnp_conn *c = (gnp_conn *)p;
+  if (--c->refs > 0) {
+    return;
+  }
+  gnpsqlClose(c->id);
+  free(c);
+}
+
+void gnp_words_free(gnp_word *words, int count) {
+  for (int i = 0; i < count; i++) {
+    free(words[i].verbatim);
+    free(words[i].normalized);
+    free(words[i].type);
+  }
+  free(words);
+}
+
+// name_arg returns a name-string argument, or NULL for NULL values.
+static char *name_arg(sqlite3_value *v, int *len) {
+  if (sqlite3_value_type(v) == SQLITE_NULL) {
+    return NULL;
+  }
+  char *name = (char *)sqlite3_value_text(v);
+  *len = sqlite3_value_bytes(v);
+  return name;
+}
+
+static void canonical(sqlite3_context *ctx, sqlite3_value **argv, int kind) {
+  gnp_conn *c = (gnp_conn *)sqlite3_user_data(ctx);
+  int len;
+  char *name = name_arg(argv[0], &len);
+  char *res;
+  if (name == NULL || !gnpsqlCanonical(c->id, name, len, kind, &res)) {
+    sqlite3_result_null(ctx);
+    return;
+  }
+  sqlite3_result_text(ctx, res, -1, free);
+}
+
+// gnparse_canonical(name) returns the simple canonical form.
+static void canonical_func(sqlite3_context *ctx, int argc,
+                           sqlite3_value **argv) {
+  canonical(ctx, argv, CANONICAL_SIMPLE);
+}
+
+// gnparse_canonical_full(name) returns the full canonical form with ranks
+// and hybrid signs.
+static void canonical_full_func(sqlite3_context *ctx, int argc,
+                                sqlite3_value **argv) {
+  canonical(ctx, argv, CANONICAL_FULL);
+}
+
+// gnparse_stem(name) returns the stemmed canonical form.
+static void stem_func(sqlite3_context *ctx, int argc, sqlite3_value **argv) {
+  canonical(ctx, argv, CANONICAL_STEMMED);
+}
+
+// gnparse_quality(name) returns parsing quality from 0 to 4.
+static void quality_func(sqlite3_context *ctx, int argc,
+                         sqlite3_value **argv) {
+  gnp_conn *c = (gnp_conn *)sqlite3_user_data(ctx);
+  int len;
+  char *name = name_arg(argv[0], &len);
+  if (name == NULL) {
+    sqlite3_result_null(ctx);
+    return;
+  }
+  sqlite3_result_int(ctx, gnpsqlQuality(c->id, name, len));
+}
+
+// gnparse_json(name[, options]) returns the parsing result as JSON.
+// Options are a JSON object with the same fields as options of REST API.
+static void json_func(sqlite3_context *ctx, int argc, sqlite3_value **argv) {
+  gnp_conn *c = (gnp_conn *)sqlite3_user_data(ctx);
+  int len;
+  char *name = name_arg(argv[0], &len);
+  if (name == NULL) {
+    sqlite3_result_null(ctx);
+    return;
+  }
+  // name can be invalidated by conversion of other arguments.
+  char *name_copy = malloc(len + 1);
+  memcpy(name_copy, name, len);
+  name_copy[len] = '\0';
+
+  char *opts = NULL;
+  if (argc > 1 && sqlite3_value_type(argv[1]) != SQLITE_NULL) {
+    opts = (char *)sqlite3_value_text(argv[1]);
+  }
+
+  char *res, *err;
+  int ok = gnpsqlJSON(c->id, name_copy, len, opts, &res, &err);
+  free(name_copy);
+  if (!ok) {
+    sqlite3_result_error(ctx, err, -1);
+    free(err);
+    return;
+  }
+  sqlite3_result_text(ctx, res, -1, free);
+}
+
+// gnparse_words(name) is a table-valued function that returns words of
+// a parsed name-string.
+
+enum {
+  WORDS_VERBATIM,
+  WORDS_NORMALIZED,
+  WORDS_TYPE,
+  WORDS_START,
+  WORDS_END,
+  WORDS_NAME
+};
+
+typedef struct {
+  sqlite3_vtab base;
+  gnp_conn *conn;
+} words_vtab;
+
+typedef struct {
+  sqlite3_vtab_cursor base;
+  gnp_word *words;
+  int count;
+  int row;
+} words_cursor;
+
+static int words_connect(sqlite3 *db, void *aux, int argc,
+                         const char *const *argv, sqlite3_vtab **vtab,
+                         char **err) {
+  int rc = sqlite3_declare_vtab(
+      db, "CREATE TABLE x(verbatim TEXT, normalized TEXT, type TEXT, "
+          "start INTEGER, end INTEGER, name HIDDEN)");
+  if (rc != SQLITE_OK) {
+    return rc;
+  }
+  words_vtab *v = sqlite3_malloc(sizeof(*v));
+  if (v == NULL) {
+    return SQLITE_NOMEM;
+  }
+  memset(v, 0, sizeof(*v));
+  v->conn = (gnp_conn *)aux;
+  sqlite3_vtab_config(db, SQLITE_VTAB_INNOCUOUS);
+  *vtab = &v->base;
+  return SQLITE_OK;
+}
+
+static int words_disconnect(sqlite3_vtab *vtab) {
+  sqlite3_free(vtab);
+  return SQLITE_OK;
+}
+
+static int words_best_index(sqlite3_vtab *vtab, sqlite3_index_info *info) {
+  for (int i = 0; i < info->nConstraint; i++) {
+    const struct sqlite3_index_constraint *c = &info->aConstraint[i];
+    if (c->iColumn != WORDS_NAME || c->op != SQLITE_INDEX_CONSTRAINT_EQ) {
+      continue;
+    }
+    if (!c->usable) {
+      return SQLITE_CONSTRAINT;
+    }
+    info->aConstraintUsage[i].argvIndex = 1;
+    info->aConstraintUsage[i].omit = 1;
+    info->estimatedCost = 10;
+    info->estimatedRows = 10;
+    return SQLITE_OK;
+  }
+  vtab->zErrMsg = sqlite3_mprintf("gnparse_words requires a name-string");
+  return SQLITE_ERROR;
+}
+
+static int words_open(sqlite3_vtab *vtab, sqlite3_vtab_cursor **cur) {
+  words_cursor *c = sqlite3_malloc(sizeof(*c));
+  if (c == NULL) {
+    return SQLITE_NOMEM;
+  }
+  memset(c, 0, sizeof(*c));
+  *cur = &c->base;
+  return SQLITE_OK;
+}
+
+static void words_reset(words_cursor *c) {
+  if (c->words != NULL) {
+    gnp_words_free(c->words, c->count);
+  }
+  c->words = NULL;
+  c->count = 0;
+  c->row = 0;
+}
+
+static int words_close(sqlite3_vtab_cursor *cur) {
+  words_reset((words_cursor *)cur);
+  sqlite3_free(cur);
+  return SQLITE_OK;
+}
+
+static int words_filter(sqlite3_vtab_cursor *cur, int idx, const char *idxStr,
+                        int argc, sqlite3_value **argv) {
+  words_cursor *c = (words_cursor *)cur;
+  words_vtab *v = (words_vtab *)cur->pVtab;
+  words_reset(c);
+  if (argc == 0) {
+    return SQLITE_OK;
+  }
+  int len;
+  char *name = name_arg(argv[0], &len);
+  if (name == NULL) {
+    return SQLITE_OK;
+  }
+  gnpsqlWords(v->conn->id, name, len, &c->words, &c->count);
+  return SQLITE_OK;
+}
+
+static int words_next(sqlite3_vtab_cursor *cur) {
+  ((words_cursor *)cur)->row++;
+  return SQLITE_OK;
+}
+
+static int words_eof(sqlite3_vtab_cursor *cur) {
+  words_cursor *c = (words_cursor *)cur;
+  return c->row >= c->count;
+}
+
+static int words_column(sqlite3_vtab_cursor *cur, sqlite3_context *ctx,
+                        int col) {
+  words_cursor *c = (words_cursor *)cur;
+  gnp_word *w = &c->words[c->row];
+  switch (col) {
+  case WORDS_VERBATIM:
+    sqlite3_result_text(ctx, w->verbatim, -1, SQLITE_TRANSIENT);
+    break;
+  case WORDS_NORMALIZED:
+    sqlite3_result_text(ctx, w->normalized, -1, SQLITE_TRANSIENT);
+    break;
+  case WORDS_TYPE:
+    sqlite3_result_text(ctx, w->type, -1, SQLITE_TRANSIENT);
+    break;
+  case WORDS_START:
+    sqlite3_result_int(ctx, w->start);
+    break;
+  case WORDS_END:
+    sqlite3_result_int(ctx, w->end);
+    break;
+  default:
+    sqlite3_result_null(ctx);
+  }
+  return SQLITE_OK;
+}
+
+static int words_rowid(sqlite3_vtab_cursor *cur, sqlite_int64 *rowid) {
+  *rowid = ((words_cursor *)cur)->row + 1;
+  return SQLITE_OK;
+}
+
+static sqlite3_module words_module = {
+    .iVersion = 0,
+    .xConnect = words_connect,
+    .xBestIndex = words_best_index,
+    .xDisconnect = words_disconnect,
+    .xOpen = words_open,
+    .xClose = words_close,
+    .xFilter = words_filter,
+    .xNext = words_next,
+    .xEof = words_eof,
+    .xColumn = words_column,
+    .xRowid = words_rowid,
+};
+
+static const struct {
+  const char *name;
+  int argc;
+  void (*fn)(sqlite3_context *, int, sqlite3_value **);
+} functions[] = {
+    {"gnparse_canonical", 1, canonical_func},
+    {"gnparse_canonical_full", 1, canonical_full_func},
+    {"gnparse_stem", 1, stem_func},
+    {"gnparse_quality", 1, quality_func},
+    {"gnparse_json", 1, json_func},
+    {"gnparse_json", 2, json_func},
+};
+
+// sqlite3_gnparsersqlite_init is the entry point of the extension, SQLite
+// finds it by the name of gnparser_sqlite library.
+#ifdef _WIN32
+__declspec(dllexport)
+#endif
+int sqlite3_gnparsersqlite_init(sqlite3 *db, char **err,
+                                const sqlite3_api_routines *api) {
+  SQLITE_EXTENSION_INIT2(api);
+
+  gnp_conn *c = malloc(sizeof(*c));
+  if (c == NULL) {
+    return SQLITE_NOMEM;
+  }
+  c->id = gnpsqlOpen();
+  // keeps the parser while functions are registered.
+  c->refs = 1;
+
+  int rc = SQLITE_OK;
+  int flags = SQLITE_UTF8 | SQLITE_DETERMINISTIC | SQLITE_INNOCUOUS;
+  int n = sizeof(functions) / sizeof(functions[0]);
+  for (int i = 0; i < n && rc == SQLITE_OK; i++) {
+    rc = sqlite3_create_function_v2(db, functions[i].name, functions[i].argc,
+                                    flags, gnp_conn_ref(c), functions[i].fn,
+                                    NULL, NULL, gnp_conn_unref);
+  }
+  if (rc == SQLITE_OK) {
+    rc = sqlite3_create_module_v2(db, "gnparse_words", &words_module,
+                                  gnp_conn_ref(c), gnp_conn_unref);
+  }
+  gnp_conn_unref(c);
+  return rc;
+}
@@ -0,0 +1,17 @@
+#ifndef GNPARSER_SQLITE_EXTENSION_H
+#define GNPARSER_SQLITE_EXTENSION_H
+
+// gnp_word describes one word of a parsed name-string. It mirrors
+// parsed.Word of GNparser.
+typedef struct {
+  char *verbatim;
+  char *normalized;
+  char *type;
+  int start;
+  int end;
+} gnp_word;
+
+// gnp_words_free releases memory of words created by gnpsqlWords.
+void gnp_words_free(gnp_word *words, int count);
+
+#endif
@@ -0,0 +1,249 @@
+//go:build sqlite
+
+// Package main provides SQLite loadable extension that exposes parsing of
+// scientific names as SQL functions. SQLite API is used only from C code
+// (extension.c), Go functions exported here do the parsing.
+package main
+
+/*
+  #include <stdlib.h>
+  #include "extension.h"
+*/
+import "C"
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"unsafe"
+
+	"github.com/gnames/gnfmt"
+	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/ent/parsed"
+)
+
+// Kinds of canonical forms returned by gnpsqlCanonical.
+const (
+	canonicalSimple = iota
+	canonicalFull
+	canonicalStemmed
+)
+
+// conn keeps a warm parser of one SQLite connection. SQLite serializes
+// calls of functions of a connection, but the mutex keeps the parser safe
+// if a connection is shared by threads in multi-thread mode.
+type conn struct {
+	sync.Mutex
+	gnp gnparser.GNparser
+
+	// last keeps the last parsed name-string and its result, so several
+	// functions called for the same name in a row parse it only once.
+	lastName string
+	last     *parsed.Parsed
+}
+
+// conns is a registry of connections, C code keeps their IDs.
+var conns = struct {
+	sync.Mutex
+	last int64
+	byID map[int64]*conn
+}{byID: make(map[int64]*conn)}
+
+// gnpsqlOpen creates a parser for a connection and returns its ID.
+//
+//export gnpsqlOpen
+func gnpsqlOpen() C.longlong {
+	cfg := gnparser.NewConfig(
+		gnparser.OptFormat(gnfmt.CompactJSON),
+		gnparser.OptWithDetails(true),
+		gnparser.OptJobsNum(1),
+	)
+	c := conn{gnp: gnparser.New(cfg)}
+
+	conns.Lock()
+	defer conns.Unlock()
+	conns.last++
+	conns.byID[conns.last] = &c
+	return C.longlong(conns.last)
+}
+
+// gnpsqlClose removes the parser of a connection. It is called after
+// SQLite destroys all functions of the extension.
+//
+//export gnpsqlClose
+func gnpsqlClose(id C.longlong) {
+	conns.Lock()
+	defer conns.Unlock()
+	delete(conns.byID, int64(id))
+}
+
+func getConn(id C.longlong) *conn {
+	conns.Lock()
+	defer conns.Unlock()
+	return conns.byID[int64(id)]
+}
+
+// parse returns parsing result of a name-string with default settings.
+func (c *conn) parse(name string) parsed.Parsed {
+	if c.last == nil || c.lastName != name {
+		res := c.gnp.ParseName(name)
+		c.lastName, c.last = name, &res
+	}
+	return *c.last
+}
+
+// gnpsqlCanonical writes a canonical form of a name-string to out. It
+// returns 0 if the name-string cannot be parsed.
+//
+//export gnpsqlCanonical
+func gnpsqlCanonical(
+	id C.longlong,
+	name *C.char,
+	l C.int,
+	kind C.int,
+	out **C.char,
+) C.int {
+	c := getConn(id)
+	if c == nil {
+		return 0
+	}
+	c.Lock()
+	defer c.Unlock()
+
+	res := c.parse(C.GoStringN(name, l))
+	if res.Canonical == nil {
+		return 0
+	}
+	var can string
+	switch kind {
+	case canonicalFull:
+		can = res.Canonical.Full
+	case canonicalStemmed:
+		can = res.Canonical.Stemmed
+	default:
+		can = res.Canonical.Simple
+	}
+	*out = C.CString(can)
+	return 1
+}
+
+// gnpsqlQuality returns parsing quality of a name-string (0 to 4).
+//
+//export gnpsqlQuality
+func gnpsqlQuality(id C.longlong, name *C.char, l C.int) C.int {
+	c := getConn(id)
+	if c == nil {
+		return 0
+	}
+	c.Lock()
+	defer c.Unlock()
+	return C.int(c.parse(C.GoStringN(name, l)).ParseQuality)
+}
+
+// gnpsqlJSON writes JSON output of a name-string to out. Options are
+// a JSON object with the same fields as options of REST API, or NULL.
+// It returns 0 and writes a message to errOut if options are invalid.
+//
+//export gnpsqlJSON
+func gnpsqlJSON(
+	id C.longlong,
+	name *C.char,
+	l C.int,
+	opts *C.char,
+	out **C.char,
+	errOut **C.char,
+) C.int {
+	c := getConn(id)
+	if c == nil {
+		*errOut = C.CString("gnparser connection is closed")
+		return 0
+	}
+	c.Lock()
+	defer c.Unlock()
+
+	var optsJSON string
+	if opts != nil {
+		optsJSON = C.GoString(opts)
+	}
+	gnp, err := withOptions(c.gnp, optsJSON)
+	if err != nil {
+		*errOut = C.CString(err.Error())
+		return 0
+	}
+	res := gnp.ParseName(C.GoStringN(name, l))
+	*out = C.CString(strings.TrimSpace(
+		res.Output(gnfmt.CompactJSON, gnp.WithFlatOutput()),
+	))
+	return 1
+}
+
+// gnpsqlWords writes words of a parsed name-string to a C array. The
+// array has to be released by gnp_words_free.
+//
+//export gnpsqlWords
+func gnpsqlWords(
+	id C.longlong,
+	name *C.char,
+	l C.int,
+	out **C.gnp_word,
+	count *C.int,
+) {
+	*out, *count = nil, 0
+	c := getConn(id)
+	if c == nil {
+		return
+	}
+	c.Lock()
+	defer c.Unlock()
+
+	words := c.parse(C.GoStringN(name, l)).Words
+	if len(words) == 0 {
+		return
+	}
+	size := C.size_t(len(words)) * C.size_t(unsafe.Sizeof(C.gnp_word{}))
+	ptr := (*C.gnp_word)(C.malloc(size))
+	res := unsafe.Slice(ptr, len(words))
+	for i, w := range words {
+		res[i] = C.gnp_word{
+			verbatim:   C.CString(w.Verbatim),
+			normalized: C.CString(w.Normalized),
+			_type:      C.CString(w.Type.String()),
+			start:      C.int(w.Start),
+			end:        C.int(w.End),
+		}
+	}
+	*out, *count = ptr, C.int(len(words))
+}
+
+// withOptions returns a copy of GNparser with settings from options
+// encoded as JSON. Empty string means default settings.
+func withOptions(
+	gnp gnparser.GNparser,
+	optsJSON string,
+) (gnparser.GNparser, error) {
+	var ro gnparser.RequestOptions
+	if strings.TrimSpace(optsJSON) != "" {
+		dec := json.NewDecoder(bytes.NewReader([]byte(optsJSON)))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&ro); err != nil {
+			return nil, fmt.Errorf("cannot decode options: %w", err)
+		}
+	}
+	switch ro.Format {
+	case "", "json", "compact", "pretty":
+	default:
+		return nil, fmt.Errorf("format '%s' is not supported, output is JSON", ro.Format)
+	}
+	if ro.CSV {
+		return nil, fmt.Errorf("csv is not supported, output is JSON")
+	}
+	opts, err := ro.Options()
+	if err != nil {
+		return nil, err
+	}
+	return gnp.ChangeConfig(opts...), nil
+}
+
+func main() {}
@@ -0,0 +1,141 @@
+//go:build sqlite
+
+package main
+
+import (
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gnames/gnparser"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithOptions(t *testing.T) {
+	assert := assert.New(t)
+	gnp := gnparser.New(gnparser.NewConfig())
+	tests := []struct {
+		msg, opts string
+		details   bool
+		flat      bool
+		err       string
+	}{
+		{"empty", "", false, false, ""},
+		{"spaces", " ", false, false, ""},
+		{"details", `{"withDetails":true}`, true, false, ""},
+		{"json", `{"format":"json","flattenOutput":true}`, false, true, ""},
+		{"pretty", `{"format":"pretty"}`, false, false, ""},
+		{"csv format", `{"format":"csv"}`, false, false, "not supported"},
+		{"csv", `{"csv":true}`, false, false, "not supported"},
+		{"bad json", `{"withDetails":`, false, false, "cannot decode"},
+		{"unknown field", `{"color":"red"}`, false, false, "cannot decode"},
+		{"bad code", `{"code":"unknown"}`, false, false, "unknown"},
+	}
+
+	for _, v := range tests {
+		res, err := withOptions(gnp, v.opts)
+		if v.err != "" {
+			assert.ErrorContains(err, v.err, v.msg)
+			assert.Nil(res, v.msg)
+			continue
+		}
+		require.Nil(t, err, v.msg)
+		p := res.ParseName("Aus bus L.")
+		assert.Equal(v.details, p.Details != nil, v.msg)
+		assert.Equal(v.flat, res.WithFlatOutput(), v.msg)
+	}
+
+	res, err := withOptions(gnp, `{"code":"bot"}`)
+	assert.Nil(err)
+	assert.Equal("ICN", res.ParseName("Aus bus L.").NomCodeSetting)
+	assert.Empty(gnp.ParseName("Aus bus L.").NomCodeSetting)
+}
+
+func TestConn(t *testing.T) {
+	assert := assert.New(t)
+	id := gnpsqlOpen()
+	c := getConn(id)
+	require.NotNil(t, c)
+	assert.NotEqual(id, gnpsqlOpen())
+
+	res := c.parse("Aus bus L.")
+	assert.Equal("Aus bus", res.Canonical.Simple)
+	assert.NotNil(res.Details)
+	last := c.last
+	c.parse("Aus bus L.")
+	assert.Same(last, c.last)
+	res = c.parse("Cus dus")
+	assert.NotSame(last, c.last)
+	assert.Equal("Cus dus", res.Verbatim)
+
+	gnpsqlClose(id)
+	assert.Nil(getConn(id))
+}
+
+// TestExtension builds the extension and runs SQL functions with the
+// sqlite3 command line tool.
+func TestExtension(t *testing.T) {
+	sqlite, err := exec.LookPath("sqlite3")
+	if err != nil {
+		t.Skip("sqlite3 is not installed")
+	}
+	dir := t.TempDir()
+	lib := filepath.Join(dir, "gnparser_sqlite.so")
+	out, err := exec.Command(
+		"go", "build", "-tags", "sqlite", "-buildmode=c-shared", "-o", lib, ".",
+	).CombinedOutput()
+	require.Nil(t, err, string(out))
+
+	query := func(sql string) (string, error) {
+		cmd := exec.Command(sqlite, ":memory:", ".load "+lib, sql)
+		out, err := cmd.CombinedOutput()
+		return strings.TrimSpace(string(out)), err
+	}
+
+	tests := []struct {
+		msg, sql, res string
+	}{
+		{"canonical", "SELECT gnparse_canonical('Aus bus var. cus L.')",
+			"Aus bus cus"},
+		{"canonical full", "SELECT gnparse_canonical_full('Aus bus var. cus L.')",
+			"Aus bus var. cus"},
+		{"stem", "SELECT gnparse_stem('Pleurosigma vitrea Cleve')",
+			"Pleurosigma uitre"},
+		{"quality", "SELECT gnparse_quality('Bubo bubo L. 1758 #1')", "4"},
+		{"null", "SELECT gnparse_canonical(NULL) IS NULL", "1"},
+		{"unparsed", "SELECT gnparse_canonical('1234') IS NULL", "1"},
+		{"json", "SELECT json_extract(gnparse_json('Aus bus L.'), " +
+			"'$.canonical.simple')", "Aus bus"},
+		{"json options", "SELECT json_extract(gnparse_json('Aus bus L.', " +
+			`'{"code":"bot"}'), '$.nomenclaturalCodeSetting')`, "ICN"},
+		{"same name", "SELECT gnparse_canonical('Aus bus L.'), " +
+			"gnparse_stem('Aus bus L.'), gnparse_quality('Aus bus L.')",
+			"Aus bus|Aus bus|1"},
+		{"words", "SELECT normalized, type, start, end " +
+			"FROM gnparse_words('Aus bus L. 1758')",
+			"Aus|GENUS|0|3\nbus|SPECIES|4|7\nL.|AUTHOR_WORD|8|10\n" +
+				"1758|YEAR|11|15"},
+		{"words null", "SELECT count(*) FROM gnparse_words(NULL)", "0"},
+		{"words join", "CREATE TABLE names (name TEXT); " +
+			"INSERT INTO names VALUES ('Aus bus'), ('Cus dus L.'); " +
+			"SELECT n.name, count(*) FROM names n, gnparse_words(n.name) w " +
+			"GROUP BY n.name ORDER BY n.name",
+			"Aus bus|2\nCus dus L.|3"},
+	}
+
+	for _, v := range tests {
+		res, err := query(v.sql)
+		assert.Nil(t, err, v.msg)
+		assert.Equal(t, v.res, res, v.msg)
+	}
+
+	res, err := query(`SELECT gnparse_json('Aus bus', '{"format":"csv"}')`)
+	assert.NotNil(t, err)
+	assert.Contains(t, res, "not supported")
+
+	res, err = query("SELECT * FROM gnparse_words")
+	assert.NotNil(t, err)
+	assert.Contains(t, res, "gnparse_words requires a name-string")
+}
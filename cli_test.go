@@ -1,11 +1,24 @@
 package gnparser_test
 
 import (
+	"bytes"
+	"compress/gzip"
+	"database/sql"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
 	"strings"
 	"testing"
 
+	"github.com/gnames/gnparser/io/parquetio"
+	"github.com/klauspost/compress/zstd"
+	"github.com/parquet-go/parquet-go"
 	"github.com/rendon/testcli"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	_ "modernc.org/sqlite"
 )
 
 func TestTMP(t *testing.T) {
@@ -143,3 +156,434 @@ func TestFlattenOutput(t *testing.T) {
 		assert.Contains(t, output, `"canonicalSimple"`)
 	})
 }
+
+func TestServeStdio(t *testing.T) {
+	t.Run("answers JSON-RPC requests", func(t *testing.T) {
+		c := testcli.Command("gnparser", "serve", "--stdio")
+		c.SetStdin(strings.NewReader(
+			`{"jsonrpc":"2.0","id":1,"method":"parse","params":{"name":"Homo sapiens"}}`,
+		))
+		c.Run()
+		assert.True(t, c.Success())
+		assert.Contains(t, c.Stdout(), `{"jsonrpc":"2.0","id":1,"result":{"parsed":true`)
+	})
+
+	t.Run("needs web or gRPC without --stdio", func(t *testing.T) {
+		c := testcli.Command("gnparser", "serve", "-p", "0")
+		c.Run()
+		assert.False(t, c.Success())
+	})
+
+	t.Run("parses names that are not subcommands", func(t *testing.T) {
+		c := testcli.Command("gnparser", "Homo sapiens", "-f", "csv")
+		c.Run()
+		assert.True(t, c.Success())
+		assert.Contains(t, c.Stdout(), ",Homo sapiens,2")
+	})
+}
+
+func TestDB(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "names.sqlite")
+	db, err := sql.Open("sqlite", path)
+	require.Nil(t, err)
+	defer db.Close()
+	_, err = db.Exec(`
+CREATE TABLE names (id INTEGER PRIMARY KEY, name TEXT);
+INSERT INTO names (name) VALUES ('Homo sapiens Linnaeus, 1758'), ('Bubo bubo')`)
+	require.Nil(t, err)
+
+	t.Run("saves parsed names into a table", func(t *testing.T) {
+		c := testcli.Command("gnparser", "db", "--dsn", path, "--table", "names", "-q")
+		c.Run()
+		assert.True(t, c.Success())
+
+		var can, authors string
+		err := db.QueryRow(
+			`SELECT canonical_simple, authors FROM names_parsed WHERE id = 1`,
+		).Scan(&can, &authors)
+		assert.Nil(t, err)
+		assert.Equal(t, "Homo sapiens", can)
+		assert.Equal(t, "Linnaeus", authors)
+	})
+
+	t.Run("requires source", func(t *testing.T) {
+		c := testcli.Command("gnparser", "db", "--dsn", path)
+		c.Run()
+		assert.False(t, c.Success())
+	})
+}
+
+func TestParquet(t *testing.T) {
+	names := "Homo sapiens Linnaeus, 1758\nBubo bubo\n"
+	t.Run("writes batches", func(t *testing.T) {
+		c := testcli.Command("gnparser", "-f", "parquet", "-q")
+		c.SetStdin(strings.NewReader(names))
+		c.Run()
+		assert.True(t, c.Success())
+
+		r := bytes.NewReader([]byte(c.Stdout()))
+		rows, err := parquet.Read[parquetio.Row](r, r.Size())
+		require.Nil(t, err)
+		require.Len(t, rows, 2)
+		assert.Equal(t, "Homo sapiens", rows[0].CanonicalSimple)
+		assert.Equal(t, int32(2), rows[1].Cardinality)
+	})
+
+	t.Run("writes stream with details", func(t *testing.T) {
+		c := testcli.Command("gnparser", "-f", "parquet", "-s", "-d")
+		c.SetStdin(strings.NewReader(names))
+		c.Run()
+		assert.True(t, c.Success())
+
+		r := bytes.NewReader([]byte(c.Stdout()))
+		rows, err := parquet.Read[parquetio.RowDetails](r, r.Size())
+		require.Nil(t, err)
+		require.Len(t, rows, 2)
+		assert.Equal(t, "Homo", rows[0].Genus)
+		assert.Len(t, rows[0].Words, 4)
+	})
+}
+
+func TestInputFiles(t *testing.T) {
+	dir := t.TempDir()
+	writeFile := func(name, data string, compress func(io.Writer) io.WriteCloser) string {
+		path := filepath.Join(dir, name)
+		f, err := os.Create(path)
+		require.Nil(t, err)
+		defer f.Close()
+		w := compress(f)
+		_, err = io.WriteString(w, data)
+		require.Nil(t, err)
+		require.Nil(t, w.Close())
+		return path
+	}
+	plain := writeFile("a.txt", "Bubo bubo\nHomo sapiens\n",
+		func(w io.Writer) io.WriteCloser { return nopCloser{w} })
+	gz := writeFile("b.txt.gz", "Aus bus\n",
+		func(w io.Writer) io.WriteCloser { return gzip.NewWriter(w) })
+	zst := writeFile("c.txt.zst", "Cus dus\n",
+		func(w io.Writer) io.WriteCloser {
+			zw, _ := zstd.NewWriter(w)
+			return zw
+		})
+
+	t.Run("reads compressed file", func(t *testing.T) {
+		for _, path := range []string{gz, zst} {
+			c := testcli.Command("gnparser", path, "-q")
+			c.Run()
+			assert.True(t, c.Success())
+			lines := strings.Split(strings.TrimSpace(c.Stdout()), "\n")
+			require.Len(t, lines, 2)
+			assert.True(t, strings.HasPrefix(lines[0], "Id,"))
+		}
+	})
+
+	t.Run("adds sources for many files", func(t *testing.T) {
+		c := testcli.Command("gnparser", plain, filepath.Join(dir, "*.gz"), "-q")
+		c.Run()
+		assert.True(t, c.Success())
+		lines := strings.Split(strings.TrimSpace(c.Stdout()), "\n")
+		require.Len(t, lines, 4)
+		assert.True(t, strings.HasPrefix(lines[0], "SourceFile,SourceLine,Id,"))
+		assert.True(t, strings.HasPrefix(lines[2], plain+",2,"))
+		assert.True(t, strings.HasPrefix(lines[3], gz+",1,"))
+	})
+
+	t.Run("reads directory in stream", func(t *testing.T) {
+		c := testcli.Command("gnparser", dir, "-s", "-f", "compact", "-q")
+		c.Run()
+		assert.True(t, c.Success())
+		lines := strings.Split(strings.TrimSpace(c.Stdout()), "\n")
+		require.Len(t, lines, 4)
+		assert.Contains(t, lines[3], `"sourceFile":"`+zst+`","sourceLine":1`)
+	})
+
+	t.Run("fails on missing files", func(t *testing.T) {
+		c := testcli.Command("gnparser", plain, filepath.Join(dir, "none.txt"))
+		c.Run()
+		assert.False(t, c.Success())
+	})
+
+	t.Run("compresses output", func(t *testing.T) {
+		c := testcli.Command("gnparser", plain, "--compress", "gzip", "-q")
+		c.Run()
+		assert.True(t, c.Success())
+		r, err := gzip.NewReader(strings.NewReader(c.Stdout()))
+		require.Nil(t, err)
+		out, err := io.ReadAll(r)
+		require.Nil(t, err)
+		assert.Contains(t, string(out), "Homo sapiens")
+	})
+}
+
+type nopCloser struct{ io.Writer }
+
+func (nopCloser) Close() error { return nil }
+
+func TestCheckpoint(t *testing.T) {
+	dir := t.TempDir()
+	input := filepath.Join(dir, "names.txt")
+	err := os.WriteFile(input, []byte("Bubo bubo\nHomo sapiens\nAus bus\n"), 0644)
+	require.Nil(t, err)
+	out := filepath.Join(dir, "parsed.csv")
+	ckptPath := out + ".checkpoint"
+
+	c := testcli.Command("gnparser", input, "-o", out, "--checkpoint", "-q")
+	c.Run()
+	require.True(t, c.Success())
+	full, err := os.ReadFile(out)
+	require.Nil(t, err)
+	ckpt, err := os.ReadFile(ckptPath)
+	require.Nil(t, err)
+	assert.Contains(t, string(ckpt), `"names": 3`)
+	assert.Contains(t, string(ckpt), `"done": true`)
+
+	t.Run("continues from checkpoint", func(t *testing.T) {
+		for _, stream := range []string{"-s=false", "-s"} {
+			// simulate a job interrupted after the first name, with a half
+			// written line after the checkpoint.
+			lines := strings.SplitAfter(string(full), "\n")
+			offset := len(lines[0]) + len(lines[1])
+			part := string(full[:offset]) + "half written"
+			require.Nil(t, os.WriteFile(out, []byte(part), 0644))
+			state := strings.Replace(string(ckpt), `"names": 3`, `"names": 1`, 1)
+			state = strings.Replace(state, `"done": true`, `"done": false`, 1)
+			state = regexp.MustCompile(`"offset": \d+`).
+				ReplaceAllString(state, fmt.Sprintf(`"offset": %d`, offset))
+			require.Nil(t, os.WriteFile(ckptPath, []byte(state), 0644))
+
+			c := testcli.Command("gnparser", input, "-o", out, "--resume", stream, "-q")
+			c.Run()
+			require.True(t, c.Success())
+			res, err := os.ReadFile(out)
+			require.Nil(t, err)
+			assert.Equal(t, string(full), string(res))
+		}
+	})
+
+	t.Run("rejects different settings", func(t *testing.T) {
+		c := testcli.Command("gnparser", input, "-o", out, "--resume", "-f", "tsv")
+		c.Run()
+		assert.False(t, c.Success())
+
+		c = testcli.Command("gnparser", input, "-o", out, "--resume", "-F")
+		c.Run()
+		assert.False(t, c.Success())
+	})
+
+	t.Run("needs output file", func(t *testing.T) {
+		c := testcli.Command("gnparser", input, "--checkpoint")
+		c.Run()
+		assert.False(t, c.Success())
+	})
+}
+
+func TestSubcommands(t *testing.T) {
+	t.Run("parse", func(t *testing.T) {
+		c := testcli.Command("gnparser", "parse", "Bubo bubo", "-f", "compact")
+		c.Run()
+		assert.True(t, c.Success())
+		assert.True(t, c.StdoutContains(`"simple":"Bubo bubo"`))
+	})
+
+	t.Run("debug", func(t *testing.T) {
+		c := testcli.Command("gnparser", "debug", "Bubo bubo")
+		c.Run()
+		assert.True(t, c.Success())
+		assert.True(t, c.StdoutContains("Complete Syntax Tree"))
+
+		c = testcli.Command("gnparser", "debug", "Bubo bubo", "-f", "json")
+		c.Run()
+		assert.True(t, c.Success())
+		assert.True(t, c.StdoutContains(`"rule": "SpeciesEpithet"`))
+
+		c = testcli.Command("gnparser", "debug", "Bubo bubo", "-f", "dot")
+		c.Run()
+		assert.True(t, c.Success())
+		assert.True(t, c.StdoutContains("subgraph cluster_output {"))
+	})
+
+	t.Run("schema", func(t *testing.T) {
+		c := testcli.Command("gnparser", "schema", "-F")
+		c.Run()
+		assert.True(t, c.Success())
+		assert.True(t, c.StdoutContains(`"title": "ParsedFlat"`))
+	})
+
+	t.Run("repl", func(t *testing.T) {
+		c := testcli.Command("gnparser", "repl")
+		c.SetStdin(strings.NewReader(":format none\nBubo bubo\n"))
+		c.Run()
+		assert.True(t, c.Success())
+		assert.True(t, c.StdoutContains("GENUS SPECIES"))
+	})
+
+	t.Run("stats", func(t *testing.T) {
+		c := testcli.Command("gnparser", "stats")
+		c.SetStdin(strings.NewReader("Bubo bubo\nAus bus var. cus\nx\n"))
+		c.Run()
+		assert.True(t, c.Success())
+		assert.True(t, c.StdoutContains(`"names": 3`))
+		assert.True(t, c.StdoutContains(`"parsed": 2`))
+		assert.True(t, c.StdoutContains(`"var.": 1`))
+	})
+}
+
+func TestSettings(t *testing.T) {
+	config := filepath.Join(t.TempDir(), "gnparser.yaml")
+	err := os.WriteFile(config, []byte("format: compact\ncapitalize: true\n"), 0644)
+	require.Nil(t, err)
+
+	tests := []struct {
+		msg, env string
+		args     []string
+		hasOut   string
+	}{
+		{"config", "", []string{"--config", config}, `"simple":"Bubo bubo"`},
+		{"env", "GNPARSER_FORMAT=pretty", nil, `"parsed": false`},
+		{"env over config", "GNPARSER_FORMAT=tsv",
+			[]string{"--config", config}, "\tBubo bubo\t"},
+		{"flag over env", "GNPARSER_FORMAT=pretty",
+			[]string{"-f", "csv"}, ",bubo bubo,0,"},
+		{"flag over config", "",
+			[]string{"--config", config, "-c=false"}, `"parsed":false`},
+		{"env config", "GNPARSER_CONFIG=" + config, nil, `"simple":"Bubo bubo"`},
+	}
+	for _, v := range tests {
+		c := testcli.Command("gnparser", append([]string{"bubo bubo"}, v.args...)...)
+		env := os.Environ()
+		if v.env != "" {
+			env = append(env, v.env)
+		}
+		c.SetEnv(env)
+		c.Run()
+		assert.True(t, c.Success(), v.msg)
+		assert.True(t, c.StdoutContains(v.hasOut), v.msg)
+	}
+
+	t.Run("bad settings", func(t *testing.T) {
+		c := testcli.Command("gnparser", "Bubo bubo")
+		c.SetEnv(append(os.Environ(), "GNPARSER_JOBS_NUM=many"))
+		c.Run()
+		assert.False(t, c.Success())
+	})
+}
+
+func TestQualityProfileFlag(t *testing.T) {
+	name := "Aus bus Smith ex Jones"
+	c := testcli.Command("gnparser", name, "--quality-profile", "zoo", "-f", "compact")
+	c.Run()
+	assert.True(t, c.Success())
+	assert.True(t, c.StdoutContains(`"quality":3,"qualityProfile":"zoological"`))
+
+	c = testcli.Command("gnparser", name, "-f", "compact")
+	c.SetEnv(append(os.Environ(), "GNPARSER_QUALITY_PROFILE=botanical"))
+	c.Run()
+	assert.True(t, c.Success())
+	assert.True(t, c.StdoutContains(`"quality":1,"qualityProfile":"botanical"`))
+
+	c = testcli.Command("gnparser", name, "--quality-profile", "nofile.yaml")
+	c.Run()
+	assert.False(t, c.Success())
+}
+
+func TestWarnings(t *testing.T) {
+	c := testcli.Command("gnparser", "warnings")
+	c.Run()
+	assert.True(t, c.Success())
+	assert.True(t, c.StdoutContains("AUTH_EX_NOT_REQUIRED"))
+
+	c = testcli.Command("gnparser", "warnings", "auth_ex_not_required")
+	c.Run()
+	assert.True(t, c.Success())
+	assert.True(t, c.StdoutContains("Fix:"))
+
+	c = testcli.Command("gnparser", "warnings", "-f", "json", "TAIL_UNPARSED")
+	c.Run()
+	assert.True(t, c.Success())
+	assert.True(t, c.StdoutContains(`"code": "TAIL_UNPARSED"`))
+
+	c = testcli.Command("gnparser", "warnings", "NOPE")
+	c.Run()
+	assert.False(t, c.Success())
+}
+
+func TestLangFlag(t *testing.T) {
+	name := "Aus bus Smith ex Jones"
+	c := testcli.Command("gnparser", name, "--lang", "es", "-f", "compact")
+	c.Run()
+	assert.True(t, c.Success())
+	assert.True(t, c.StdoutContains(`"message":"Los autores`))
+
+	c = testcli.Command("gnparser", name, "-f", "compact")
+	c.SetEnv(append(os.Environ(), "GNPARSER_LANGUAGE=pt"))
+	c.Run()
+	assert.True(t, c.Success())
+	assert.True(t, c.StdoutContains(`"message":"Autores`))
+
+	c = testcli.Command("gnparser", name, "--lang", "xx")
+	c.Run()
+	assert.False(t, c.Success())
+}
+
+func TestSuggestionsFlag(t *testing.T) {
+	name := "Acer tiliaefolia L."
+	c := testcli.Command("gnparser", name, "--suggestions", "-f", "compact")
+	c.Run()
+	assert.True(t, c.Success())
+	assert.True(t, c.StdoutContains(`"canonical":"Acer tiliifolia"`))
+
+	c = testcli.Command("gnparser", name, "-f", "compact")
+	c.Run()
+	assert.True(t, c.Success())
+	assert.False(t, c.StdoutContains(`"suggestions"`))
+}
+
+func TestHierarchyFlag(t *testing.T) {
+	c := testcli.Command("gnparser", "Aus bus var. cus", "--hierarchy",
+		"-f", "compact")
+	c.Run()
+	assert.True(t, c.Success())
+	assert.True(t, c.StdoutContains(`"hierarchy":[{"rank":"gen."`))
+	assert.True(t, c.StdoutContains(`"isAutonym":true`))
+}
+
+func TestRankInferenceFlag(t *testing.T) {
+	c := testcli.Command("gnparser", "Pinaceae", "--infer-rank", "-d")
+	c.Run()
+	assert.True(t, c.Success())
+	assert.True(t, c.StdoutContains(",Rank,RankInferred,"))
+	assert.True(t, c.StdoutContains(",fam.,true,"))
+
+	c = testcli.Command("gnparser", "Pinaceae", "-d")
+	c.Run()
+	assert.True(t, c.Success())
+	assert.True(t, c.StdoutContains(",Pinaceae,,false,"))
+}
+
+func TestCodeInferenceFlag(t *testing.T) {
+	c := testcli.Command("gnparser", "Aus (Bus) cus Smith, 1900",
+		"--infer-code", "-f", "compact")
+	c.Run()
+	assert.True(t, c.Success())
+	assert.True(t, c.StdoutContains(
+		`"nomenclaturalCodeInferred":{"code":"ICZN","confidence":0.68`))
+	assert.True(t, c.StdoutContains(`"clue":"SUBGENUS"`))
+}
+
+func TestGenderAgreementFlag(t *testing.T) {
+	name := "Aus rubrum"
+	c := testcli.Command("gnparser", name, "--gender-agreement", "-f", "compact")
+	c.Run()
+	assert.True(t, c.Success())
+	assert.False(t, c.StdoutContains(`"correction"`))
+
+	path := filepath.Join(t.TempDir(), "genders.txt")
+	err := os.WriteFile(path, []byte("Aus\tf\n"), 0644)
+	assert.Nil(t, err)
+	c = testcli.Command("gnparser", name, "--gender-agreement",
+		"--gender-dict", path, "-f", "compact")
+	c.Run()
+	assert.True(t, c.Success())
+	assert.True(t, c.StdoutContains(`"correction":"rubra"`))
+}
@@ -6,6 +6,8 @@ import (
 
 	"github.com/gnames/gnfmt"
 	"github.com/gnames/gnlib/ent/nomcode"
+	"github.com/gnames/gnparser/ent/gender"
+	"github.com/gnames/gnparser/ent/parsed"
 )
 
 // Config keeps settings that might affect how parsing is done,
@@ -28,6 +30,11 @@ type Config struct {
 	// 'PrettyJSON'.
 	Format gnfmt.Format
 
+	// GenusGenders contains grammatical genders of genera for the gender
+	// agreement check. The nil (default) value means genders from the
+	// built-in dictionary.
+	GenusGenders map[string]gender.Gender
+
 	// IgnoreHTMLTags can be set to true when it is desirable to clean up names
 	// from a few HTML tags often present in names-strings that were planned to
 	// be presented via an HTML page.
@@ -42,23 +49,47 @@ type Config struct {
 	// a stream of name-strings.
 	JobsNum int
 
+	// Language is the language of warning messages and labels of word
+	// types in parsing results. The empty value means
+	// parsed.DefaultLanguage.
+	Language string
+
+	// QualityProfile assigns qualities to warnings. The empty (default)
+	// profile uses parsed.WarningQualityMap.
+	QualityProfile parsed.QualityProfile
+
 	// Port to run wer-service.
 	Port int
 
+	// GRPCPort is a port to run gRPC service.
+	GRPCPort int
+
 	// WithCapitalization flag, when true, the first letter of a name-string
 	// is capitalized, if appropriate.
 	WithCapitalization bool
 
+	// WithCodeInference flag, when true, adds a nomenclatural code the name
+	// probably belongs to, with the evidence for it.
+	WithCodeInference bool
+
 	// WithDetails can be set to true when a simplified output is not sufficient
 	// for obtaining a required information.
 	WithDetails bool
 
+	// WithHierarchy flag, when true, adds names implied by a name (genus,
+	// species, autonyms) to the output.
+	WithHierarchy bool
+
 	// WithNoOrder flag, when true, output and input are in different order.
 	WithNoOrder bool
 
 	// WithPreserveDiaereses flag, when true, diaereses will not be transliterated
 	WithPreserveDiaereses bool
 
+	// WithRankInference flag, when true, adds ranks to uninomials that have
+	// a standard ending of a rank, for example 'fam.' to 'Pinaceae'.
+	WithRankInference bool
+
 	// WithCompactAuthors flag, when true, authors' initials will not be
 	// separated by space
 	WithCompactAuthors bool
@@ -68,6 +99,10 @@ type Config struct {
 	// some information that requires nesting.
 	WithFlatOutput bool
 
+	// WithGenderAgreement flag, when true, adds a warning for epithets that
+	// do not agree in gender with their genus.
+	WithGenderAgreement bool
+
 	// WithStream changes from parsing a batch by batch, to parsing one name
 	// at a time. When WithStream is true, BatchSize setting is ignored.
 	WithStream bool
@@ -76,6 +111,10 @@ type Config struct {
 	// species group names (ICZN) will be truncated to species. It helps to
 	// simplify matching names like `Aus bus` and `Aus bus bus`.
 	WithSpeciesGroupCut bool
+
+	// WithSuggestions flag, when true, adds corrected canonical forms of
+	// names according to orthographic rules of nomenclatural codes.
+	WithSuggestions bool
 }
 
 // Option is a type that has to be returned by all Option functions. Such
@@ -108,6 +147,13 @@ func OptFormat(f gnfmt.Format) Option {
 	}
 }
 
+// OptGenusGenders sets genders of genera for the gender agreement check.
+func OptGenusGenders(m map[string]gender.Gender) Option {
+	return func(cfg *Config) {
+		cfg.GenusGenders = m
+	}
+}
+
 // OptKeepHTMLTags sets the KeepHTMLTags field. This option is useful if
 // names with HTML tags shold not be parsed, or they are absent in input
 // data.
@@ -131,6 +177,23 @@ func OptJobsNum(i int) Option {
 	}
 }
 
+// OptLanguage sets the language of warning messages and labels of word
+// types. It accepts language tags like 'es' or 'pt-BR', unsupported
+// languages are ignored. The default language is kept as an empty string.
+func OptLanguage(lang string) Option {
+	return func(cfg *Config) {
+		l, err := parsed.NewLanguage(lang)
+		if err != nil {
+			slog.Warn("Cannot set language", "error", err)
+			return
+		}
+		if l == parsed.DefaultLanguage {
+			l = ""
+		}
+		cfg.Language = l
+	}
+}
+
 // OptPort sets a port for web-service.
 func OptPort(i int) Option {
 	return func(cfg *Config) {
@@ -138,6 +201,24 @@ func OptPort(i int) Option {
 	}
 }
 
+// OptGRPCPort sets a port for gRPC service.
+func OptGRPCPort(i int) Option {
+	return func(cfg *Config) {
+		cfg.GRPCPort = i
+	}
+}
+
+// OptQualityProfile sets a profile that assigns qualities to warnings.
+// The default profile is kept as an empty QualityProfile.
+func OptQualityProfile(qp parsed.QualityProfile) Option {
+	return func(cfg *Config) {
+		if qp.IsDefault() {
+			qp = parsed.QualityProfile{}
+		}
+		cfg.QualityProfile = qp
+	}
+}
+
 // OptWithCapitaliation sets the WithCapitalization field.
 func OptWithCapitaliation(b bool) Option {
 	return func(cfg *Config) {
@@ -187,6 +268,34 @@ func OptWithFlatOutput(b bool) Option {
 	}
 }
 
+// OptWithGenderAgreement sets WithGenderAgreement field.
+func OptWithGenderAgreement(b bool) Option {
+	return func(cfg *Config) {
+		cfg.WithGenderAgreement = b
+	}
+}
+
+// OptWithHierarchy sets WithHierarchy field.
+func OptWithHierarchy(b bool) Option {
+	return func(cfg *Config) {
+		cfg.WithHierarchy = b
+	}
+}
+
+// OptWithCodeInference sets WithCodeInference field.
+func OptWithCodeInference(b bool) Option {
+	return func(cfg *Config) {
+		cfg.WithCodeInference = b
+	}
+}
+
+// OptWithRankInference sets WithRankInference field.
+func OptWithRankInference(b bool) Option {
+	return func(cfg *Config) {
+		cfg.WithRankInference = b
+	}
+}
+
 // OptWithDetails sets the WithDetails field.
 func OptWithStream(b bool) Option {
 	return func(cfg *Config) {
@@ -201,6 +310,13 @@ func OptWithSpeciesGroupCut(b bool) Option {
 	}
 }
 
+// OptWithSuggestions sets WithSuggestions field.
+func OptWithSuggestions(b bool) Option {
+	return func(cfg *Config) {
+		cfg.WithSuggestions = b
+	}
+}
+
 // NewConfig generates a new Config object. It can take an arbitrary number
 // of `Option` functions to modify default configuration settings.
 func NewConfig(opts ...Option) Config {
@@ -33,6 +33,7 @@ func TestNewOpts(t *testing.T) {
 		IgnoreHTMLTags: true,
 		WithDetails:    true,
 		Port:           8989,
+		GRPCPort:       8990,
 	}
 	assert.Equal(t, updt, cnf)
 }
@@ -45,5 +46,6 @@ func opts() []gnparser.Option {
 		gnparser.OptIgnoreHTMLTags(true),
 		gnparser.OptWithDetails(true),
 		gnparser.OptPort(8989),
+		gnparser.OptGRPCPort(8990),
 	}
 }
@@ -0,0 +1,223 @@
+// Package codeinfer infers a nomenclatural code a name-string probably
+// belongs to.
+//
+// Names do not declare their code, but codes leave traces in name-strings.
+// A comma between authors and year, or a subgenus in parentheses are
+// zoological conventions (ICZN Recommendation 22A.2), while ranks like
+// 'var.' or 'f.', 'ex' authors, abbreviated authors and authors of
+// combinations after parenthesized basionym authors are botanical ones
+// (ICN Art. 4, 46.4, 49). Bacterial genera and authors of botanical genera
+// are known from dictionaries, cultivars and viruses are detected by the
+// parser.
+//
+// Every trace is a piece of evidence with a weight. Evidence pointing to
+// the same code is combined, the code with the strongest support wins, and
+// the confidence decreases when some evidence points to other codes.
+package codeinfer
+
+import (
+	"math"
+	"regexp"
+	"strings"
+
+	"github.com/gnames/gnlib/ent/nomcode"
+	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/gnames/gnparser/io/dict"
+)
+
+// Clues of evidence.
+const (
+	ClueCultivar          = "CULTIVAR"
+	ClueCandidatus        = "CANDIDATUS"
+	ClueBacterialGenus    = "BACTERIAL_GENUS"
+	ClueVirus             = "VIRUS"
+	ClueSubgenus          = "SUBGENUS"
+	ClueYearComma         = "YEAR_COMMA"
+	ClueBotanicalRank     = "BOTANICAL_RANK"
+	ClueICNAuthor         = "ICN_AUTHOR"
+	ClueAuthorAbbr        = "AUTHOR_ABBREVIATION"
+	ClueExAuthor          = "EX_AUTHOR"
+	ClueCombinationAuthor = "COMBINATION_AUTHOR"
+	ClueHybrid            = "HYBRID"
+)
+
+// botanicalRanks are ranks that are used only by botanical codes.
+var botanicalRanks = map[string]struct{}{
+	"var.":     {},
+	"subvar.":  {},
+	"f.":       {},
+	"subf.":    {},
+	"sect.":    {},
+	"subsect.": {},
+	"ser.":     {},
+	"subser.":  {},
+}
+
+var (
+	// yearCommaRe finds a comma between an author and a year.
+	yearCommaRe = regexp.MustCompile(`[\p{L}.)](,\s*\(?[12]\d{3})`)
+	// combAuthorRe finds authors that follow parenthesized authors.
+	combAuthorRe = regexp.MustCompile(`\)\s*(\p{Lu}[^\s,&]*)`)
+	// exAuthorRe finds 'ex' between authors.
+	exAuthorRe = regexp.MustCompile(`\s(ex|ex\.)\s`)
+	// cultivarRe finds a cultivar epithet.
+	cultivarRe = regexp.MustCompile(
+		`\s(cv\.?\s+\p{Lu}\S*|['‘"“]\p{Lu}[^'’"”]*['’"”])`,
+	)
+)
+
+// Infer returns the nomenclatural code the name probably belongs to,
+// with the confidence of the inference and the evidence it is based on.
+// The words argument contains parsed words of the name. It returns nil if
+// the name-string has no evidence for any code.
+func Infer(p parsed.Parsed, words []parsed.Word) *parsed.CodeInference {
+	ev := evidence(p, words)
+	if len(ev) == 0 {
+		return nil
+	}
+
+	// support of a code combines the strongest evidence of every clue.
+	clues := make(map[string]map[string]float64)
+	for _, v := range ev {
+		if clues[v.Code] == nil {
+			clues[v.Code] = make(map[string]float64)
+		}
+		clues[v.Code][v.Clue] = max(clues[v.Code][v.Clue], v.Weight)
+	}
+
+	var best string
+	var bestSupport, total float64
+	for _, v := range ev {
+		w, ok := clues[v.Code]
+		if !ok {
+			continue
+		}
+		delete(clues, v.Code)
+		miss := 1.0
+		for _, weight := range w {
+			miss *= 1 - weight
+		}
+		support := 1 - miss
+		total += support
+		if support > bestSupport {
+			best, bestSupport = v.Code, support
+		}
+	}
+
+	return &parsed.CodeInference{
+		Code:       best,
+		Confidence: math.Round(100*bestSupport*bestSupport/total) / 100,
+		Evidence:   ev,
+	}
+}
+
+func evidence(p parsed.Parsed, words []parsed.Word) []parsed.CodeEvidence {
+	var res []parsed.CodeEvidence
+	add := func(code nomcode.Code, clue, value string, weight float64) {
+		ce := parsed.CodeEvidence{
+			Code:   code.Abbr(),
+			Clue:   clue,
+			Value:  value,
+			Weight: weight,
+		}
+		res = append(res, ce)
+	}
+
+	if p.Virus {
+		add(nomcode.Virus, ClueVirus, genus(words), 0.9)
+	}
+	if p.Cultivar {
+		add(nomcode.Cultivars, ClueCultivar, "", 0.9)
+	} else if m := cultivarRe.FindStringSubmatch(p.Verbatim); m != nil {
+		// without the cultivar code cultivar epithets are not parsed.
+		add(nomcode.Cultivars, ClueCultivar, m[1], 0.7)
+	}
+	if p.Candidatus {
+		add(nomcode.Bacterial, ClueCandidatus, "", 0.9)
+	} else if p.Bacteria != nil {
+		weight := 0.4
+		if p.Bacteria.Int() == 1 {
+			weight = 0.8
+		}
+		add(nomcode.Bacterial, ClueBacterialGenus, genus(words), weight)
+	}
+
+	var hasAuthors bool
+	for i, v := range words {
+		switch v.Type {
+		case parsed.CultivarType:
+			if !p.Cultivar {
+				add(nomcode.Cultivars, ClueCultivar, v.Verbatim, 0.9)
+			}
+		case parsed.SubgenusType:
+			add(nomcode.Zoological, ClueSubgenus, v.Verbatim, 0.6)
+		case parsed.RankType:
+			if weight := rankWeight(v.Normalized); weight > 0 {
+				add(nomcode.Botanical, ClueBotanicalRank, v.Verbatim, weight)
+			}
+		case parsed.HybridCharType:
+			add(nomcode.Botanical, ClueHybrid, v.Verbatim, 0.4)
+		case parsed.AuthorWordType:
+			hasAuthors = true
+			if _, ok := dict.Dict.AuthorICN[v.Normalized]; ok {
+				add(nomcode.Botanical, ClueICNAuthor, v.Verbatim, 0.2)
+			} else if isAbbr(words, i) {
+				add(nomcode.Botanical, ClueAuthorAbbr, v.Verbatim, 0.3)
+			}
+		}
+	}
+	if !hasAuthors {
+		return res
+	}
+
+	if m := yearCommaRe.FindStringSubmatch(p.Verbatim); m != nil {
+		add(nomcode.Zoological, ClueYearComma, m[1], 0.6)
+	}
+	if m := exAuthorRe.FindString(p.Normalized); m != "" {
+		add(nomcode.Botanical, ClueExAuthor, strings.TrimSpace(m), 0.5)
+	}
+	if m := combAuthorRe.FindStringSubmatch(p.Normalized); m != nil {
+		add(nomcode.Botanical, ClueCombinationAuthor, m[1], 0.4)
+	}
+	return res
+}
+
+// rankWeight returns the weight of a rank as evidence for the botanical
+// codes. Subspecies are used in zoology too, so their weight is low.
+func rankWeight(rank string) float64 {
+	if _, ok := botanicalRanks[rank]; ok {
+		return 0.7
+	}
+	if strings.HasPrefix(rank, "notho") {
+		return 0.7
+	}
+	if rank == "subsp." || rank == "ssp." {
+		return 0.3
+	}
+	return 0
+}
+
+// isAbbr checks if an author word is an abbreviated family name of an
+// author ('L.', 'Mill.'), which is common in botany. Abbreviations that
+// are followed by other words of the same author are initials.
+func isAbbr(words []parsed.Word, i int) bool {
+	if !strings.HasSuffix(words[i].Normalized, ".") {
+		return false
+	}
+	if i+1 == len(words) {
+		return true
+	}
+	next := words[i+1]
+	return next.Type != parsed.AuthorWordType || next.Start > words[i].End+1
+}
+
+// genus returns the first genus or uninomial of a name.
+func genus(words []parsed.Word) string {
+	for _, v := range words {
+		switch v.Type {
+		case parsed.GenusType, parsed.UninomialType, parsed.GenusIcvcnType:
+			return v.Normalized
+		}
+	}
+	return ""
+}
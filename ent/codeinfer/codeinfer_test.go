@@ -0,0 +1,90 @@
+package codeinfer_test
+
+import (
+	"testing"
+
+	"github.com/gnames/gnlib/ent/nomcode"
+	"github.com/gnames/gnparser/ent/codeinfer"
+	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/gnames/gnparser/ent/parser"
+	"github.com/stretchr/testify/assert"
+)
+
+func infer(name string, code nomcode.Code) *parsed.CodeInference {
+	p := parser.New()
+	sn := p.PreprocessAndParse(name, "test", code,
+		false, false, false, false)
+	res := sn.ToOutput(true, false)
+	return codeinfer.Infer(res, res.Words)
+}
+
+func TestInfer(t *testing.T) {
+	assert := assert.New(t)
+	tests := []struct {
+		msg, name  string
+		code       nomcode.Code
+		res        string
+		confidence float64
+		clues      []string
+	}{
+		{"year comma", "Bubo bubo (Linnaeus, 1758)", nomcode.Unknown,
+			"ICZN", 0.6, []string{"YEAR_COMMA"}},
+		{"subgenus", "Aus (Bus) cus", nomcode.Unknown,
+			"ICZN", 0.6, []string{"SUBGENUS"}},
+		{"rank", "Aus bus var. cus (L.) Mill.", nomcode.Unknown,
+			"ICN", 0.87, []string{"BOTANICAL_RANK", "AUTHOR_ABBREVIATION",
+				"AUTHOR_ABBREVIATION", "COMBINATION_AUTHOR"}},
+		{"ex author", "Aus bus Hook. ex Jones", nomcode.Unknown,
+			"ICN", 0.65, []string{"AUTHOR_ABBREVIATION", "EX_AUTHOR"}},
+		{"notho", "Aus bus nothosubsp. cus", nomcode.Unknown,
+			"ICN", 0.7, []string{"BOTANICAL_RANK"}},
+		{"hybrid", "Carex × elata", nomcode.Unknown,
+			"ICN", 0.4, []string{"HYBRID"}},
+		{"initials", "Aus bus J.Jones", nomcode.Unknown, "", 0, nil},
+		{"bacteria", "Escherichia coli", nomcode.Unknown,
+			"ICNP", 0.8, []string{"BACTERIAL_GENUS"}},
+		{"candidatus", "Candidatus Aus bus", nomcode.Unknown,
+			"ICNP", 0.9, []string{"CANDIDATUS"}},
+		{"virus", "Tobacco mosaic virus", nomcode.Unknown,
+			"ICVCN", 0.9, []string{"VIRUS"}},
+		{"icvcn", "Tobamovirus tabaci", nomcode.Virus,
+			"ICVCN", 0.9, []string{"VIRUS"}},
+		{"cultivar", "Rosa 'Peace'", nomcode.Cultivars,
+			"ICNCP", 0.9, []string{"CULTIVAR"}},
+		{"cultivar no code", "Rosa 'Peace'", nomcode.Unknown,
+			"ICNCP", 0.7, []string{"CULTIVAR"}},
+		{"mixed", "Aus (Bus) cus var. dus", nomcode.Unknown,
+			"ICN", 0.38, []string{"SUBGENUS", "BOTANICAL_RANK"}},
+		{"no evidence", "Aus bus", nomcode.Unknown, "", 0, nil},
+		{"not parsed", "not a name", nomcode.Unknown, "", 0, nil},
+	}
+
+	for _, v := range tests {
+		res := infer(v.name, v.code)
+		if v.res == "" {
+			assert.Nil(res, v.msg)
+			continue
+		}
+		assert.Equal(v.res, res.Code, v.msg)
+		assert.Equal(v.confidence, res.Confidence, v.msg)
+		clues := make([]string, len(res.Evidence))
+		for i, e := range res.Evidence {
+			clues[i] = e.Clue
+		}
+		assert.Equal(v.clues, clues, v.msg)
+	}
+}
+
+func TestInferValues(t *testing.T) {
+	assert := assert.New(t)
+	res := infer("Aus bus Smith, 1900", nomcode.Unknown)
+	assert.Equal("ICZN", res.Code)
+	assert.Equal([]parsed.CodeEvidence{
+		{Code: "ICN", Clue: "ICN_AUTHOR", Value: "Smith", Weight: 0.2},
+		{Code: "ICZN", Clue: "YEAR_COMMA", Value: ", 1900", Weight: 0.6},
+	}, res.Evidence)
+	assert.Equal(0.45, res.Confidence)
+
+	res = infer("Aus bus cv. Peace", nomcode.Unknown)
+	assert.Equal("cv. Peace", res.Evidence[0].Value)
+}
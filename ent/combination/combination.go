@@ -0,0 +1,303 @@
+// Package combination creates names that result from moving a species or
+// an infraspecific taxon to another genus, or from changing its rank.
+//
+// The epithet keeps the authorship of its basionym, which goes to
+// parentheses. Botanical (ICN Art. 49) and bacterial names cite authors of
+// the new combination after the parentheses, zoological names (ICZN Art.
+// 51.3) do not. In zoology only a transfer to another genus requires
+// parentheses, a change of rank within the species group does not.
+package combination
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/gnames/gnlib/ent/nomcode"
+	"github.com/gnames/gnparser/ent/gender"
+	"github.com/gnames/gnparser/ent/parsed"
+)
+
+// Target describes the new position of a name.
+type Target struct {
+	// Genus is the genus of the new combination. The empty value keeps the
+	// genus of the name.
+	Genus string
+
+	// Rank is the new rank of the last epithet of the name. 'sp.' (or
+	// 'species') makes an infraspecific epithet a specific one, other
+	// values ('subsp.', 'var.', 'f.' etc.) make it an infraspecific epithet.
+	// The empty value keeps the rank.
+	Rank string
+
+	// Species is the specific epithet of the new combination, when the
+	// epithet becomes infraspecific. The empty value keeps the specific
+	// epithet of the name.
+	Species string
+
+	// Authors are authors of the new combination ('Jones'). They are added
+	// to botanical and bacterial names only.
+	Authors string
+
+	// Gender is the gender of the target genus. If it is known, adjectival
+	// epithets are changed to agree with the genus.
+	Gender gender.Gender
+
+	// Code is the nomenclatural code of the name. If it is unknown,
+	// a name with the year in its authorship follows the zoological code,
+	// other names follow the botanical code.
+	Code nomcode.Code
+}
+
+// isSpecies checks if the target rank is species.
+func (t Target) isSpecies() bool {
+	switch strings.ToLower(t.Rank) {
+	case "sp.", "sp", "spec.", "species":
+		return true
+	}
+	return false
+}
+
+// name is a simplified binomial or trinomial name.
+type name struct {
+	genus, subgenus, species string
+	rank, infrasp            string
+	auth                     *parsed.Authorship
+}
+
+// epithet returns the last epithet of the name.
+func (n name) epithet() string {
+	if n.infrasp != "" {
+		return n.infrasp
+	}
+	return n.species
+}
+
+// Parser parses name-strings, gnparser.GNparser is a Parser.
+type Parser interface {
+	ParseName(string) parsed.Parsed
+}
+
+// Parse creates a name-string of the new combination with New and parses
+// it with gnp. If the code of the target is unknown, the code set or
+// inferred for the parsed name is used.
+func Parse(
+	p parsed.Parsed,
+	t Target,
+	gnp Parser,
+) (string, parsed.Parsed, error) {
+	if t.Code == nomcode.Unknown {
+		code := p.NomCodeSetting
+		if code == "" && p.NomCodeInferred != nil {
+			code = p.NomCodeInferred.Code
+		}
+		t.Code = nomcode.New(code)
+	}
+	res, err := New(p, t)
+	if err != nil {
+		return "", parsed.Parsed{}, err
+	}
+	return res, gnp.ParseName(res), nil
+}
+
+// New creates a name-string of the new combination. The parsed name must
+// contain details. Only binomial and trinomial names are supported, when
+// an infraspecific name has several infraspecific epithets, only the last
+// one is kept.
+func New(p parsed.Parsed, t Target) (string, error) {
+	if t.Genus == "" && t.Rank == "" && t.Species == "" {
+		return "", errors.New("target genus or rank is not set")
+	}
+	n, err := newName(p)
+	if err != nil {
+		return "", err
+	}
+	zoo := isZoological(t.Code, n.auth)
+
+	res := name{genus: n.genus, species: n.species, rank: n.rank,
+		infrasp: n.infrasp}
+	if t.Genus != "" {
+		res.genus = t.Genus
+	}
+	if res.genus == n.genus {
+		res.subgenus = n.subgenus
+	}
+
+	epithet := n.epithet()
+	switch {
+	case t.isSpecies():
+		res.species, res.rank, res.infrasp = epithet, "", ""
+	case t.Rank != "":
+		res.rank, res.infrasp = t.Rank, epithet
+		if n.infrasp == "" && t.Species == "" {
+			return "", fmt.Errorf(
+				"specific epithet for '%s %s' is not set", t.Rank, epithet,
+			)
+		}
+	}
+	if t.Species != "" {
+		if res.infrasp == "" {
+			return "", errors.New("target rank is not infraspecific")
+		}
+		res.species = t.Species
+	}
+
+	if t.Gender != gender.Unknown {
+		res.species = gender.Classify(res.species).Inflect(t.Gender)
+		if res.infrasp != "" {
+			res.infrasp = gender.Classify(res.infrasp).Inflect(t.Gender)
+		}
+	}
+
+	moved := res.genus != n.genus || res.rank != n.rank
+	if zoo {
+		moved = res.genus != n.genus
+	}
+	return res.value(authorship(n.auth, t.Authors, moved, zoo), zoo), nil
+}
+
+// newName takes the genus, epithets and the authorship of the last epithet
+// from details of a parsed name.
+func newName(p parsed.Parsed) (name, error) {
+	var res name
+	if !p.Parsed {
+		return res, errors.New("name is not parsed")
+	}
+	if p.Details == nil {
+		return res, errors.New("name has no details")
+	}
+
+	switch d := p.Details.(type) {
+	case parsed.DetailsSpecies:
+		sp := d.Species
+		res = name{genus: sp.Genus, subgenus: sp.Subgenus,
+			species: sp.Species, auth: sp.Authorship}
+	case parsed.DetailsInfraspecies:
+		sp := d.Infraspecies.Species
+		isp := d.Infraspecies.Infraspecies
+		if len(isp) == 0 {
+			return res, errors.New("name has no infraspecific epithets")
+		}
+		last := isp[len(isp)-1]
+		res = name{genus: sp.Genus, subgenus: sp.Subgenus,
+			species: sp.Species, rank: last.Rank, infrasp: last.Value,
+			auth: last.Authorship}
+	default:
+		return res, fmt.Errorf(
+			"cannot create a combination from '%s'", p.Verbatim,
+		)
+	}
+	return res, nil
+}
+
+// isZoological decides which code's conventions to use.
+func isZoological(code nomcode.Code, auth *parsed.Authorship) bool {
+	switch code {
+	case nomcode.Zoological:
+		return true
+	case nomcode.Unknown:
+		og := originalAuth(auth)
+		return og != nil && og.Year != nil
+	}
+	return false
+}
+
+func originalAuth(auth *parsed.Authorship) *parsed.AuthGroup {
+	if auth == nil {
+		return nil
+	}
+	return auth.Original
+}
+
+// value creates a name-string. Zoological trinomials have no rank.
+func (n name) value(auth string, zoo bool) string {
+	res := []string{n.genus}
+	if n.subgenus != "" {
+		res = append(res, "("+n.subgenus+")")
+	}
+	res = append(res, n.species)
+	if n.infrasp != "" {
+		if n.rank != "" && !zoo {
+			res = append(res, n.rank)
+		}
+		res = append(res, n.infrasp)
+	}
+	if auth != "" {
+		res = append(res, auth)
+	}
+	return strings.Join(res, " ")
+}
+
+// authorship creates the authorship of the new combination. Authors of the
+// original name go to parentheses if the name is moved, or if they are
+// already in parentheses, authors of the previous combination are replaced
+// by the new ones.
+func authorship(
+	auth *parsed.Authorship,
+	comb string,
+	moved, zoo bool,
+) string {
+	og := originalAuth(auth)
+	if og == nil {
+		return ""
+	}
+
+	res := authGroup(og, zoo)
+	parens := moved || auth.Combination != nil || hasParens(auth)
+	if !parens {
+		return res
+	}
+	res = "(" + res + ")"
+	if !zoo && comb != "" {
+		res += " " + comb
+	}
+	return res
+}
+
+// hasParens checks if the authors of the original name are in parentheses
+// already. Zoological names have no authors of the combination, so only
+// the authorship string shows it.
+func hasParens(auth *parsed.Authorship) bool {
+	return strings.HasPrefix(auth.Normalized, "(") ||
+		strings.HasPrefix(auth.Verbatim, "(")
+}
+
+// authGroup creates a string of authors. Zoological names separate the
+// year with a comma ('Smith, 1900'), other names with a space.
+func authGroup(ag *parsed.AuthGroup, zoo bool) string {
+	res := withYear(ag.Authors, ag.Year, zoo)
+	if ag.ExAuthors != nil {
+		res += " ex " + withYear(ag.ExAuthors.Authors, ag.ExAuthors.Year, zoo)
+	}
+	if ag.InAuthors != nil {
+		res += " in " + withYear(ag.InAuthors.Authors, ag.InAuthors.Year, zoo)
+	}
+	return res
+}
+
+func withYear(authors []string, year *parsed.Year, zoo bool) string {
+	res := joinAuthors(authors)
+	if year == nil {
+		return res
+	}
+	y := year.Value
+	if year.IsApproximate {
+		y = "[" + y + "]"
+	}
+	if zoo && res != "" {
+		return res + ", " + y
+	}
+	return strings.TrimSpace(res + " " + y)
+}
+
+// joinAuthors joins authors with commas and '&' before the last one.
+func joinAuthors(authors []string) string {
+	switch len(authors) {
+	case 0:
+		return ""
+	case 1:
+		return authors[0]
+	}
+	last := len(authors) - 1
+	return strings.Join(authors[:last], ", ") + " & " + authors[last]
+}
@@ -0,0 +1,157 @@
+package combination_test
+
+import (
+	"testing"
+
+	"github.com/gnames/gnlib/ent/nomcode"
+	"github.com/gnames/gnparser/ent/combination"
+	"github.com/gnames/gnparser/ent/gender"
+	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/gnames/gnparser/ent/parser"
+	"github.com/stretchr/testify/assert"
+)
+
+func parse(name string, details bool) parsed.Parsed {
+	p := parser.New()
+	sn := p.PreprocessAndParse(name, "test", nomcode.Unknown,
+		false, false, false, false)
+	return sn.ToOutput(details, false)
+}
+
+func TestNew(t *testing.T) {
+	assert := assert.New(t)
+	tests := []struct {
+		msg, name string
+		target    combination.Target
+		res       string
+	}{
+		{"zoo by year", "Aus bus Smith, 1900",
+			combination.Target{Genus: "Cus"}, "Cus bus (Smith, 1900)"},
+		{"zoo combination", "Aus bus (Smith, 1900)",
+			combination.Target{Genus: "Dus", Code: nomcode.Zoological},
+			"Dus bus (Smith, 1900)"},
+		{"zoo no comb authors", "Aus bus Smith 1900",
+			combination.Target{Genus: "Cus", Authors: "Jones"},
+			"Cus bus (Smith, 1900)"},
+		{"bot", "Aus bus L.",
+			combination.Target{Genus: "Cus", Authors: "Jones"},
+			"Cus bus (L.) Jones"},
+		{"bot recombination", "Aus bus (L.) Smith",
+			combination.Target{Genus: "Cus", Authors: "Jones"},
+			"Cus bus (L.) Jones"},
+		{"bot no comb authors", "Aus bus L.",
+			combination.Target{Genus: "Cus", Code: nomcode.Botanical},
+			"Cus bus (L.)"},
+		{"bacterial", "Aus bus Smith 1900",
+			combination.Target{Genus: "Cus", Authors: "Jones 1990",
+				Code: nomcode.Bacterial},
+			"Cus bus (Smith 1900) Jones 1990"},
+		{"gender", "Aus nigra L.",
+			combination.Target{Genus: "Cus", Authors: "Jones",
+				Gender: gender.Masculine},
+			"Cus niger (L.) Jones"},
+		{"gender infraspecies", "Aus nigra var. alba L.",
+			combination.Target{Genus: "Cus", Gender: gender.Neuter},
+			"Cus nigrum var. album (L.)"},
+		{"raise rank", "Aus bus var. cus Smith",
+			combination.Target{Rank: "sp.", Authors: "Jones"},
+			"Aus cus (Smith) Jones"},
+		{"lower rank", "Aus bus Smith",
+			combination.Target{Rank: "subsp.", Species: "dus",
+				Authors: "Jones"},
+			"Aus dus subsp. bus (Smith) Jones"},
+		{"change rank", "Aus bus var. cus Smith",
+			combination.Target{Rank: "subsp.", Authors: "Jones"},
+			"Aus bus subsp. cus (Smith) Jones"},
+		{"zoo lower rank", "Aus bus Smith, 1900",
+			combination.Target{Rank: "subsp.", Species: "dus"},
+			"Aus dus bus Smith, 1900"},
+		{"zoo lower rank parens", "Cus bus (Smith, 1900)",
+			combination.Target{Rank: "ssp.", Species: "dus",
+				Code: nomcode.Zoological},
+			"Cus dus bus (Smith, 1900)"},
+		{"zoo raise rank", "Aus bus cus Smith, 1900",
+			combination.Target{Genus: "Dus", Rank: "species"},
+			"Dus cus (Smith, 1900)"},
+		{"subgenus kept", "Aus (Bus) cus Smith, 1900",
+			combination.Target{Rank: "subsp.", Species: "dus"},
+			"Aus (Bus) dus cus Smith, 1900"},
+		{"subgenus removed", "Aus (Bus) cus Smith, 1900",
+			combination.Target{Genus: "Dus"}, "Dus cus (Smith, 1900)"},
+		{"authors", "Aus bus Smith, Jones & Doe 1900",
+			combination.Target{Genus: "Cus"},
+			"Cus bus (Smith, Jones & Doe, 1900)"},
+		{"ex authors", "Aus bus Smith ex Jones",
+			combination.Target{Genus: "Cus", Authors: "Doe"},
+			"Cus bus (Smith ex Jones) Doe"},
+		{"no authors", "Aus bus",
+			combination.Target{Genus: "Cus", Authors: "Doe"}, "Cus bus"},
+	}
+
+	for _, v := range tests {
+		res, err := combination.New(parse(v.name, true), v.target)
+		assert.Nil(err, v.msg)
+		assert.Equal(v.res, res, v.msg)
+	}
+}
+
+func TestNewErrors(t *testing.T) {
+	assert := assert.New(t)
+	tests := []struct {
+		msg, name string
+		details   bool
+		target    combination.Target
+	}{
+		{"no target", "Aus bus L.", true, combination.Target{}},
+		{"no details", "Aus bus L.", false, combination.Target{Genus: "Cus"}},
+		{"not parsed", "not a name", true, combination.Target{Genus: "Cus"}},
+		{"uninomial", "Aus L.", true, combination.Target{Genus: "Cus"}},
+		{"hybrid formula", "Aus bus × Aus cus", true,
+			combination.Target{Genus: "Cus"}},
+		{"no species", "Aus bus L.", true,
+			combination.Target{Rank: "var."}},
+		{"species for species", "Aus bus var. cus L.", true,
+			combination.Target{Rank: "sp.", Species: "dus"}},
+	}
+
+	for _, v := range tests {
+		_, err := combination.New(parse(v.name, v.details), v.target)
+		assert.NotNil(err, v.msg)
+	}
+}
+
+// testParser parses names with details for Parse.
+type testParser struct{}
+
+func (testParser) ParseName(name string) parsed.Parsed {
+	return parse(name, true)
+}
+
+func TestParse(t *testing.T) {
+	assert := assert.New(t)
+	target := combination.Target{Genus: "Cus", Authors: "Jones"}
+	tests := []struct {
+		msg, code, inferred string
+		res                 string
+	}{
+		{"no code", "", "", "Cus bus (Smith, 1900)"},
+		{"code setting", "ICN", "", "Cus bus (Smith 1900) Jones"},
+		{"inferred code", "", "ICN", "Cus bus (Smith 1900) Jones"},
+	}
+
+	for _, v := range tests {
+		p := parse("Aus bus Smith, 1900", true)
+		p.NomCodeSetting = v.code
+		if v.inferred != "" {
+			p.NomCodeInferred = &parsed.CodeInference{Code: v.inferred}
+		}
+		name, res, err := combination.Parse(p, target, testParser{})
+		assert.Nil(err, v.msg)
+		assert.Equal(v.res, name, v.msg)
+		assert.Equal(name, res.Verbatim, v.msg)
+		assert.Equal("Cus bus", res.Canonical.Simple, v.msg)
+	}
+
+	_, _, err := combination.Parse(parse("Aus bus L.", false), target, testParser{})
+	assert.NotNil(err)
+}
@@ -0,0 +1,55 @@
+package gender
+
+import "github.com/gnames/gnparser/ent/parsed"
+
+// Check finds epithets of a parsed name that do not agree in gender with
+// its genus. The words argument contains parsed words of the name, genders
+// of genera are taken from the genders map. Every epithet that does not
+// agree gets a warning with the corrected epithet. Ambiguous epithets,
+// that can be nouns in apposition, get a warning that does not change the
+// quality of the name. Names with several genera, like hybrid formulas,
+// are not checked.
+func Check(
+	p parsed.Parsed,
+	words []parsed.Word,
+	genders map[string]Gender,
+) []parsed.QualityWarning {
+	if !p.Parsed || p.Virus {
+		return nil
+	}
+
+	var genus string
+	var epithets []string
+	for _, v := range words {
+		switch v.Type {
+		case parsed.GenusType:
+			if genus != "" {
+				return nil
+			}
+			genus = v.Normalized
+		case parsed.SpEpithetType, parsed.InfraspEpithetType:
+			epithets = append(epithets, v.Normalized)
+		}
+	}
+
+	g, ok := genders[genus]
+	if !ok || g == Unknown {
+		return nil
+	}
+
+	var res []parsed.QualityWarning
+	for _, v := range epithets {
+		e := Classify(v)
+		if e.Agrees(g) {
+			continue
+		}
+		w := parsed.GenderAgreementWarn
+		if e.Ambiguous {
+			w = parsed.GenderAgreementMaybeWarn
+		}
+		qw := w.NewQualityWarning()
+		qw.Correction = e.Inflect(g)
+		res = append(res, qw)
+	}
+	return res
+}
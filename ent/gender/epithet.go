@@ -0,0 +1,258 @@
+package gender
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/gnames/gnparser/ent/stemmer"
+)
+
+// Form is a grammatical form of an epithet.
+type Form int
+
+// Forms of epithets.
+const (
+	// UnknownForm means that the ending of an epithet is not recognized.
+	UnknownForm Form = iota
+
+	// Adjective is an epithet that changes its ending according to the
+	// gender of the genus ('albus', 'alba', 'album').
+	Adjective
+
+	// Invariable is an adjective with the same form for all genders
+	// ('repens', 'simplex').
+	Invariable
+
+	// Noun is a noun in apposition or in genitive ('smithii', 'rosae',
+	// 'silvicola'). It does not agree with the genus.
+	Noun
+)
+
+var formMap = map[Form]string{
+	UnknownForm: "unknown",
+	Adjective:   "adjective",
+	Invariable:  "invariable",
+	Noun:        "noun",
+}
+
+// String implements fmt.Stringer interface.
+func (f Form) String() string {
+	return formMap[f]
+}
+
+// Epithet is a classified specific or infraspecific epithet.
+type Epithet struct {
+	// Value is the epithet as it is written.
+	Value string
+
+	// Form is the grammatical form of the epithet.
+	Form Form
+
+	// Ambiguous is true for an adjective recognized only by its '-us',
+	// '-a' or '-um' ending. Nouns in apposition have the same endings
+	// ('Equus zebra', 'Vipera berus'), so its gender is less certain.
+	Ambiguous bool
+
+	// forms are masculine, feminine and neuter forms of an adjective.
+	forms map[Gender]string
+}
+
+// Inflect returns the form of the epithet that agrees with the given
+// gender. Epithets that are not adjectives are returned without changes.
+func (e Epithet) Inflect(g Gender) string {
+	if v, ok := e.forms[g]; ok {
+		return v
+	}
+	return e.Value
+}
+
+// Agrees checks if the epithet agrees with a genus of the given gender.
+// Epithets that are not adjectives always agree.
+func (e Epithet) Agrees(g Gender) bool {
+	return e.Inflect(g) == e.Value
+}
+
+// nounEndings are endings of epithets that are nouns and do not change
+// with the gender of the genus.
+var nounEndings = []string{"cola", "gena", "opsis", "orum", "arum", "aster"}
+
+// knownNouns are well-known nouns in apposition and substantive epithets
+// that look like adjectives ('Felis catus', 'Prunus cerasus').
+var knownNouns = []string{
+	"absinthium", "aquila", "asinus", "avium", "betulus", "berus",
+	"caballus", "carota", "catus", "cepa", "cerasus", "dracunculus",
+	"elaphus", "hemionus", "lagopus", "lupus", "malus", "napus", "padus",
+	"pardus", "pica", "pseudoplatanus", "pulegium", "scrofa", "suber",
+	"zebra",
+}
+
+// adjectiveStemEndings are endings of stems of adjectives formed with
+// adjectival suffixes ('sinicus', 'montanus', 'ramosus', 'hirsutus',
+// 'aureus'). Other epithets in '-us', '-a' or '-um' can be nouns.
+var adjectiveStemEndings = []string{
+	"ic", "in", "an", "os", "at", "it", "ut", "iv", "id", "e",
+}
+
+// genitiveSuffixes are suffixes of the stemmer that mark nouns in
+// genitive ('smithi', 'smithii', 'annae').
+var genitiveSuffixes = []string{"i", "ii", "ae"}
+
+// invariableEndings are endings of one-termination adjectives.
+var invariableEndings = []string{
+	"color", "oides", "ans", "ens", "ceps", "plex", "ax", "ex", "ix", "ox",
+	"ux", "rs",
+}
+
+// keepE are adjectives in '-er' that keep 'e' in feminine and neuter forms
+// ('asper', 'aspera', 'asperum'). Compounds in '-fer' and '-ger' keep it as
+// well.
+var keepE = []string{
+	"asper", "exter", "lacer", "liber", "miser", "prosper", "tener",
+}
+
+// thirdER are adjectives with three terminations of the third declension
+// ('acer', 'acris', 'acre'). Adjectives in '-ester' and '-uster' belong to
+// this group too. Their masculine forms in '-is' ('silvestris') are in
+// common use, so only the forms in '-er' are corrected.
+var thirdER = []string{
+	"acer", "alacer", "celeber", "saluber", "volucer",
+}
+
+// comparatives are comparative adjectives that do not end with '-ior' or
+// '-erius' ('major', 'major', 'majus').
+var comparatives = []string{"major", "majus", "minor", "minus"}
+
+// Classify finds the form of an epithet and, for adjectives, its forms for
+// all genders.
+func Classify(epithet string) Epithet {
+	res := Epithet{Value: epithet}
+	if len(epithet) < 4 {
+		return res
+	}
+
+	if slices.Contains(knownNouns, epithet) {
+		res.Form = Noun
+		return res
+	}
+	for _, v := range nounEndings {
+		if strings.HasSuffix(epithet, v) {
+			res.Form = Noun
+			return res
+		}
+	}
+
+	switch {
+	case isComparative(epithet):
+		stem := epithet[:len(epithet)-2]
+		return adjective(res, stem+"or", stem+"or", stem+"us")
+	case strings.HasSuffix(epithet, "er"):
+		return erAdjective(res, epithet[:len(epithet)-2])
+	}
+
+	for _, v := range invariableEndings {
+		if strings.HasSuffix(epithet, v) {
+			res.Form = Invariable
+			return res
+		}
+	}
+
+	suffix := stemmer.Stem(epithet).Suffix
+	stem, ok := strings.CutSuffix(epithet, suffix)
+	if suffix == "" || !ok {
+		return res
+	}
+	if slices.Contains(genitiveSuffixes, suffix) {
+		res.Form = Noun
+		return res
+	}
+
+	switch suffix {
+	case "us", "a", "um":
+		if masc, ok := erMasculine(stem); ok {
+			return adjective(res, masc, stem+"a", stem+"um")
+		}
+		res.Ambiguous = !hasAdjectiveStem(stem)
+		return adjective(res, stem+"us", stem+"a", stem+"um")
+	case "ius", "ia":
+		return adjective(res, stem+"ius", stem+"ia", stem+"ium")
+	case "is", "e":
+		return adjective(res, stem+"is", stem+"is", stem+"e")
+	}
+	return res
+}
+
+func adjective(e Epithet, masc, fem, neut string) Epithet {
+	e.Form = Adjective
+	e.forms = map[Gender]string{
+		Masculine: masc,
+		Feminine:  fem,
+		Neuter:    neut,
+	}
+	return e
+}
+
+// erAdjective creates forms of adjectives in '-er': 'niger', 'nigra',
+// 'nigrum'; 'asper', 'aspera', 'asperum'; 'silvester', 'silvestris',
+// 'silvestre'. The stem is the epithet without '-er'.
+func erAdjective(e Epithet, stem string) Epithet {
+	masc := stem + "er"
+	switch {
+	case isThirdER(masc):
+		return adjective(e, masc, stem+"ris", stem+"re")
+	case isKeepE(masc):
+		return adjective(e, masc, masc+"a", masc+"um")
+	default:
+		return adjective(e, masc, stem+"ra", stem+"rum")
+	}
+}
+
+// erMasculine finds the masculine form of a feminine or neuter adjective
+// that has a masculine form in '-er' ('nigra' to 'niger', 'lanifera' to
+// 'lanifer'). The stem is the epithet without '-a' or '-um'.
+func erMasculine(stem string) (string, bool) {
+	if isKeepE(stem) {
+		return stem, true
+	}
+	n := len(stem)
+	if n < 3 || stem[n-1] != 'r' || isVowel(stem[n-2]) {
+		return "", false
+	}
+	masc := stem[:n-1] + "er"
+	if isThirdER(masc) {
+		return "", false
+	}
+	return masc, true
+}
+
+func hasAdjectiveStem(stem string) bool {
+	for _, v := range adjectiveStemEndings {
+		if strings.HasSuffix(stem, v) {
+			return true
+		}
+	}
+	return false
+}
+
+func isComparative(s string) bool {
+	return slices.Contains(comparatives, s) ||
+		strings.HasSuffix(s, "ior") || strings.HasSuffix(s, "erius")
+}
+
+func isThirdER(masc string) bool {
+	return slices.Contains(thirdER, masc) ||
+		strings.HasSuffix(masc, "ester") || strings.HasSuffix(masc, "uster")
+}
+
+// isKeepE finds adjectives in '-er' that keep 'e'. 'niger' is too short
+// to be a compound in '-iger'.
+func isKeepE(masc string) bool {
+	if slices.Contains(keepE, masc) {
+		return true
+	}
+	return len(masc) > 5 &&
+		(strings.HasSuffix(masc, "ifer") || strings.HasSuffix(masc, "iger"))
+}
+
+func isVowel(b byte) bool {
+	return strings.IndexByte("aeiouy", b) > -1
+}
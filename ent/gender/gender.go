@@ -0,0 +1,58 @@
+// Package gender checks Latin gender agreement between a genus and its
+// epithets.
+//
+// Adjectival epithets agree in gender with the genus (ICN Art. 23.5,
+// 32.2; ICZN Art. 31.2), so when a species is moved to a genus of another
+// gender its epithet has to change the ending ('Aus nigra' becomes
+// 'Bus niger' in a masculine genus). Nouns in apposition and in genitive,
+// as well as one-termination adjectives, do not change.
+//
+// Endings of epithets are found with the suffix table of the stemmer
+// package. Genders of genera cannot be inferred reliably from their
+// endings (Pinus and Quercus are feminine), so they come from
+// a dictionary.
+package gender
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Gender is a grammatical gender of a genus name or of an adjectival
+// epithet.
+type Gender int
+
+// Genders of Latin words.
+const (
+	Unknown Gender = iota
+	Masculine
+	Feminine
+	Neuter
+)
+
+var genderMap = map[Gender]string{
+	Masculine: "masculine",
+	Feminine:  "feminine",
+	Neuter:    "neuter",
+}
+
+// String implements fmt.Stringer interface.
+func (g Gender) String() string {
+	return genderMap[g]
+}
+
+// New converts a string to Gender. It accepts full names of genders and
+// their abbreviations: 'm', 'masc', 'f', 'fem', 'n', 'neut'.
+func New(s string) (Gender, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "m", "m.", "masc", "masc.", "masculine":
+		return Masculine, nil
+	case "f", "f.", "fem", "fem.", "feminine":
+		return Feminine, nil
+	case "n", "n.", "neut", "neut.", "neuter":
+		return Neuter, nil
+	}
+	return Unknown, fmt.Errorf(
+		"unknown gender '%s', use 'm', 'f' or 'n'", s,
+	)
+}
@@ -0,0 +1,149 @@
+package gender_test
+
+import (
+	"testing"
+
+	"github.com/gnames/gnlib/ent/nomcode"
+	"github.com/gnames/gnparser/ent/gender"
+	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/gnames/gnparser/ent/parser"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew(t *testing.T) {
+	assert := assert.New(t)
+	tests := []struct {
+		s      string
+		g      gender.Gender
+		hasErr bool
+	}{
+		{"m", gender.Masculine, false},
+		{"Masc.", gender.Masculine, false},
+		{" f ", gender.Feminine, false},
+		{"feminine", gender.Feminine, false},
+		{"n", gender.Neuter, false},
+		{"neut", gender.Neuter, false},
+		{"x", gender.Unknown, true},
+		{"", gender.Unknown, true},
+	}
+
+	for _, v := range tests {
+		g, err := gender.New(v.s)
+		assert.Equal(v.hasErr, err != nil, v.s)
+		assert.Equal(v.g, g, v.s)
+	}
+	assert.Equal("feminine", gender.Feminine.String())
+	assert.Equal("", gender.Unknown.String())
+}
+
+func TestClassify(t *testing.T) {
+	assert := assert.New(t)
+	tests := []struct {
+		epithet         string
+		form            gender.Form
+		masc, fem, neut string
+	}{
+		{"albus", gender.Adjective, "albus", "alba", "album"},
+		{"alba", gender.Adjective, "albus", "alba", "album"},
+		{"album", gender.Adjective, "albus", "alba", "album"},
+		{"niger", gender.Adjective, "niger", "nigra", "nigrum"},
+		{"nigra", gender.Adjective, "niger", "nigra", "nigrum"},
+		{"ruber", gender.Adjective, "ruber", "rubra", "rubrum"},
+		{"asper", gender.Adjective, "asper", "aspera", "asperum"},
+		{"aspera", gender.Adjective, "asper", "aspera", "asperum"},
+		{"lanigera", gender.Adjective, "laniger", "lanigera", "lanigerum"},
+		{"silvester", gender.Adjective, "silvester", "silvestris", "silvestre"},
+		{"acer", gender.Adjective, "acer", "acris", "acre"},
+		{"varius", gender.Adjective, "varius", "varia", "varium"},
+		{"campestris", gender.Adjective, "campestris", "campestris",
+			"campestre"},
+		{"campestre", gender.Adjective, "campestris", "campestris",
+			"campestre"},
+		{"minor", gender.Adjective, "minor", "minor", "minus"},
+		{"minus", gender.Adjective, "minor", "minor", "minus"},
+		{"superior", gender.Adjective, "superior", "superior", "superius"},
+		{"repens", gender.Invariable, "repens", "repens", "repens"},
+		{"simplex", gender.Invariable, "simplex", "simplex", "simplex"},
+		{"discolor", gender.Invariable, "discolor", "discolor", "discolor"},
+		{"smithii", gender.Noun, "smithii", "smithii", "smithii"},
+		{"rosae", gender.Noun, "rosae", "rosae", "rosae"},
+		{"silvicola", gender.Noun, "silvicola", "silvicola", "silvicola"},
+		{"bus", gender.UnknownForm, "bus", "bus", "bus"},
+		{"zebra", gender.Noun, "zebra", "zebra", "zebra"},
+		{"berus", gender.Noun, "berus", "berus", "berus"},
+		{"catus", gender.Noun, "catus", "catus", "catus"},
+		{"pinaster", gender.Noun, "pinaster", "pinaster", "pinaster"},
+	}
+
+	for _, v := range tests {
+		e := gender.Classify(v.epithet)
+		assert.Equal(v.form, e.Form, v.epithet)
+		assert.Equal(v.masc, e.Inflect(gender.Masculine), v.epithet)
+		assert.Equal(v.fem, e.Inflect(gender.Feminine), v.epithet)
+		assert.Equal(v.neut, e.Inflect(gender.Neuter), v.epithet)
+		assert.Equal(v.epithet, e.Inflect(gender.Unknown), v.epithet)
+	}
+
+	// adjectives recognized only by '-us', '-a', '-um' endings are
+	// ambiguous.
+	ambiguous := map[string]bool{
+		"albus": true, "alba": true, "lupa": true, "sinicus": false,
+		"montana": false, "ramosum": false, "hirsutus": false,
+		"aurea": false, "nigra": false, "varius": false,
+	}
+	for k, v := range ambiguous {
+		e := gender.Classify(k)
+		assert.Equal(gender.Adjective, e.Form, k)
+		assert.Equal(v, e.Ambiguous, k)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	assert := assert.New(t)
+	genders := map[string]gender.Gender{
+		"Aus":    gender.Feminine,
+		"Bus":    gender.Masculine,
+		"Equus":  gender.Masculine,
+		"Vipera": gender.Feminine,
+		"Felis":  gender.Feminine,
+		"Prunus": gender.Feminine,
+		"Pinus":  gender.Feminine,
+	}
+	dis, maybe := parsed.GenderAgreementWarn, parsed.GenderAgreementMaybeWarn
+	tests := []struct {
+		msg, name   string
+		corrections []string
+		warnings    []parsed.Warning
+	}{
+		{"species", "Aus niger Smith", []string{"nigra"},
+			[]parsed.Warning{dis}},
+		{"suffix", "Aus sinicus", []string{"sinica"}, []parsed.Warning{dis}},
+		{"infraspecies", "Bus albus alba", []string{"albus"},
+			[]parsed.Warning{maybe}},
+		{"both", "Bus nigra alba", []string{"niger", "albus"},
+			[]parsed.Warning{dis, maybe}},
+		{"agrees", "Aus nigra alba", nil, nil},
+		{"unknown genus", "Cus niger", nil, nil},
+		{"uninomial", "Aus", nil, nil},
+		{"hybrid formula", "Aus niger × Bus albus", nil, nil},
+		{"not parsed", "not a name", nil, nil},
+		{"noun zebra", "Equus zebra L.", nil, nil},
+		{"noun berus", "Vipera berus (L.)", nil, nil},
+		{"noun catus", "Felis catus L.", nil, nil},
+		{"noun cerasus", "Prunus cerasus L.", nil, nil},
+		{"noun -aster", "Pinus pinaster Aiton", nil, nil},
+	}
+
+	for _, v := range tests {
+		p := parser.New()
+		sn := p.PreprocessAndParse(v.name, "test", nomcode.Unknown,
+			false, false, false, false)
+		res := sn.ToOutput(true, false)
+		qws := gender.Check(res, res.Words, genders)
+		assert.Len(qws, len(v.corrections), v.msg)
+		for i, qw := range qws {
+			assert.Equal(v.warnings[i], qw.Warning, v.msg)
+			assert.Equal(v.corrections[i], qw.Correction, v.msg)
+		}
+	}
+}
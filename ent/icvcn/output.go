@@ -105,9 +105,10 @@ func (p *Parsed) details() parsed.Details {
 		// Binomial virus name
 		return parsed.DetailsSpeciesICVCN{
 			SpeciesICVCN: parsed.SpeciesICVCN{
-				Genus:   p.Genus,
-				Species: p.Species,
-				Rank:    p.Rank.String(),
+				Genus:    p.Genus,
+				Species:  p.Species,
+				Rank:     p.Rank.String(),
+				RankInfo: parsed.NewRankInfo(p.Rank.String()),
 			},
 		}
 	}
@@ -115,8 +116,9 @@ func (p *Parsed) details() parsed.Details {
 	// Uninomial virus name
 	return parsed.DetailsUninomialICVCN{
 		UninomialICVCN: parsed.UninomialICVCN{
-			Value: p.Uninomial,
-			Rank:  p.Rank.String(),
+			Value:    p.Uninomial,
+			Rank:     p.Rank.String(),
+			RankInfo: parsed.NewRankInfo(p.Rank.String()),
 		},
 	}
 }
@@ -0,0 +1,201 @@
+// Package orthography proposes corrected canonical forms of scientific
+// names according to orthographic rules of nomenclatural codes (ICN
+// Art. 60, ICZN Art. 32-34).
+//
+// The parser keeps names as they are written and only warns about some of
+// the problems. Corrections made by this package are suggestions: some of
+// the rules depend on information that a name-string does not contain, for
+// example the etymology of an epithet, or the date of its publication.
+// Every suggestion has a rule reference and a confidence, so a user can
+// decide which of them to accept.
+package orthography
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/gnames/gnlib/ent/nomcode"
+	"github.com/gnames/gnparser/ent/parsed"
+)
+
+// Identifiers of the orthographic rules.
+const (
+	// Diacritics rule removes diacritical signs and ligatures from epithets.
+	Diacritics = "DIACRITICS"
+
+	// CapitalizedEpithet rule converts capitalized epithets to lower case.
+	CapitalizedEpithet = "CAPITALIZED_EPITHET"
+
+	// ConnectingVowel rule corrects connecting vowels of compound
+	// epithets.
+	ConnectingVowel = "CONNECTING_VOWEL"
+
+	// Genitive rule corrects '-i' and '-ii' terminations of epithets
+	// formed from personal names.
+	Genitive = "GENITIVE"
+)
+
+// codes decides which rules are applicable for a nomenclatural code.
+type codes struct {
+	botanical, zoological bool
+}
+
+func newCodes(code nomcode.Code) codes {
+	switch code {
+	case nomcode.Unknown:
+		return codes{botanical: true, zoological: true}
+	case nomcode.Botanical, nomcode.Cultivars:
+		return codes{botanical: true}
+	case nomcode.Zoological:
+		return codes{zoological: true}
+	default:
+		return codes{}
+	}
+}
+
+// Suggest returns corrected canonical forms of a parsed name. The words
+// argument contains parsed words of the name (the same as the Words field
+// of the parsed.Parsed, when details are enabled). Only rules of the given
+// nomenclatural code are applied, if the code is unknown, rules of both
+// botanical and zoological codes are used. Suggestions are sorted by their
+// confidence, the most probable first.
+func Suggest(
+	p parsed.Parsed,
+	words []parsed.Word,
+	code nomcode.Code,
+) []parsed.Suggestion {
+	cs := newCodes(code)
+	if !p.Parsed || p.Canonical == nil || p.Virus || p.Hybrid != nil ||
+		p.GraftChimera != nil || (!cs.botanical && !cs.zoological) {
+		return nil
+	}
+
+	var res []parsed.Suggestion
+	canonical := strings.Fields(p.Canonical.Simple)
+	pos := canonicalPositions(words, canonical)
+	for i, w := range words {
+		if isEpithet(w.Type) {
+			if pos[i] < 0 {
+				continue
+			}
+			for _, c := range epithetCorrections(w, cs) {
+				res = append(res, c.suggestion(w.Verbatim, canonical, pos[i]))
+			}
+			continue
+		}
+		if c, ok := capitalizedEpithet(p, words, i, cs); ok {
+			sg := c.suggestion(w.Verbatim, canonical, len(canonical))
+			res = append(res, sg)
+		}
+	}
+	return sortSuggestions(res)
+}
+
+// canonicalPositions finds the position of every word in the canonical
+// form, or -1 if the word is not a part of it. Words are matched in order,
+// so repeated epithets of autonyms ('Aus bus var. bus') get their own
+// positions.
+func canonicalPositions(words []parsed.Word, canonical []string) []int {
+	res := make([]int, len(words))
+	var j int
+	for i, w := range words {
+		res[i] = -1
+		if j < len(canonical) && w.Normalized == canonical[j] {
+			res[i] = j
+			j++
+		}
+	}
+	return res
+}
+
+// correction is a corrected form of a word.
+type correction struct {
+	value      string
+	rule       string
+	reference  string
+	confidence float64
+}
+
+// suggestion creates a parsed.Suggestion for a corrected word. The
+// corrected word replaces the word at the idx position of the canonical
+// form, or it is appended to the canonical form if idx is after its end.
+func (c correction) suggestion(
+	verbatim string,
+	canonical []string,
+	idx int,
+) parsed.Suggestion {
+	can := slices.Clone(canonical)
+	if idx < len(can) {
+		can[idx] = c.value
+	} else {
+		can = append(can, c.value)
+	}
+	return parsed.Suggestion{
+		Canonical:  strings.Join(can, " "),
+		Verbatim:   verbatim,
+		Correction: c.value,
+		Rule:       c.rule,
+		Reference:  c.reference,
+		Confidence: c.confidence,
+	}
+}
+
+func isEpithet(wt parsed.WordType) bool {
+	return wt == parsed.SpEpithetType || wt == parsed.InfraspEpithetType
+}
+
+// epithetCorrections applies all rules relevant for lower-case epithets.
+func epithetCorrections(w parsed.Word, cs codes) []correction {
+	var res []correction
+	res = append(res, diacritics(w, cs)...)
+
+	// the rest of the rules are applied to the name as it is (or should be)
+	// written in the canonical form.
+	if !isASCII(w.Normalized) {
+		return res
+	}
+	if cs.botanical {
+		res = append(res, connectingVowel(w.Normalized)...)
+		res = append(res, genitive(w.Normalized)...)
+	}
+	return res
+}
+
+// sortSuggestions removes duplicate canonical forms, keeping the most
+// confident ones, and sorts suggestions by confidence.
+func sortSuggestions(sgs []parsed.Suggestion) []parsed.Suggestion {
+	if len(sgs) == 0 {
+		return nil
+	}
+	slices.SortStableFunc(sgs, func(a, b parsed.Suggestion) int {
+		switch {
+		case a.Confidence > b.Confidence:
+			return -1
+		case a.Confidence < b.Confidence:
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	seen := make(map[string]struct{})
+	res := make([]parsed.Suggestion, 0, len(sgs))
+	for _, v := range sgs {
+		if _, ok := seen[v.Canonical]; ok {
+			continue
+		}
+		seen[v.Canonical] = struct{}{}
+		res = append(res, v)
+	}
+	return res
+}
+
+func isASCII(s string) bool {
+	for _, r := range s {
+		if r > unicode.MaxASCII {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,129 @@
+package orthography_test
+
+import (
+	"testing"
+
+	"github.com/gnames/gnlib/ent/nomcode"
+	"github.com/gnames/gnparser/ent/orthography"
+	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/gnames/gnparser/ent/parser"
+	"github.com/stretchr/testify/assert"
+)
+
+func suggest(name string, code nomcode.Code) []parsed.Suggestion {
+	p := parser.New()
+	sn := p.PreprocessAndParse(name, "test", code, false, false, false, false)
+	res := sn.ToOutput(true, false)
+	return orthography.Suggest(res, res.Words, code)
+}
+
+func TestSuggest(t *testing.T) {
+	assert := assert.New(t)
+	tests := []struct {
+		msg, name  string
+		code       nomcode.Code
+		canonical  string
+		rule, ref  string
+		confidence float64
+		num        int
+	}{
+		{"icn diacritics", "Aus büsi Smith", nomcode.Botanical, "Aus buesi",
+			orthography.Diacritics, "ICN Art. 60.7", 0.9, 1},
+		{"iczn diacritics", "Aus büsi Smith", nomcode.Zoological, "Aus busi",
+			orthography.Diacritics, "ICZN Art. 32.5.2", 0.7, 2},
+		{"any diacritics", "Aus bøsi", nomcode.Unknown, "Aus boesi",
+			orthography.Diacritics, "ICN Art. 60.7", 0.9, 2},
+		{"ligature", "Aus cæsius", nomcode.Zoological, "Aus caesius",
+			orthography.Diacritics, "ICZN Art. 32.5.2", 0.7, 1},
+		{"capitalized", "Quercus Robur L.", nomcode.Botanical, "Quercus robur",
+			orthography.CapitalizedEpithet, "ICN Rec. 60F.1", 0.6, 1},
+		{"capitalized zoo", "Aus Bus Smith 1888", nomcode.Zoological,
+			"Aus bus", orthography.CapitalizedEpithet, "ICZN Art. 28", 0.4, 1},
+		{"connecting vowel", "Acer tiliaefolia L.", nomcode.Botanical,
+			"Acer tiliifolia", orthography.ConnectingVowel, "ICN Art. 60.10",
+			0.7, 1},
+		{"connecting vowel ae", "Rubus rosaefolius Sm.", nomcode.Unknown,
+			"Rubus rosifolius", orthography.ConnectingVowel, "ICN Art. 60.10",
+			0.7, 1},
+		{"connecting vowel o", "Aus albofolia", nomcode.Botanical,
+			"Aus albifolia", orthography.ConnectingVowel, "ICN Art. 60.10",
+			0.5, 1},
+		{"genitive -erii", "Rhododendron hookerii", nomcode.Botanical,
+			"Rhododendron hookeri", orthography.Genitive, "ICN Art. 60.12",
+			0.5, 1},
+		{"genitive -ii", "Salix jacksoni", nomcode.Botanical,
+			"Salix jacksonii", orthography.Genitive, "ICN Art. 60.12",
+			0.5, 1},
+		{"infraspecies", "Aus bus var. tiliaefolia", nomcode.Botanical,
+			"Aus bus tiliifolia", orthography.ConnectingVowel,
+			"ICN Art. 60.10", 0.7, 1},
+	}
+
+	for _, v := range tests {
+		res := suggest(v.name, v.code)
+		assert.Len(res, v.num, v.msg)
+		if len(res) == 0 {
+			continue
+		}
+		assert.Equal(v.canonical, res[0].Canonical, v.msg)
+		assert.Equal(v.rule, res[0].Rule, v.msg)
+		assert.Equal(v.ref, res[0].Reference, v.msg)
+		assert.Equal(v.confidence, res[0].Confidence, v.msg)
+	}
+}
+
+func TestSuggestAutonym(t *testing.T) {
+	assert := assert.New(t)
+	tests := []struct {
+		msg, name string
+		canonical []string
+	}{
+		{"genitive", "Aus jacksoni var. jacksoni",
+			[]string{"Aus jacksonii jacksoni", "Aus jacksoni jacksonii"}},
+		{"connecting vowel", "Aus tiliaefolia var. tiliaefolia L.",
+			[]string{"Aus tiliifolia tiliaefolia", "Aus tiliaefolia tiliifolia"}},
+	}
+
+	for _, v := range tests {
+		res := suggest(v.name, nomcode.Botanical)
+		can := make([]string, len(res))
+		for i := range res {
+			can[i] = res[i].Canonical
+		}
+		assert.Equal(v.canonical, can, v.msg)
+	}
+}
+
+func TestSuggestNone(t *testing.T) {
+	assert := assert.New(t)
+	tests := []struct {
+		msg, name string
+		code      nomcode.Code
+	}{
+		{"correct", "Acer tiliifolia L.", nomcode.Botanical},
+		{"zoo connecting vowel", "Aus tiliaefolia", nomcode.Zoological},
+		{"zoo genitive", "Aus jacksoni", nomcode.Zoological},
+		{"author", "Aus bus Smith & Jones", nomcode.Unknown},
+		{"authors", "Aus Smith & Jones", nomcode.Unknown},
+		{"particle", "Aus De Bus L.", nomcode.Unknown},
+		{"uppercase author", "Aus bus BUS L.", nomcode.Unknown},
+		{"bacterial", "Aus büsi", nomcode.Bacterial},
+		{"not parsed", "Not a name", nomcode.Unknown},
+	}
+
+	for _, v := range tests {
+		assert.Nil(suggest(v.name, v.code), v.msg)
+	}
+}
+
+func TestSuggestOrder(t *testing.T) {
+	assert := assert.New(t)
+	res := suggest("Aus büsi", nomcode.Zoological)
+	assert.Equal(2, len(res))
+	assert.Equal("Aus busi", res[0].Canonical)
+	assert.Equal("büsi", res[0].Verbatim)
+	assert.Equal("busi", res[0].Correction)
+	assert.Equal("Aus buesi", res[1].Canonical)
+	assert.Equal("ICZN Art. 32.5.2.1", res[1].Reference)
+	assert.Equal(0.3, res[1].Confidence)
+}
@@ -0,0 +1,236 @@
+package orthography
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/gnames/gnparser/ent/parsed"
+	"golang.org/x/text/unicode/norm"
+)
+
+// ligatures are characters that do not lose their 'diacritics' after
+// Unicode decomposition.
+var ligatures = map[rune]string{
+	'æ': "ae",
+	'œ': "oe",
+	'ø': "o",
+	'ß': "ss",
+	'đ': "d",
+	'ł': "l",
+}
+
+// umlauts are German vowels that, according to ICZN Art. 32.5.2.1, are
+// spelled with 'e' in names based on German words and published
+// before 1985.
+var umlauts = map[rune]string{
+	'ä': "ae",
+	'ö': "oe",
+	'ü': "ue",
+}
+
+// diacritics suggests epithets without diacritical signs.
+//
+// ICN Art. 60.7: diacritical signs are suppressed, ä, ö, ü become ae, oe,
+// ue, å becomes ao. The parser already does that for the canonical form,
+// so the normalized word is the correction.
+//
+// ICZN Art. 32.5.2: diacritical marks are removed, ligatures are
+// separated. For German names published before 1985 an umlaut is replaced
+// by the vowel with 'e' (Art. 32.5.2.1), but the date is not always known,
+// so this correction is less probable.
+func diacritics(w parsed.Word, cs codes) []correction {
+	if isASCII(w.Verbatim) || !isWord(w.Verbatim) {
+		return nil
+	}
+
+	var res []correction
+	if cs.botanical && isASCII(w.Normalized) {
+		res = append(res, correction{
+			value:      w.Normalized,
+			rule:       Diacritics,
+			reference:  "ICN Art. 60.7",
+			confidence: 0.9,
+		})
+	}
+	if !cs.zoological {
+		return res
+	}
+
+	res = append(res, correction{
+		value:      stripMarks(w.Verbatim, nil),
+		rule:       Diacritics,
+		reference:  "ICZN Art. 32.5.2",
+		confidence: 0.7,
+	})
+	if strings.ContainsAny(w.Verbatim, "äöü") {
+		res = append(res, correction{
+			value:      stripMarks(w.Verbatim, umlauts),
+			rule:       Diacritics,
+			reference:  "ICZN Art. 32.5.2.1",
+			confidence: 0.3,
+		})
+	}
+	return res
+}
+
+// stripMarks removes diacritical marks from a word. Runes from the
+// special map are replaced by their values first.
+func stripMarks(s string, special map[rune]string) string {
+	var sb strings.Builder
+	for _, r := range s {
+		if v, ok := special[r]; ok {
+			sb.WriteString(v)
+			continue
+		}
+		if v, ok := ligatures[r]; ok {
+			sb.WriteString(v)
+			continue
+		}
+		for _, d := range norm.NFD.String(string(r)) {
+			if !unicode.Is(unicode.Mn, d) {
+				sb.WriteRune(d)
+			}
+		}
+	}
+	return sb.String()
+}
+
+// isWord checks if a string consists of letters only.
+func isWord(s string) bool {
+	for _, r := range s {
+		if !unicode.IsLetter(r) {
+			return false
+		}
+	}
+	return s != ""
+}
+
+// capitalizedEpithet checks if the word with index i is an epithet that
+// the parser took for an author, because it starts with a capital letter,
+// as in 'Quercus Robur L.'. Such words follow a genus and start the first
+// author of the name, that has more than one word.
+//
+// ICN Rec. 60F.1 and ICZN Art. 28 require epithets to start with a
+// lower-case letter.
+func capitalizedEpithet(
+	p parsed.Parsed,
+	words []parsed.Word,
+	i int,
+	cs codes,
+) (correction, bool) {
+	var res correction
+	if i == 0 || i+1 >= len(words) || p.Authorship == nil ||
+		len(p.Authorship.Authors) == 0 {
+		return res, false
+	}
+	w := words[i]
+	prev := words[i-1].Type
+	next := words[i+1]
+	if w.Type != parsed.AuthorWordType ||
+		next.Type != parsed.AuthorWordType ||
+		(prev != parsed.GenusType && prev != parsed.UninomialType) ||
+		!strings.HasPrefix(p.Authorship.Authors[0], w.Verbatim+" ") {
+		return res, false
+	}
+
+	first, _ := utf8.DecodeRuneInString(w.Verbatim)
+	if !unicode.IsUpper(first) || !isWord(w.Verbatim) ||
+		!isASCII(w.Verbatim) || utf8.RuneCountInString(w.Verbatim) < 3 ||
+		strings.ToUpper(w.Verbatim) == w.Verbatim {
+		return res, false
+	}
+
+	res = correction{
+		value:      strings.ToLower(w.Verbatim),
+		rule:       CapitalizedEpithet,
+		reference:  "ICN Rec. 60F.1",
+		confidence: 0.4,
+	}
+	if !cs.botanical {
+		res.reference = "ICZN Art. 28"
+	}
+	// an abbreviated author after the word makes the word more likely
+	// to be an epithet.
+	if strings.HasSuffix(next.Verbatim, ".") {
+		res.confidence = 0.6
+	}
+	return res, true
+}
+
+// latinElements are Latin second parts of compound epithets. ICN Art.
+// 60.10 requires 'i' as the connecting vowel before them.
+var latinElements = []string{
+	"folia", "folius", "folium",
+	"flora", "florus", "florum",
+	"formis", "forme",
+	"color",
+	"fera", "ferus", "ferum",
+	"gera", "gerus", "gerum",
+}
+
+// connectingVowel corrects connecting vowels of compound epithets made of
+// Latin words, for example 'tiliaefolia' to 'tiliifolia' and 'rosaefolia'
+// to 'rosifolia' (ICN Art. 60.10, Rec. 60G.1). Zoological names are
+// not corrected, because ICZN Art. 32.5.1 does not consider such spellings
+// as incorrect.
+func connectingVowel(s string) []correction {
+	for _, el := range latinElements {
+		stem, ok := strings.CutSuffix(s, el)
+		if !ok || len(stem) < 3 {
+			continue
+		}
+		c := correction{
+			rule:       ConnectingVowel,
+			reference:  "ICN Art. 60.10",
+			confidence: 0.7,
+		}
+		switch {
+		case strings.HasSuffix(stem, "ae"):
+			c.value = strings.TrimSuffix(stem, "ae") + "i" + el
+		case strings.HasSuffix(stem, "o"):
+			c.value = strings.TrimSuffix(stem, "o") + "i" + el
+			c.confidence = 0.5
+		default:
+			continue
+		}
+		return []correction{c}
+	}
+	return nil
+}
+
+// personalStems are common endings of personal names. Epithets made from
+// such names that end on a consonant take '-ii' in genitive.
+var personalStems = []string{
+	"son", "sen", "man", "mann", "berg", "burg", "stein", "ton", "ard",
+	"ald", "ell", "ett", "ing",
+}
+
+// genitive corrects terminations of epithets formed from personal names
+// (ICN Art. 60.12, Rec. 60C.1). Names ending with '-er' take only '-i'
+// ('hookerii' to 'hookeri'), names ending with a consonant take '-ii'
+// ('jacksoni' to 'jacksonii'). ICZN Art. 33.4 preserves the original
+// spelling, so zoological names are not corrected.
+func genitive(s string) []correction {
+	c := correction{
+		rule:       Genitive,
+		reference:  "ICN Art. 60.12",
+		confidence: 0.5,
+	}
+	if stem, ok := strings.CutSuffix(s, "erii"); ok && len(stem) > 2 {
+		c.value = stem + "eri"
+		return []correction{c}
+	}
+
+	stem, ok := strings.CutSuffix(s, "i")
+	if !ok || strings.HasSuffix(stem, "i") {
+		return nil
+	}
+	for _, v := range personalStems {
+		if strings.HasSuffix(stem, v) && len(stem) > len(v)+1 {
+			c.value = s + "i"
+			return []correction{c}
+		}
+	}
+	return nil
+}
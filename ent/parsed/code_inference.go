@@ -0,0 +1,34 @@
+package parsed
+
+// CodeInference is a nomenclatural code that a name probably belongs to,
+// inferred from features of the name-string.
+type CodeInference struct {
+	// Code is the abbreviation of the inferred code: 'ICZN', 'ICN', 'ICNP',
+	// 'ICVCN' or 'ICNCP'.
+	Code string `json:"code"`
+
+	// Confidence is a number from 0 to 1. It is lower when the evidence
+	// is weak, or when some of the evidence points to other codes.
+	Confidence float64 `json:"confidence"`
+
+	// Evidence contains all features of the name that were used for the
+	// inference, including the ones that point to other codes.
+	Evidence []CodeEvidence `json:"evidence"`
+}
+
+// CodeEvidence is a feature of a name-string that points to
+// a nomenclatural code.
+type CodeEvidence struct {
+	// Code is the abbreviation of the code the feature points to.
+	Code string `json:"code"`
+
+	// Clue is the identifier of the feature, for example 'YEAR_COMMA' or
+	// 'BOTANICAL_RANK'.
+	Clue string `json:"clue"`
+
+	// Value is the part of the name-string that has the feature.
+	Value string `json:"value,omitempty"`
+
+	// Weight is the strength of the evidence from 0 to 1.
+	Weight float64 `json:"weight"`
+}
@@ -7,6 +7,8 @@ type Uninomial struct {
 	// Rank of the uninomial in a combination name, for example
 	// "Pereskia subg. Maihuenia Philippi ex F.A.C.Weber, 1898"
 	Rank string `json:"rank,omitempty"`
+	// RankInfo describes the rank with the standard vocabulary of ranks.
+	RankInfo *RankInfo `json:"rankInfo,omitempty"`
 	// Cultivar is a value of a cultivar of a uninomial.
 	Cultivar string `json:"cultivar,omitempty"`
 	// Parent of a uninomial in a combination name.
@@ -44,6 +46,8 @@ type InfraspeciesElem struct {
 	Value string `json:"value"`
 	// Rank of the infraspecific epithet.
 	Rank string `json:"rank,omitempty"`
+	// RankInfo describes the rank with the standard vocabulary of ranks.
+	RankInfo *RankInfo `json:"rankInfo,omitempty"`
 	// Authorship of the infraspecific epithet.
 	Authorship *Authorship `json:"authorship,omitempty"`
 }
@@ -86,6 +90,8 @@ type UninomialICVCN struct {
 	Value string `json:"uninomial"`
 	// Rank is the taxonomic rank of the ICVCN name.
 	Rank string `json:"rank"`
+	// RankInfo describes the rank with the standard vocabulary of ranks.
+	RankInfo *RankInfo `json:"rankInfo,omitempty"`
 }
 
 // SpeciesICVCN are details for species names according to
@@ -97,6 +103,8 @@ type SpeciesICVCN struct {
 	Species string `json:"species"`
 	// Rank is the taxonomic rank of the ICVCN name.
 	Rank string `json:"rank"`
+	// RankInfo describes the rank with the standard vocabulary of ranks.
+	RankInfo *RankInfo `json:"rankInfo,omitempty"`
 }
 
 // DetailsHybridFormula are details for a hybrid formula names.
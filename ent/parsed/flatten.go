@@ -30,6 +30,10 @@ type ParsedFlat struct {
 	// is set to 1. If parsing failed, the parseQuality is 0.
 	ParseQuality int `json:"quality"`
 
+	// QualityProfile is the name of the profile that assigned qualities to
+	// warnings. It is empty for the default profile.
+	QualityProfile string `json:"qualityProfile,omitempty"`
+
 	// Verbatim is input name-string without modifications.
 	Verbatim string `json:"verbatim"`
 
@@ -75,6 +79,25 @@ type ParsedFlat struct {
 	// omitted when the data for it does not exist.
 	Rank string `json:"rank,omitempty"`
 
+	// RankInferred is true if the rank is inferred from the standard ending
+	// of a uninomial.
+	RankInferred bool `json:"rankInferred,omitempty"`
+
+	// RankID is the identifier of the rank in the standard vocabulary of
+	// ranks, for example 'subspecies' for 'ssp.' and 'nothosubsp.'.
+	RankID string `json:"rankId,omitempty"`
+
+	// RankLevel is a number for sorting ranks from the highest to the
+	// lowest.
+	RankLevel int `json:"rankLevel,omitempty"`
+
+	// RankCode is the abbreviation of the nomenclatural code that uses the
+	// rank. It is empty for ranks used by several codes.
+	RankCode string `json:"rankCode,omitempty"`
+
+	// RankURI is the URI of the rank in the GBIF rank vocabulary.
+	RankURI string `json:"rankUri,omitempty"`
+
 	// Authorship is the verbatim authorship of the name.
 	Authorship string `json:"authorship,omitempty"`
 
@@ -231,10 +254,12 @@ func (p Parsed) Flatten() ParsedFlat {
 		Parsed:         p.Parsed,
 		NomCodeSetting: p.NomCodeSetting,
 		ParseQuality:   p.ParseQuality,
+		QualityProfile: p.QualityProfile,
 		Verbatim:       p.Verbatim,
 		Normalized:     p.Normalized,
 		Cardinality:    p.Cardinality,
 		Rank:           p.Rank,
+		RankInferred:   p.RankInferred,
 		Candidatus:     p.Candidatus,
 		Virus:          p.Virus,
 		Cultivar:       p.Cultivar,
@@ -287,6 +312,13 @@ func (p Parsed) Flatten() ParsedFlat {
 			res.Infraspecies = detail.Infraspecies.Infraspecies[0].Value
 		}
 	}
+
+	if ri := NewRankInfo(res.Rank); ri != nil {
+		res.RankID = ri.ID
+		res.RankLevel = ri.Level
+		res.RankCode = ri.Code
+		res.RankURI = ri.URI
+	}
 	return res
 }
 
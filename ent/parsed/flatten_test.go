@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/gnames/gnparser/ent/rank"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -64,6 +65,9 @@ func TestFlatten(t *testing.T) {
 				Normalized:                "Aus bus",
 				Cardinality:               2,
 				Rank:                      "species",
+				RankID:                    "species",
+				RankLevel:                 80,
+				RankURI:                   rank.URIPrefix + "species",
 				Candidatus:                true,
 				Virus:                     false,
 				Cultivar:                  false,
@@ -130,6 +134,9 @@ func TestFlatten(t *testing.T) {
 				Verbatim:        "Asteraceae",
 				Cardinality:     1,
 				Rank:            "family",
+				RankID:          "family",
+				RankLevel:       60,
+				RankURI:         rank.URIPrefix + "family",
 				VerbatimID:      "test-123",
 				ParserVersion:   "1.0.0",
 				CanonicalSimple: "Asteraceae",
@@ -167,6 +174,10 @@ func TestFlatten(t *testing.T) {
 				Verbatim:        "Aus bus var. cus",
 				Cardinality:     3,
 				Rank:            "var.",
+				RankID:          "variety",
+				RankLevel:       85,
+				RankCode:        "ICN",
+				RankURI:         rank.URIPrefix + "variety",
 				VerbatimID:      "test-456",
 				ParserVersion:   "1.0.0",
 				CanonicalSimple: "Aus bus cus",
@@ -0,0 +1,156 @@
+package parsed
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/gnames/gnparser/ent/rank"
+	"github.com/gnames/gnparser/ent/stemmer"
+)
+
+// HierarchyElem is a name implied by a parsed name: its genus, species,
+// autonyms and the name itself.
+type HierarchyElem struct {
+	// Rank of the name: 'gen.', 'sp.' or a rank of an infraspecific
+	// name ('subsp.', 'var.' etc.). It is empty if the rank is not given
+	// in the name-string, or cannot be implied from it.
+	Rank string `json:"rank,omitempty"`
+
+	// Canonical forms of the name.
+	Canonical Canonical `json:"canonical"`
+
+	// Authorship of the name, if it is known from the name-string.
+	Authorship *Authorship `json:"authorship,omitempty"`
+
+	// IsAutonym is true for an ICN autonym ('Aus bus var. bus'), or for an
+	// ICZN nominotypical subspecies ('Aus bus bus'). According to ICN Art.
+	// 26.1 autonyms have no authorship, a nominotypical subspecies has the
+	// authorship of its species (ICZN Art. 47.1).
+	IsAutonym bool `json:"isAutonym,omitempty"`
+}
+
+// NameHierarchy returns the ordered list of names implied by the parsed
+// name, from the genus to the name itself. Every infraspecific name
+// implies an autonym of the same rank ('Aus bus var. cus' implies
+// 'Aus bus var. bus'). The hierarchy is derived from details, so it is
+// empty for names without details, and for hybrid formulas, surrogates
+// and viruses. Autonyms follow the ICZN rules if the code is set to ICZN,
+// or, without the setting, if the code inferred for the name is ICZN.
+func (p Parsed) NameHierarchy() []HierarchyElem {
+	switch d := p.Details.(type) {
+	case DetailsUninomial:
+		return uninomialHierarchy(d.Uninomial)
+	case DetailsSpecies:
+		return speciesHierarchy(d.Species)
+	case DetailsInfraspecies:
+		code := p.NomCodeSetting
+		if code == "" && p.NomCodeInferred != nil {
+			code = p.NomCodeInferred.Code
+		}
+		return infraspeciesHierarchy(d.Infraspecies, code == "ICZN")
+	}
+	return nil
+}
+
+// uninomialHierarchy adds the parent of a combination ('Aus subgen. Bus').
+// The parent is a genus only if the rank of the combination is below genus,
+// otherwise ('Poaceae subtrib. Scolochloinae') its rank is unknown.
+func uninomialHierarchy(u Uninomial) []HierarchyElem {
+	if u.Parent == "" {
+		return []HierarchyElem{
+			hierarchyElem(u.Rank, []string{u.Value}, u.Authorship),
+		}
+	}
+	var parentRank string
+	if rank.New(u.Rank).Level() > rank.Genus.Level() {
+		parentRank = "gen."
+	}
+	return []HierarchyElem{
+		hierarchyElem(parentRank, []string{u.Parent}, nil),
+		{
+			Rank: u.Rank,
+			Canonical: Canonical{
+				Stemmed: u.Value,
+				Simple:  u.Value,
+				Full:    u.Parent + " " + u.Rank + " " + u.Value,
+			},
+			Authorship: u.Authorship,
+		},
+	}
+}
+
+func speciesHierarchy(sp Species) []HierarchyElem {
+	return []HierarchyElem{
+		hierarchyElem("gen.", []string{sp.Genus}, nil),
+		hierarchyElem("sp.", []string{sp.Genus, sp.Species}, sp.Authorship),
+	}
+}
+
+// infraspeciesHierarchy adds an autonym before every infraspecific name
+// that is not an autonym itself. For the ICZN a nominotypical subspecies
+// gets the authorship of the species.
+func infraspeciesHierarchy(isp Infraspecies, zoo bool) []HierarchyElem {
+	res := speciesHierarchy(isp.Species)
+	words := []string{isp.Genus, isp.Species.Species}
+	ranks := []string{"", ""}
+	parent := isp.Species.Species
+	parentAuth := isp.Species.Authorship
+	for _, v := range isp.Infraspecies {
+		var autonymAuth *Authorship
+		if zoo {
+			autonymAuth = parentAuth
+		}
+		autonym := hierarchyElemRanked(
+			v.Rank,
+			slices.Concat(words, []string{parent}),
+			slices.Concat(ranks, []string{v.Rank}),
+			autonymAuth,
+		)
+		autonym.IsAutonym = true
+
+		words = append(words, v.Value)
+		ranks = append(ranks, v.Rank)
+		if v.Value == parent {
+			res = append(res, autonym)
+			continue
+		}
+		res = append(res, autonym,
+			hierarchyElemRanked(v.Rank, words, ranks, v.Authorship))
+		parent, parentAuth = v.Value, v.Authorship
+	}
+	return res
+}
+
+func hierarchyElem(rank string, words []string, au *Authorship) HierarchyElem {
+	return hierarchyElemRanked(rank, words, make([]string, len(words)), au)
+}
+
+// hierarchyElemRanked creates canonical forms from words of a name and
+// ranks that precede them in the full canonical form.
+func hierarchyElemRanked(
+	rank string,
+	words, ranks []string,
+	au *Authorship,
+) HierarchyElem {
+	simple := strings.Join(words, " ")
+	full := make([]string, 0, 2*len(words))
+	for i, v := range words {
+		if ranks[i] != "" {
+			full = append(full, ranks[i])
+		}
+		full = append(full, v)
+	}
+	stemmed := simple
+	if len(words) > 1 {
+		stemmed = stemmer.StemCanonical(simple)
+	}
+	return HierarchyElem{
+		Rank: rank,
+		Canonical: Canonical{
+			Stemmed: stemmed,
+			Simple:  simple,
+			Full:    strings.Join(full, " "),
+		},
+		Authorship: au,
+	}
+}
@@ -0,0 +1,123 @@
+package parsed_test
+
+import (
+	"testing"
+
+	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNameHierarchy(t *testing.T) {
+	assert := assert.New(t)
+	spAuth := &parsed.Authorship{Normalized: "Smith", Authors: []string{"Smith"}}
+	ispAuth := &parsed.Authorship{Normalized: "Jones", Authors: []string{"Jones"}}
+	isp := parsed.Infraspecies{
+		Species: parsed.Species{
+			Genus: "Aus", Species: "bus", Authorship: spAuth,
+		},
+		Infraspecies: []parsed.InfraspeciesElem{
+			{Value: "cus", Rank: "subsp."},
+			{Value: "dus", Rank: "var.", Authorship: ispAuth},
+		},
+	}
+	p := parsed.Parsed{Details: parsed.DetailsInfraspecies{Infraspecies: isp}}
+
+	res := p.NameHierarchy()
+	full := make([]string, len(res))
+	for i, v := range res {
+		full[i] = v.Canonical.Full
+	}
+	assert.Equal([]string{
+		"Aus",
+		"Aus bus",
+		"Aus bus subsp. bus",
+		"Aus bus subsp. cus",
+		"Aus bus subsp. cus var. cus",
+		"Aus bus subsp. cus var. dus",
+	}, full)
+
+	assert.Equal("gen.", res[0].Rank)
+	assert.Equal("sp.", res[1].Rank)
+	assert.Equal(spAuth, res[1].Authorship)
+	assert.Equal("subsp.", res[2].Rank)
+	assert.True(res[2].IsAutonym)
+	assert.Nil(res[2].Authorship)
+	assert.Equal("Aus bus bus", res[2].Canonical.Simple)
+	assert.False(res[3].IsAutonym)
+	assert.Equal("var.", res[5].Rank)
+	assert.Equal(ispAuth, res[5].Authorship)
+	assert.Equal("Aus bus cus dus", res[5].Canonical.Simple)
+
+	// ICZN nominotypical subspecies has authorship of the species.
+	isp.Infraspecies = []parsed.InfraspeciesElem{{Value: "cus"}}
+	p = parsed.Parsed{
+		NomCodeSetting: "ICZN",
+		Details:        parsed.DetailsInfraspecies{Infraspecies: isp},
+	}
+	res = p.NameHierarchy()
+	assert.Len(res, 4)
+	assert.Equal("Aus bus bus", res[2].Canonical.Full)
+	assert.Equal("", res[2].Rank)
+	assert.True(res[2].IsAutonym)
+	assert.Equal(spAuth, res[2].Authorship)
+
+	// without the setting the inferred code is used.
+	p = parsed.Parsed{
+		NomCodeInferred: &parsed.CodeInference{Code: "ICZN"},
+		Details:         parsed.DetailsInfraspecies{Infraspecies: isp},
+	}
+	res = p.NameHierarchy()
+	assert.Equal(spAuth, res[2].Authorship)
+	p.NomCodeSetting = "ICN"
+	res = p.NameHierarchy()
+	assert.Nil(res[2].Authorship)
+
+	// autonym is not repeated.
+	isp.Infraspecies = []parsed.InfraspeciesElem{{Value: "bus", Rank: "var."}}
+	p = parsed.Parsed{Details: parsed.DetailsInfraspecies{Infraspecies: isp}}
+	res = p.NameHierarchy()
+	assert.Len(res, 3)
+	assert.Equal("Aus bus var. bus", res[2].Canonical.Full)
+	assert.True(res[2].IsAutonym)
+}
+
+func TestNameHierarchyOther(t *testing.T) {
+	assert := assert.New(t)
+	p := parsed.Parsed{Details: parsed.DetailsUninomial{
+		Uninomial: parsed.Uninomial{Value: "Bus", Rank: "subgen.", Parent: "Aus"},
+	}}
+	res := p.NameHierarchy()
+	assert.Len(res, 2)
+	assert.Equal("Aus", res[0].Canonical.Simple)
+	assert.Equal("Bus", res[1].Canonical.Simple)
+	assert.Equal("Aus subgen. Bus", res[1].Canonical.Full)
+	assert.Equal("gen.", res[0].Rank)
+
+	// parent of a rank above genus is not a genus.
+	p = parsed.Parsed{Details: parsed.DetailsUninomial{
+		Uninomial: parsed.Uninomial{
+			Value: "Scolochloinae", Rank: "subtrib.", Parent: "Poaceae",
+		},
+	}}
+	res = p.NameHierarchy()
+	assert.Len(res, 2)
+	assert.Equal("", res[0].Rank)
+	assert.Equal("Poaceae", res[0].Canonical.Simple)
+	assert.Equal("subtrib.", res[1].Rank)
+
+	p = parsed.Parsed{Details: parsed.DetailsUninomial{
+		Uninomial: parsed.Uninomial{Value: "Aus"},
+	}}
+	assert.Len(p.NameHierarchy(), 1)
+
+	p = parsed.Parsed{Details: parsed.DetailsSpecies{
+		Species: parsed.Species{Genus: "Aus", Species: "bus"},
+	}}
+	res = p.NameHierarchy()
+	assert.Len(res, 2)
+	assert.Equal("Aus bus", res[1].Canonical.Simple)
+
+	p = parsed.Parsed{Details: parsed.DetailsHybridFormula{}}
+	assert.Nil(p.NameHierarchy())
+	assert.Nil(parsed.Parsed{}.NameHierarchy())
+}
@@ -0,0 +1,136 @@
+package parsed
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// DefaultLanguage is the language of warning messages and labels of word
+// types when no other language is selected. Messages in this language are
+// also the values of the 'warning' field of the output.
+const DefaultLanguage = "en"
+
+// catalog contains translations of warning messages and labels of word
+// types into one language. Stable codes of warnings and names of word
+// types are not translated.
+type catalog struct {
+	// name is the name of the language in the language itself.
+	name string
+
+	// warnings are translations of messages from warningMap.
+	warnings map[Warning]string
+
+	// wordTypes are human-readable labels of word types.
+	wordTypes map[WordType]string
+}
+
+// catalogs contain message catalogs keyed by ISO 639-1 language codes.
+var catalogs = map[string]catalog{
+	"de": catalogDe,
+	"en": catalogEn,
+	"es": catalogEs,
+	"fr": catalogFr,
+	"pt": catalogPt,
+}
+
+// languages contains supported languages, the default one is the first.
+var languages = func() []string {
+	res := make([]string, 0, len(catalogs))
+	for k := range catalogs {
+		if k != DefaultLanguage {
+			res = append(res, k)
+		}
+	}
+	slices.Sort(res)
+	return append([]string{DefaultLanguage}, res...)
+}()
+
+var languageMatcher = func() language.Matcher {
+	tags := make([]language.Tag, len(languages))
+	for i, v := range languages {
+		tags[i] = language.Make(v)
+	}
+	return language.NewMatcher(tags)
+}()
+
+// Languages returns codes of supported languages, starting with
+// DefaultLanguage.
+func Languages() []string {
+	return slices.Clone(languages)
+}
+
+// LanguageName returns the name of a supported language in the language
+// itself, for example 'Español' for 'es'.
+func LanguageName(lang string) string {
+	return catalogs[lang].name
+}
+
+// NewLanguage returns the code of a supported language from a language
+// tag. Regional variants are reduced to their language, so 'pt-BR' or
+// 'pt_BR' become 'pt'. An empty tag means DefaultLanguage.
+func NewLanguage(tag string) (string, error) {
+	tag = strings.TrimSpace(tag)
+	if tag == "" {
+		return DefaultLanguage, nil
+	}
+	lang, _, _ := strings.Cut(strings.ReplaceAll(tag, "_", "-"), "-")
+	lang = strings.ToLower(lang)
+	if _, ok := catalogs[lang]; !ok {
+		return "", fmt.Errorf(
+			"unsupported language '%s', use %s", tag,
+			strings.Join(languages, ", "),
+		)
+	}
+	return lang, nil
+}
+
+// MatchLanguage returns the supported language that fits best to the
+// value of an HTTP Accept-Language header, or DefaultLanguage if none of
+// the accepted languages is supported.
+func MatchLanguage(acceptLanguage string) string {
+	tags, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil || len(tags) == 0 {
+		return DefaultLanguage
+	}
+	_, idx, conf := languageMatcher.Match(tags...)
+	if conf == language.No {
+		return DefaultLanguage
+	}
+	return languages[idx]
+}
+
+// Message returns the message of a warning in a given language. If there
+// is no translation, the message is in DefaultLanguage.
+func (w Warning) Message(lang string) string {
+	if res, ok := catalogs[lang].warnings[w]; ok {
+		return res
+	}
+	return w.String()
+}
+
+// Label returns a human-readable name of a word type in a given language.
+// If there is no translation, the label is in DefaultLanguage.
+func (wt WordType) Label(lang string) string {
+	if res, ok := catalogs[lang].wordTypes[wt]; ok {
+		return res
+	}
+	return catalogEn.wordTypes[wt]
+}
+
+// Localize adds messages of warnings and labels of words in a given
+// language to parsing results. Results in DefaultLanguage do not change,
+// because their warnings already contain messages.
+func (p *Parsed) Localize(lang string) {
+	if lang == "" || lang == DefaultLanguage {
+		return
+	}
+	for i := range p.QualityWarnings {
+		p.QualityWarnings[i].Message = p.QualityWarnings[i].Warning.Message(lang)
+	}
+	for i := range p.Words {
+		p.Words[i].Label = p.Words[i].Type.Label(lang)
+	}
+}
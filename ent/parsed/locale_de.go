@@ -0,0 +1,104 @@
+package parsed
+
+// catalogDe contains warning messages and labels of word types in German.
+var catalogDe = catalog{
+	name: "Deutsch",
+	warnings: map[Warning]string{
+		TailWarn:                              "Nicht analysierter Rest",
+		ApostrOtherWarn:                       "Kein ASCII-Apostroph",
+		AuthAmbiguousFiliusWarn:               "Mehrdeutiges f. (filius oder forma)",
+		AuthDoubleParensWarn:                  "Autorschaft in doppelten Klammern",
+		AuthEmendWarn:                         "`emend`-Autoren sind nicht erforderlich",
+		AuthEmendWithoutDotWarn:               "`emend` ohne Punkt",
+		AuthExWarn:                            "`ex`-Autoren sind nicht erforderlich (nur ICZN)",
+		AuthInWarn:                            "`in`-Autoren sind nicht erforderlich",
+		AuthExWithDotWarn:                     "`ex` endet mit einem Punkt",
+		AuthInWithDotWarn:                     "`in` endet mit einem Punkt",
+		AuthMissingOneParensWarn:              "In der Autorschaft fehlt eine Klammer",
+		AuthQuestionWarn:                      "Autor als Fragezeichen",
+		AuthShortWarn:                         "Autor ist zu kurz",
+		AuthUnknownWarn:                       "Autor ist unbekannt",
+		AuthUpperCaseWarn:                     "Autor in Großbuchstaben",
+		BacteriaMaybeWarn:                     "Die Gattung ist ein Homonym einer Bakteriengattung",
+		BotanyAuthorNotSubgenWarn:             "Mehrdeutigkeit: ICN-Autor oder Untergattung",
+		CandidatusName:                        "Bakterieller `Candidatus`-Name",
+		CanonicalApostropheWarn:               "Apostroph ist im kanonischen Namen nicht erlaubt",
+		CapWordQuestionWarn:                   "Uninomen mit Fragezeichen",
+		CharBadWarn:                           "Nicht standardmäßige Zeichen im kanonischen Namen",
+		ContainsIgnoredAnnotation:             "Ignorierte Anmerkung `mihi`",
+		CultivarEpithetWarn:                   "Sortenepitheton",
+		DashOtherWarn:                         "Untypischer Bindestrich",
+		DotEpithetWarn:                        "Punkt ist im kanonischen Namen nicht erlaubt",
+		GenderAgreementWarn:                   "Das Epitheton stimmt im Genus nicht mit der Gattung überein",
+		GenderAgreementMaybeWarn:              "Das Epitheton stimmt möglicherweise im Genus nicht mit der Gattung überein",
+		GenusAbbrWarn:                         "Abgekürztes Uninomen",
+		GenusUpperCharAfterDash:               "Vermutliche Gattung mit Großbuchstaben nach Bindestrich",
+		GraftChimeraCharNoSpaceWarn:           "Pfropfchimären-Zeichen ist nicht durch Leerzeichen getrennt",
+		GraftChimeraFormulaIncompleteWarn:     "Unvollständige Pfropfchimären-Formel",
+		GraftChimeraFormulaProbIncompleteWarn: "Wahrscheinlich unvollständige Pfropfchimären-Formel",
+		GraftChimeraFormulaWarn:               "Pfropfchimären-Formel",
+		GraftChimeraNamedWarn:                 "Benannte Pfropfchimäre",
+		GreekLetterInRank:                     "Veraltete Nummerierung mit griechischem Buchstaben im Rang",
+		HTMLTagsEntitiesWarn:                  "HTML-Tags oder -Entitäten im Namen",
+		HybridCharNoSpaceWarn:                 "Hybridzeichen ist nicht durch Leerzeichen getrennt",
+		HybridFormulaIncompleteWarn:           "Unvollständige Hybridformel",
+		HybridFormulaProbIncompleteWarn:       "Wahrscheinlich unvollständige Hybridformel",
+		HybridFormulaWarn:                     "Hybridformel",
+		HybridNamedWarn:                       "Benannter Hybrid",
+		LowCaseWarn:                           "Name beginnt mit Kleinbuchstaben",
+		NameApproxWarn:                        "Name ist ungefähr",
+		NameComparisonWarn:                    "Namensvergleich",
+		RankUncommonWarn:                      "Ungewöhnlicher Rang",
+		SpaceNonStandardWarn:                  "Nicht standardmäßige Leerzeichen",
+		SpanishAndAsSeparator:                 "Spanisches 'y' statt '&'",
+		SpeciesNumericWarn:                    "Numerisches Präfix",
+		SubgenusAbbrWarn:                      "Abgekürzte Untergattung",
+		SuperspeciesWarn:                      "Mehrdeutigkeit: Untergattung oder Superspezies",
+		UTF8ConvBadWarn:                       "Fehlerhafte Umwandlung in UTF-8",
+		UninomialComboWarn:                    "Kombination zweier Uninomina",
+		UninomialWithRank:                     "Uninomen mit vorangestelltem Rang",
+		WhiteSpaceTrailWarn:                   "Leerzeichen am Ende",
+		YearCharWarn:                          "Jahr mit lateinischem Buchstaben",
+		YearDotWarn:                           "Jahr mit Punkt",
+		YearMisplacedWarn:                     "Falsch platziertes Jahr",
+		YearOrigMisplacedWarn:                 "Falsch platziertes Jahr des Basionyms",
+		YearPageWarn:                          "Jahr mit Seitenangabe",
+		YearParensWarn:                        "Jahr in Klammern",
+		YearQuestionWarn:                      "Jahr mit Fragezeichen",
+		YearRangeWarn:                         "Zeitraum von Jahren",
+		YearSqBracketsWarn:                    "Jahr in eckigen Klammern",
+	},
+	wordTypes: map[WordType]string{
+		UnknownType:          "Wort",
+		ComparisonMarkerType: "Vergleichsmarker",
+		CultivarType:         "Sorte",
+		ApproxMarkerType:     "Näherungsmarker",
+		AuthorWordType:       "Autor",
+		AuthorWordFiliusType: "Autor (filius)",
+		CandidatusType:       "Candidatus",
+		GenusType:            "Gattung",
+		HybridCharType:       "Hybridzeichen",
+		GraftChimeraCharType: "Pfropfchimären-Zeichen",
+		InfraspEpithetType:   "Infraspezifisches Epitheton",
+		RankType:             "Rang",
+		SpEpithetType:        "Artepitheton",
+		SubgenusType:         "Infragenerisches Epitheton",
+		UninomialType:        "Uninomen",
+		YearApproximateType:  "Ungefähres Jahr",
+		YearType:             "Jahr",
+		RealmIcvcnType:       "Realm",
+		SubrealmIcvcnType:    "Subrealm",
+		KingdomIcvcnType:     "Reich",
+		SubkingdomIcvcnType:  "Unterreich",
+		PhylumIcvcnType:      "Stamm",
+		SubphylumIcvcnType:   "Unterstamm",
+		ClassIcvcnType:       "Klasse",
+		SubclassIcvcnType:    "Unterklasse",
+		OrderIcvcnType:       "Ordnung",
+		SuborderIcvcnType:    "Unterordnung",
+		FamilyIcvcnType:      "Familie",
+		SubfamilyIcvcnType:   "Unterfamilie",
+		GenusIcvcnType:       "Gattung",
+		SpeciesIcvcnType:     "Art",
+	},
+}
@@ -0,0 +1,40 @@
+package parsed
+
+// catalogEn contains labels of word types in English. Warning messages in
+// English come from warningMap.
+var catalogEn = catalog{
+	name: "English",
+	wordTypes: map[WordType]string{
+		UnknownType:          "Word",
+		ComparisonMarkerType: "Comparison marker",
+		CultivarType:         "Cultivar",
+		ApproxMarkerType:     "Approximation marker",
+		AuthorWordType:       "Author",
+		AuthorWordFiliusType: "Author (filius)",
+		CandidatusType:       "Candidatus",
+		GenusType:            "Genus",
+		HybridCharType:       "Hybrid sign",
+		GraftChimeraCharType: "Graft-chimera sign",
+		InfraspEpithetType:   "Infraspecific epithet",
+		RankType:             "Rank",
+		SpEpithetType:        "Specific epithet",
+		SubgenusType:         "Infrageneric epithet",
+		UninomialType:        "Uninomial",
+		YearApproximateType:  "Approximate year",
+		YearType:             "Year",
+		RealmIcvcnType:       "Realm",
+		SubrealmIcvcnType:    "Subrealm",
+		KingdomIcvcnType:     "Kingdom",
+		SubkingdomIcvcnType:  "Subkingdom",
+		PhylumIcvcnType:      "Phylum",
+		SubphylumIcvcnType:   "Subphylum",
+		ClassIcvcnType:       "Class",
+		SubclassIcvcnType:    "Subclass",
+		OrderIcvcnType:       "Order",
+		SuborderIcvcnType:    "Suborder",
+		FamilyIcvcnType:      "Family",
+		SubfamilyIcvcnType:   "Subfamily",
+		GenusIcvcnType:       "Genus",
+		SpeciesIcvcnType:     "Species",
+	},
+}
@@ -0,0 +1,104 @@
+package parsed
+
+// catalogEs contains warning messages and labels of word types in Spanish.
+var catalogEs = catalog{
+	name: "Español",
+	warnings: map[Warning]string{
+		TailWarn:                              "Cola sin analizar",
+		ApostrOtherWarn:                       "Apóstrofo que no es ASCII",
+		AuthAmbiguousFiliusWarn:               "f. ambiguo (filius o forma)",
+		AuthDoubleParensWarn:                  "Autoría entre paréntesis dobles",
+		AuthEmendWarn:                         "Los autores `emend` no son necesarios",
+		AuthEmendWithoutDotWarn:               "`emend` sin punto",
+		AuthExWarn:                            "Los autores `ex` no son necesarios (solo ICZN)",
+		AuthInWarn:                            "Los autores `in` no son necesarios",
+		AuthExWithDotWarn:                     "`ex` termina con punto",
+		AuthInWithDotWarn:                     "`in` termina con punto",
+		AuthMissingOneParensWarn:              "A la autoría le falta un paréntesis",
+		AuthQuestionWarn:                      "Autor como signo de interrogación",
+		AuthShortWarn:                         "Autor demasiado corto",
+		AuthUnknownWarn:                       "Autor desconocido",
+		AuthUpperCaseWarn:                     "Autor en mayúsculas",
+		BacteriaMaybeWarn:                     "El género es homónimo de un género bacteriano",
+		BotanyAuthorNotSubgenWarn:             "Ambigüedad: autor ICN o subgénero",
+		CandidatusName:                        "Nombre bacteriano `Candidatus`",
+		CanonicalApostropheWarn:               "El apóstrofo no está permitido en el nombre canónico",
+		CapWordQuestionWarn:                   "Uninomial con signo de interrogación",
+		CharBadWarn:                           "Caracteres no estándar en el nombre canónico",
+		ContainsIgnoredAnnotation:             "Anotación `mihi` ignorada",
+		CultivarEpithetWarn:                   "Epíteto de cultivar",
+		DashOtherWarn:                         "Carácter de guion atípico",
+		DotEpithetWarn:                        "El punto no está permitido en el nombre canónico",
+		GenderAgreementWarn:                   "El epíteto no concuerda en género con el género",
+		GenderAgreementMaybeWarn:              "Es posible que el epíteto no concuerde en género con el género",
+		GenusAbbrWarn:                         "Uninomial abreviado",
+		GenusUpperCharAfterDash:               "Aparente género con mayúscula después del guion",
+		GraftChimeraCharNoSpaceWarn:           "El signo de quimera de injerto no está separado por espacio",
+		GraftChimeraFormulaIncompleteWarn:     "Fórmula de quimera de injerto incompleta",
+		GraftChimeraFormulaProbIncompleteWarn: "Fórmula de quimera de injerto probablemente incompleta",
+		GraftChimeraFormulaWarn:               "Fórmula de quimera de injerto",
+		GraftChimeraNamedWarn:                 "Quimera de injerto con nombre",
+		GreekLetterInRank:                     "Numeración obsoleta con letra griega en el rango",
+		HTMLTagsEntitiesWarn:                  "Etiquetas o entidades HTML en el nombre",
+		HybridCharNoSpaceWarn:                 "El signo de híbrido no está separado por espacio",
+		HybridFormulaIncompleteWarn:           "Fórmula de híbrido incompleta",
+		HybridFormulaProbIncompleteWarn:       "Fórmula de híbrido probablemente incompleta",
+		HybridFormulaWarn:                     "Fórmula de híbrido",
+		HybridNamedWarn:                       "Híbrido con nombre",
+		LowCaseWarn:                           "El nombre empieza con minúscula",
+		NameApproxWarn:                        "El nombre es aproximado",
+		NameComparisonWarn:                    "Comparación de nombres",
+		RankUncommonWarn:                      "Rango poco común",
+		SpaceNonStandardWarn:                  "Caracteres de espacio no estándar",
+		SpanishAndAsSeparator:                 "Se usa 'y' en lugar de '&'",
+		SpeciesNumericWarn:                    "Prefijo numérico",
+		SubgenusAbbrWarn:                      "Subgénero abreviado",
+		SuperspeciesWarn:                      "Ambigüedad: subgénero o superespecie",
+		UTF8ConvBadWarn:                       "Conversión incorrecta a UTF-8",
+		UninomialComboWarn:                    "Combinación de dos uninomiales",
+		UninomialWithRank:                     "Uninomial precedido por su rango",
+		WhiteSpaceTrailWarn:                   "Espacios al final",
+		YearCharWarn:                          "Año con letra latina",
+		YearDotWarn:                           "Año con punto",
+		YearMisplacedWarn:                     "Año fuera de lugar",
+		YearOrigMisplacedWarn:                 "Año del basiónimo fuera de lugar",
+		YearPageWarn:                          "Año con número de página",
+		YearParensWarn:                        "Año entre paréntesis",
+		YearQuestionWarn:                      "Año con signo de interrogación",
+		YearRangeWarn:                         "Rango de años",
+		YearSqBracketsWarn:                    "Año entre corchetes",
+	},
+	wordTypes: map[WordType]string{
+		UnknownType:          "Palabra",
+		ComparisonMarkerType: "Marcador de comparación",
+		CultivarType:         "Cultivar",
+		ApproxMarkerType:     "Marcador de aproximación",
+		AuthorWordType:       "Autor",
+		AuthorWordFiliusType: "Autor (filius)",
+		CandidatusType:       "Candidatus",
+		GenusType:            "Género",
+		HybridCharType:       "Signo de híbrido",
+		GraftChimeraCharType: "Signo de quimera de injerto",
+		InfraspEpithetType:   "Epíteto infraespecífico",
+		RankType:             "Rango",
+		SpEpithetType:        "Epíteto específico",
+		SubgenusType:         "Epíteto infragenérico",
+		UninomialType:        "Uninomial",
+		YearApproximateType:  "Año aproximado",
+		YearType:             "Año",
+		RealmIcvcnType:       "Dominio",
+		SubrealmIcvcnType:    "Subdominio",
+		KingdomIcvcnType:     "Reino",
+		SubkingdomIcvcnType:  "Subreino",
+		PhylumIcvcnType:      "Filo",
+		SubphylumIcvcnType:   "Subfilo",
+		ClassIcvcnType:       "Clase",
+		SubclassIcvcnType:    "Subclase",
+		OrderIcvcnType:       "Orden",
+		SuborderIcvcnType:    "Suborden",
+		FamilyIcvcnType:      "Familia",
+		SubfamilyIcvcnType:   "Subfamilia",
+		GenusIcvcnType:       "Género",
+		SpeciesIcvcnType:     "Especie",
+	},
+}
@@ -0,0 +1,104 @@
+package parsed
+
+// catalogFr contains warning messages and labels of word types in French.
+var catalogFr = catalog{
+	name: "Français",
+	warnings: map[Warning]string{
+		TailWarn:                              "Fin non analysée",
+		ApostrOtherWarn:                       "Apostrophe non ASCII",
+		AuthAmbiguousFiliusWarn:               "f. ambigu (filius ou forma)",
+		AuthDoubleParensWarn:                  "Auteurs entre doubles parenthèses",
+		AuthEmendWarn:                         "Les auteurs `emend` ne sont pas requis",
+		AuthEmendWithoutDotWarn:               "`emend` sans point",
+		AuthExWarn:                            "Les auteurs `ex` ne sont pas requis (ICZN seulement)",
+		AuthInWarn:                            "Les auteurs `in` ne sont pas requis",
+		AuthExWithDotWarn:                     "`ex` se termine par un point",
+		AuthInWithDotWarn:                     "`in` se termine par un point",
+		AuthMissingOneParensWarn:              "Il manque une parenthèse aux auteurs",
+		AuthQuestionWarn:                      "Auteur remplacé par un point d'interrogation",
+		AuthShortWarn:                         "Auteur trop court",
+		AuthUnknownWarn:                       "Auteur inconnu",
+		AuthUpperCaseWarn:                     "Auteur en majuscules",
+		BacteriaMaybeWarn:                     "Le genre est un homonyme d'un genre bactérien",
+		BotanyAuthorNotSubgenWarn:             "Ambiguïté : auteur ICN ou sous-genre",
+		CandidatusName:                        "Nom bactérien `Candidatus`",
+		CanonicalApostropheWarn:               "Apostrophe non autorisée dans le nom canonique",
+		CapWordQuestionWarn:                   "Uninominal avec point d'interrogation",
+		CharBadWarn:                           "Caractères non standard dans le nom canonique",
+		ContainsIgnoredAnnotation:             "Annotation `mihi` ignorée",
+		CultivarEpithetWarn:                   "Épithète de cultivar",
+		DashOtherWarn:                         "Caractère de trait d'union atypique",
+		DotEpithetWarn:                        "Point non autorisé dans le nom canonique",
+		GenderAgreementWarn:                   "L'épithète ne s'accorde pas en genre avec le genre",
+		GenderAgreementMaybeWarn:              "L'épithète ne s'accorde peut-être pas en genre avec le genre",
+		GenusAbbrWarn:                         "Uninominal abrégé",
+		GenusUpperCharAfterDash:               "Genre apparent avec majuscule après le trait d'union",
+		GraftChimeraCharNoSpaceWarn:           "Signe de chimère de greffe non séparé par une espace",
+		GraftChimeraFormulaIncompleteWarn:     "Formule de chimère de greffe incomplète",
+		GraftChimeraFormulaProbIncompleteWarn: "Formule de chimère de greffe probablement incomplète",
+		GraftChimeraFormulaWarn:               "Formule de chimère de greffe",
+		GraftChimeraNamedWarn:                 "Chimère de greffe nommée",
+		GreekLetterInRank:                     "Numérotation obsolète par lettre grecque dans le rang",
+		HTMLTagsEntitiesWarn:                  "Balises ou entités HTML dans le nom",
+		HybridCharNoSpaceWarn:                 "Signe d'hybride non séparé par une espace",
+		HybridFormulaIncompleteWarn:           "Formule d'hybride incomplète",
+		HybridFormulaProbIncompleteWarn:       "Formule d'hybride probablement incomplète",
+		HybridFormulaWarn:                     "Formule d'hybride",
+		HybridNamedWarn:                       "Hybride nommé",
+		LowCaseWarn:                           "Le nom commence par une minuscule",
+		NameApproxWarn:                        "Le nom est approximatif",
+		NameComparisonWarn:                    "Comparaison de noms",
+		RankUncommonWarn:                      "Rang peu courant",
+		SpaceNonStandardWarn:                  "Caractères d'espace non standard",
+		SpanishAndAsSeparator:                 "'y' espagnol utilisé au lieu de '&'",
+		SpeciesNumericWarn:                    "Préfixe numérique",
+		SubgenusAbbrWarn:                      "Sous-genre abrégé",
+		SuperspeciesWarn:                      "Ambiguïté : sous-genre ou superespèce",
+		UTF8ConvBadWarn:                       "Conversion incorrecte en UTF-8",
+		UninomialComboWarn:                    "Combinaison de deux uninominaux",
+		UninomialWithRank:                     "Uninominal précédé de son rang",
+		WhiteSpaceTrailWarn:                   "Espaces en fin de nom",
+		YearCharWarn:                          "Année avec une lettre latine",
+		YearDotWarn:                           "Année avec un point",
+		YearMisplacedWarn:                     "Année mal placée",
+		YearOrigMisplacedWarn:                 "Année du basionyme mal placée",
+		YearPageWarn:                          "Année avec numéro de page",
+		YearParensWarn:                        "Année entre parenthèses",
+		YearQuestionWarn:                      "Année avec point d'interrogation",
+		YearRangeWarn:                         "Intervalle d'années",
+		YearSqBracketsWarn:                    "Année entre crochets",
+	},
+	wordTypes: map[WordType]string{
+		UnknownType:          "Mot",
+		ComparisonMarkerType: "Marqueur de comparaison",
+		CultivarType:         "Cultivar",
+		ApproxMarkerType:     "Marqueur d'approximation",
+		AuthorWordType:       "Auteur",
+		AuthorWordFiliusType: "Auteur (filius)",
+		CandidatusType:       "Candidatus",
+		GenusType:            "Genre",
+		HybridCharType:       "Signe d'hybride",
+		GraftChimeraCharType: "Signe de chimère de greffe",
+		InfraspEpithetType:   "Épithète infraspécifique",
+		RankType:             "Rang",
+		SpEpithetType:        "Épithète spécifique",
+		SubgenusType:         "Épithète infragénérique",
+		UninomialType:        "Uninominal",
+		YearApproximateType:  "Année approximative",
+		YearType:             "Année",
+		RealmIcvcnType:       "Domaine",
+		SubrealmIcvcnType:    "Sous-domaine",
+		KingdomIcvcnType:     "Règne",
+		SubkingdomIcvcnType:  "Sous-règne",
+		PhylumIcvcnType:      "Embranchement",
+		SubphylumIcvcnType:   "Sous-embranchement",
+		ClassIcvcnType:       "Classe",
+		SubclassIcvcnType:    "Sous-classe",
+		OrderIcvcnType:       "Ordre",
+		SuborderIcvcnType:    "Sous-ordre",
+		FamilyIcvcnType:      "Famille",
+		SubfamilyIcvcnType:   "Sous-famille",
+		GenusIcvcnType:       "Genre",
+		SpeciesIcvcnType:     "Espèce",
+	},
+}
@@ -0,0 +1,105 @@
+package parsed
+
+// catalogPt contains warning messages and labels of word types in
+// Portuguese.
+var catalogPt = catalog{
+	name: "Português",
+	warnings: map[Warning]string{
+		TailWarn:                              "Cauda não analisada",
+		ApostrOtherWarn:                       "Apóstrofo que não é ASCII",
+		AuthAmbiguousFiliusWarn:               "f. ambíguo (filius ou forma)",
+		AuthDoubleParensWarn:                  "Autoria entre parênteses duplos",
+		AuthEmendWarn:                         "Autores `emend` não são necessários",
+		AuthEmendWithoutDotWarn:               "`emend` sem ponto",
+		AuthExWarn:                            "Autores `ex` não são necessários (somente ICZN)",
+		AuthInWarn:                            "Autores `in` não são necessários",
+		AuthExWithDotWarn:                     "`ex` termina com ponto",
+		AuthInWithDotWarn:                     "`in` termina com ponto",
+		AuthMissingOneParensWarn:              "Falta um parêntese na autoria",
+		AuthQuestionWarn:                      "Autor como ponto de interrogação",
+		AuthShortWarn:                         "Autor curto demais",
+		AuthUnknownWarn:                       "Autor desconhecido",
+		AuthUpperCaseWarn:                     "Autor em maiúsculas",
+		BacteriaMaybeWarn:                     "O gênero é homônimo de um gênero bacteriano",
+		BotanyAuthorNotSubgenWarn:             "Ambiguidade: autor ICN ou subgênero",
+		CandidatusName:                        "Nome bacteriano `Candidatus`",
+		CanonicalApostropheWarn:               "Apóstrofo não é permitido no nome canônico",
+		CapWordQuestionWarn:                   "Uninominal com ponto de interrogação",
+		CharBadWarn:                           "Caracteres não padronizados no nome canônico",
+		ContainsIgnoredAnnotation:             "Anotação `mihi` ignorada",
+		CultivarEpithetWarn:                   "Epíteto de cultivar",
+		DashOtherWarn:                         "Caractere de hífen atípico",
+		DotEpithetWarn:                        "Ponto não é permitido no nome canônico",
+		GenderAgreementWarn:                   "O epíteto não concorda em gênero com o gênero",
+		GenderAgreementMaybeWarn:              "O epíteto talvez não concorde em gênero com o gênero",
+		GenusAbbrWarn:                         "Uninominal abreviado",
+		GenusUpperCharAfterDash:               "Aparente gênero com maiúscula após o hífen",
+		GraftChimeraCharNoSpaceWarn:           "Sinal de quimera de enxerto não separado por espaço",
+		GraftChimeraFormulaIncompleteWarn:     "Fórmula de quimera de enxerto incompleta",
+		GraftChimeraFormulaProbIncompleteWarn: "Fórmula de quimera de enxerto provavelmente incompleta",
+		GraftChimeraFormulaWarn:               "Fórmula de quimera de enxerto",
+		GraftChimeraNamedWarn:                 "Quimera de enxerto com nome",
+		GreekLetterInRank:                     "Numeração obsoleta com letra grega na categoria",
+		HTMLTagsEntitiesWarn:                  "Tags ou entidades HTML no nome",
+		HybridCharNoSpaceWarn:                 "Sinal de híbrido não separado por espaço",
+		HybridFormulaIncompleteWarn:           "Fórmula de híbrido incompleta",
+		HybridFormulaProbIncompleteWarn:       "Fórmula de híbrido provavelmente incompleta",
+		HybridFormulaWarn:                     "Fórmula de híbrido",
+		HybridNamedWarn:                       "Híbrido com nome",
+		LowCaseWarn:                           "O nome começa com minúscula",
+		NameApproxWarn:                        "O nome é aproximado",
+		NameComparisonWarn:                    "Comparação de nomes",
+		RankUncommonWarn:                      "Categoria incomum",
+		SpaceNonStandardWarn:                  "Caracteres de espaço não padronizados",
+		SpanishAndAsSeparator:                 "'y' espanhol usado em vez de '&'",
+		SpeciesNumericWarn:                    "Prefixo numérico",
+		SubgenusAbbrWarn:                      "Subgênero abreviado",
+		SuperspeciesWarn:                      "Ambiguidade: subgênero ou superespécie",
+		UTF8ConvBadWarn:                       "Conversão incorreta para UTF-8",
+		UninomialComboWarn:                    "Combinação de dois uninominais",
+		UninomialWithRank:                     "Uninominal precedido por sua categoria",
+		WhiteSpaceTrailWarn:                   "Espaços no final",
+		YearCharWarn:                          "Ano com letra latina",
+		YearDotWarn:                           "Ano com ponto",
+		YearMisplacedWarn:                     "Ano fora do lugar",
+		YearOrigMisplacedWarn:                 "Ano do basiônimo fora do lugar",
+		YearPageWarn:                          "Ano com número de página",
+		YearParensWarn:                        "Ano entre parênteses",
+		YearQuestionWarn:                      "Ano com ponto de interrogação",
+		YearRangeWarn:                         "Intervalo de anos",
+		YearSqBracketsWarn:                    "Ano entre colchetes",
+	},
+	wordTypes: map[WordType]string{
+		UnknownType:          "Palavra",
+		ComparisonMarkerType: "Marcador de comparação",
+		CultivarType:         "Cultivar",
+		ApproxMarkerType:     "Marcador de aproximação",
+		AuthorWordType:       "Autor",
+		AuthorWordFiliusType: "Autor (filius)",
+		CandidatusType:       "Candidatus",
+		GenusType:            "Gênero",
+		HybridCharType:       "Sinal de híbrido",
+		GraftChimeraCharType: "Sinal de quimera de enxerto",
+		InfraspEpithetType:   "Epíteto infraespecífico",
+		RankType:             "Categoria",
+		SpEpithetType:        "Epíteto específico",
+		SubgenusType:         "Epíteto infragenérico",
+		UninomialType:        "Uninominal",
+		YearApproximateType:  "Ano aproximado",
+		YearType:             "Ano",
+		RealmIcvcnType:       "Domínio",
+		SubrealmIcvcnType:    "Subdomínio",
+		KingdomIcvcnType:     "Reino",
+		SubkingdomIcvcnType:  "Sub-reino",
+		PhylumIcvcnType:      "Filo",
+		SubphylumIcvcnType:   "Subfilo",
+		ClassIcvcnType:       "Classe",
+		SubclassIcvcnType:    "Subclasse",
+		OrderIcvcnType:       "Ordem",
+		SuborderIcvcnType:    "Subordem",
+		FamilyIcvcnType:      "Família",
+		SubfamilyIcvcnType:   "Subfamília",
+		GenusIcvcnType:       "Gênero",
+		SpeciesIcvcnType:     "Espécie",
+	},
+}
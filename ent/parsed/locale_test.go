@@ -0,0 +1,87 @@
+package parsed_test
+
+import (
+	"testing"
+
+	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLanguages(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal([]string{"en", "de", "es", "fr", "pt"}, parsed.Languages())
+	assert.Equal("Español", parsed.LanguageName("es"))
+
+	tests := []struct {
+		msg, tag, res string
+		hasErr        bool
+	}{
+		{"empty", "", "en", false},
+		{"es", "es", "es", false},
+		{"upper", "FR", "fr", false},
+		{"region", "pt-BR", "pt", false},
+		{"underscore", "de_AT", "de", false},
+		{"unknown", "ru", "", true},
+	}
+	for _, v := range tests {
+		res, err := parsed.NewLanguage(v.tag)
+		assert.Equal(v.hasErr, err != nil, v.msg)
+		assert.Equal(v.res, res, v.msg)
+	}
+}
+
+func TestMatchLanguage(t *testing.T) {
+	assert := assert.New(t)
+	tests := []struct {
+		msg, accept, res string
+	}{
+		{"empty", "", "en"},
+		{"es", "es", "es"},
+		{"region", "es-MX,es;q=0.9,en;q=0.8", "es"},
+		{"order", "ru,pt;q=0.8,en;q=0.5", "pt"},
+		{"unsupported", "ru,ja", "en"},
+		{"bad", ";;;", "en"},
+	}
+	for _, v := range tests {
+		assert.Equal(v.res, parsed.MatchLanguage(v.accept), v.msg)
+	}
+}
+
+func TestCatalogs(t *testing.T) {
+	for _, lang := range parsed.Languages()[1:] {
+		for _, v := range parsed.WarningInfos() {
+			w, _ := parsed.NewWarningFromCode(v.Code)
+			assert.NotEqual(t, w.String(), w.Message(lang), lang+": "+v.Code)
+		}
+	}
+	for _, lang := range parsed.Languages() {
+		for wt := parsed.UnknownType; wt <= parsed.SpeciesIcvcnType; wt++ {
+			if wt.String() == "" {
+				continue
+			}
+			assert.NotEmpty(t, wt.Label(lang), lang+": "+wt.String())
+		}
+	}
+	assert.Equal(t, parsed.TailWarn.String(), parsed.TailWarn.Message("ru"))
+	assert.Equal(t, "Genus", parsed.GenusType.Label("ru"))
+}
+
+func TestLocalize(t *testing.T) {
+	assert := assert.New(t)
+	p := parsed.Parsed{
+		QualityWarnings: []parsed.QualityWarning{
+			parsed.YearPageWarn.NewQualityWarning(),
+		},
+		Words: []parsed.Word{{Verbatim: "Aus", Type: parsed.GenusType}},
+	}
+	en := p
+	en.Localize("en")
+	assert.Empty(en.QualityWarnings[0].Message)
+	assert.Empty(en.Words[0].Label)
+
+	p.Localize("es")
+	assert.Equal("Año con número de página", p.QualityWarnings[0].Message)
+	assert.Equal("Género", p.Words[0].Label)
+	assert.Equal(parsed.YearPageWarn, p.QualityWarnings[0].Warning)
+	assert.Equal("YEAR_WITH_PAGE", p.QualityWarnings[0].Code)
+}
@@ -100,6 +100,11 @@ func HeaderCSVFlat(f gnfmt.Format, withDetails bool) string {
 			"ParserVersion",
 			"Normalized",
 			"Rank",
+			"RankInferred",
+			"RankID",
+			"RankLevel",
+			"RankCode",
+			"RankURI",
 			"Authors",
 			"BasionymAuthorship",
 			"BasionymExAuthorship",
@@ -167,6 +172,11 @@ func (p Parsed) csvOutputFlat(sep rune, withDetails bool) string {
 			pf.ParserVersion,
 			pf.Normalized,
 			pf.Rank,
+			strconv.FormatBool(pf.RankInferred),
+			pf.RankID,
+			rankLevel(pf.RankLevel),
+			pf.RankCode,
+			pf.RankURI,
 			pf.Authors,
 			pf.BasionymAuthorship,
 			pf.BasionymExAuthorship,
@@ -194,3 +204,11 @@ func (p Parsed) csvOutputFlat(sep rune, withDetails bool) string {
 
 	return gnfmt.ToCSV(row, sep)
 }
+
+// rankLevel returns an empty string for names without a rank.
+func rankLevel(level int) string {
+	if level == 0 {
+		return ""
+	}
+	return strconv.Itoa(level)
+}
@@ -266,11 +266,11 @@ func TestHeaderCSVFlat_FieldCount(t *testing.T) {
 			expectedFields: 10,
 		},
 		{
-			name:           "CSV with details has 36 fields (extended)",
+			name:           "CSV with details has 41 fields (extended)",
 			format:         gnfmt.CSV,
 			withDetails:    true,
 			separator:      ",",
-			expectedFields: 36,
+			expectedFields: 41,
 		},
 		{
 			name:           "TSV without details has 10 fields (simple)",
@@ -280,11 +280,11 @@ func TestHeaderCSVFlat_FieldCount(t *testing.T) {
 			expectedFields: 10,
 		},
 		{
-			name:           "TSV with details has 36 fields (extended)",
+			name:           "TSV with details has 41 fields (extended)",
 			format:         gnfmt.TSV,
 			withDetails:    true,
 			separator:      "\t",
-			expectedFields: 36,
+			expectedFields: 41,
 		},
 	}
 
@@ -303,6 +303,7 @@ func TestOutput_CSV_WithDetails(t *testing.T) {
 		Parsed:        true,
 		Verbatim:      "Homo sapiens Linnaeus",
 		Cardinality:   2,
+		Rank:          "sp.",
 		ParseQuality:  1,
 		VerbatimID:    "test-id",
 		ParserVersion: "v1.0.0",
@@ -321,12 +322,16 @@ func TestOutput_CSV_WithDetails(t *testing.T) {
 	csvOutput := p.Output(gnfmt.CSV, false)
 	fields := strings.Split(csvOutput, ",")
 
-	// With details, should have 36 fields (10 base + 26 extended)
-	assert.Equal(t, 36, len(fields), "CSV with details should have 36 fields")
+	// With details, should have 41 fields (10 base + 31 extended)
+	assert.Equal(t, 41, len(fields), "CSV with details should have 41 fields")
 
 	// Check that genus and species are in the output
 	assert.Contains(t, csvOutput, "Homo")
 	assert.Contains(t, csvOutput, "sapiens")
+
+	// rank columns
+	assert.Contains(t, csvOutput,
+		",sp.,false,species,80,,http://rs.gbif.org/vocabulary/gbif/rank/species,")
 }
 
 func TestOutput_InvalidFormat(t *testing.T) {
@@ -17,6 +17,11 @@ type Parsed struct {
 	// other codes.
 	NomCodeSetting string `json:"nomenclaturalCodeSetting,omitempty"`
 
+	// NomCodeInferred is a nomenclatural code the name probably belongs to,
+	// with the evidence for it. It is provided only if the code inference
+	// is enabled and the name-string has evidence for some code.
+	NomCodeInferred *CodeInference `json:"nomenclaturalCodeInferred,omitempty"`
+
 	// ParseQuality is a number that represents the quality of the
 	// parsing.
 	//
@@ -32,6 +37,10 @@ type Parsed struct {
 	// is set to 1. If parsing failed, the parseQuality is 0.
 	ParseQuality int `json:"quality"`
 
+	// QualityProfile is the name of the profile that assigned qualities to
+	// warnings. It is empty for the default profile.
+	QualityProfile string `json:"qualityProfile,omitempty"`
+
 	// QualityWarnings contains encountered parsing problems.
 	QualityWarnings []QualityWarning `json:"qualityWarnings,omitempty"`
 
@@ -60,6 +69,11 @@ type Parsed struct {
 	// omitted when the data for it does not exist.
 	Rank string `json:"rank,omitempty"`
 
+	// RankInferred is true if the rank is not given in the name-string, but
+	// inferred from the standard ending of a uninomial, for example
+	// 'fam.' for 'Felidae' or 'ord.' for 'Rosales'.
+	RankInferred bool `json:"rankInferred,omitempty"`
+
 	// Authorship describes provided metainformation about authors of a name.
 	// This authorship provided outside of Details belongs to
 	// the most fine-grained element of a name.
@@ -137,6 +151,16 @@ type Parsed struct {
 	// Words contain description of every parsed word of a name.
 	Words []Word `json:"words,omitempty"`
 
+	// Suggestions contain corrected canonical forms of the name proposed
+	// according to orthographic rules of nomenclatural codes. They are
+	// provided only if suggestions are enabled.
+	Suggestions []Suggestion `json:"suggestions,omitempty"`
+
+	// Hierarchy contains names implied by the name: its genus, species,
+	// autonyms and the name itself. It is provided only if the hierarchy
+	// is enabled.
+	Hierarchy []HierarchyElem `json:"hierarchy,omitempty"`
+
 	// VerbatimID is a UUID v5 generated from the verbatim value of the
 	// input name-string. Every unique string always generates the same
 	// UUID.
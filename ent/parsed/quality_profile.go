@@ -0,0 +1,182 @@
+package parsed
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/gnames/gnlib/ent/nomcode"
+)
+
+// DefaultQualityProfile is the name of the profile that uses
+// WarningQualityMap without changes.
+const DefaultQualityProfile = "default"
+
+// QualityProfile assigns severity (parsing quality) to warnings. It allows
+// to adjust ParseQuality to the needs of a particular project. For example,
+// `ex` authors are normal for botanical names, but are not used by
+// zoologists.
+type QualityProfile struct {
+	// Name of the profile. It is recorded in the output of parsing.
+	Name string
+
+	// Qualities override qualities of warnings from WarningQualityMap.
+	// Warnings that are not in Qualities keep their default quality.
+	Qualities map[Warning]int
+}
+
+// qualityProfiles contain built-in profiles for nomenclatural codes.
+var qualityProfiles = map[nomcode.Code]map[Warning]int{
+	nomcode.Zoological: {
+		AuthExWarn:          3,
+		AuthEmendWarn:       3,
+		CultivarEpithetWarn: 3,
+	},
+	nomcode.Botanical: {
+		AuthExWarn:              1,
+		AuthInWarn:              1,
+		AuthEmendWarn:           1,
+		HybridFormulaWarn:       1,
+		HybridNamedWarn:         1,
+		GraftChimeraFormulaWarn: 1,
+		GraftChimeraNamedWarn:   1,
+	},
+	nomcode.Cultivars: {
+		AuthExWarn:              1,
+		AuthInWarn:              1,
+		AuthEmendWarn:           1,
+		CultivarEpithetWarn:     1,
+		HybridFormulaWarn:       1,
+		HybridNamedWarn:         1,
+		GraftChimeraFormulaWarn: 1,
+		GraftChimeraNamedWarn:   1,
+	},
+	nomcode.Bacterial: {
+		AuthEmendWarn:  1,
+		CandidatusName: 1,
+	},
+}
+
+// QualityProfileNames returns names of built-in profiles.
+func QualityProfileNames() []string {
+	res := []string{DefaultQualityProfile}
+	for k := range qualityProfiles {
+		res = append(res, k.String())
+	}
+	slices.Sort(res[1:])
+	return res
+}
+
+// NewQualityProfileByName returns a built-in profile. The name is
+// 'default' or a nomenclatural code ('zoological', 'botanical',
+// 'cultivars', 'bacterial' or their abbreviations).
+func NewQualityProfileByName(name string) (QualityProfile, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || strings.EqualFold(name, DefaultQualityProfile) {
+		return QualityProfile{Name: DefaultQualityProfile}, nil
+	}
+	code := nomcode.New(name)
+	qs, ok := qualityProfiles[code]
+	if !ok {
+		return QualityProfile{}, fmt.Errorf(
+			"unknown quality profile '%s', use %s", name,
+			strings.Join(QualityProfileNames(), ", "),
+		)
+	}
+	return QualityProfile{Name: code.String(), Qualities: qs}, nil
+}
+
+// NewQualityProfile creates a profile that changes qualities of a built-in
+// profile base. Keys of warnings are warning codes or messages (as they
+// appear in the output), values are qualities from 1 to 4. A profile without name
+// is called 'custom', or takes the name of its base if it changes nothing.
+func NewQualityProfile(
+	name, base string,
+	warnings map[string]int,
+) (QualityProfile, error) {
+	baseProfile, err := NewQualityProfileByName(base)
+	if err != nil {
+		return QualityProfile{}, err
+	}
+	res := QualityProfile{Name: name, Qualities: make(map[Warning]int)}
+	switch {
+	case res.IsDefault() && len(warnings) > 0 && name != "":
+		return QualityProfile{}, fmt.Errorf(
+			"name '%s' is reserved for the built-in profile", name,
+		)
+	case name == "" && len(warnings) > 0:
+		res.Name = "custom"
+	case name == "":
+		res.Name = baseProfile.Name
+	}
+	for k, v := range baseProfile.Qualities {
+		res.Qualities[k] = v
+	}
+	for k, v := range warnings {
+		w, ok := warningStrMap[k]
+		if !ok {
+			w, ok = warningCodeMap[k]
+		}
+		if !ok {
+			return QualityProfile{}, fmt.Errorf("unknown warning '%s'", k)
+		}
+		if v < 1 || v > 4 {
+			return QualityProfile{}, fmt.Errorf(
+				"quality of warning '%s' must be from 1 to 4, not %d", k, v,
+			)
+		}
+		res.Qualities[w] = v
+	}
+	return res, nil
+}
+
+// IsDefault returns true if the profile does not change qualities of
+// warnings.
+func (qp QualityProfile) IsDefault() bool {
+	return qp.Name == "" || qp.Name == DefaultQualityProfile
+}
+
+// Quality returns quality of a warning according to the profile.
+func (qp QualityProfile) Quality(w Warning) int {
+	if q, ok := qp.Qualities[w]; ok {
+		return q
+	}
+	return w.Quality()
+}
+
+// Apply recalculates qualities of warnings and ParseQuality of parsing
+// results according to the profile, and records the name of the profile.
+// The default profile leaves results unchanged.
+func (qp QualityProfile) Apply(p *Parsed) {
+	if qp.IsDefault() {
+		return
+	}
+	p.QualityProfile = qp.Name
+	if !p.Parsed {
+		return
+	}
+	warns := make([]QualityWarning, len(p.QualityWarnings))
+	for i, v := range p.QualityWarnings {
+		warns[i] = v
+		warns[i].Quality = qp.Quality(v.Warning)
+	}
+	SortQualityWarnings(warns)
+	p.QualityWarnings = warns
+	p.ParseQuality = 1
+	if len(warns) > 0 {
+		p.ParseQuality = warns[0].Quality
+	}
+}
+
+// SortQualityWarnings sorts warnings from the most severe to the least
+// severe ones. Warnings of the same quality are sorted by their messages.
+func SortQualityWarnings(ws []QualityWarning) {
+	slices.SortFunc(ws, func(a, b QualityWarning) int {
+		res := cmp.Compare(b.Quality, a.Quality)
+		if res != 0 {
+			return res
+		}
+		return cmp.Compare(a.Warning.String(), b.Warning.String())
+	})
+}
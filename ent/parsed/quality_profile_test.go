@@ -0,0 +1,108 @@
+package parsed_test
+
+import (
+	"testing"
+
+	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQualityProfileByName(t *testing.T) {
+	assert := assert.New(t)
+	tests := []struct {
+		msg, name, res string
+		quality        int
+	}{
+		{"empty", "", "default", 2},
+		{"default", "Default", "default", 2},
+		{"zoo", "zoo", "zoological", 3},
+		{"icn", "ICN", "botanical", 1},
+		{"cult", "cultivar", "cultivars", 1},
+		{"bact", "bacterial", "bacterial", 2},
+	}
+	for _, v := range tests {
+		qp, err := parsed.NewQualityProfileByName(v.name)
+		assert.Nil(err, v.msg)
+		assert.Equal(v.res, qp.Name, v.msg)
+		assert.Equal(v.quality, qp.Quality(parsed.AuthExWarn), v.msg)
+	}
+
+	_, err := parsed.NewQualityProfileByName("viral")
+	assert.NotNil(err)
+	assert.Equal(
+		[]string{"default", "bacterial", "botanical", "cultivars", "zoological"},
+		parsed.QualityProfileNames(),
+	)
+}
+
+func TestNewQualityProfile(t *testing.T) {
+	assert := assert.New(t)
+	qp, err := parsed.NewQualityProfile("my", "zoo", map[string]int{
+		"Year with page info": 1,
+	})
+	require.Nil(t, err)
+	assert.Equal("my", qp.Name)
+	assert.Equal(1, qp.Quality(parsed.YearPageWarn))
+	assert.Equal(3, qp.Quality(parsed.AuthExWarn))
+	assert.Equal(4, qp.Quality(parsed.TailWarn))
+
+	qp, err = parsed.NewQualityProfile("", "", map[string]int{
+		"Year with page info": 3,
+	})
+	require.Nil(t, err)
+	assert.Equal("custom", qp.Name)
+
+	qp, err = parsed.NewQualityProfile("", "bot", nil)
+	require.Nil(t, err)
+	assert.Equal("botanical", qp.Name)
+
+	_, err = parsed.NewQualityProfile("my", "", map[string]int{"No such": 1})
+	assert.NotNil(err)
+	_, err = parsed.NewQualityProfile("my", "", map[string]int{
+		"Year with page info": 5,
+	})
+	assert.NotNil(err)
+	_, err = parsed.NewQualityProfile("default", "", map[string]int{
+		"Year with page info": 1,
+	})
+	assert.NotNil(err)
+}
+
+func TestQualityProfileApply(t *testing.T) {
+	assert := assert.New(t)
+	p := parsed.Parsed{
+		Parsed:       true,
+		ParseQuality: 2,
+		QualityWarnings: []parsed.QualityWarning{
+			{Quality: 2, Warning: parsed.AuthExWarn},
+			{Quality: 2, Warning: parsed.YearPageWarn},
+		},
+	}
+
+	qp, _ := parsed.NewQualityProfileByName("default")
+	res := p
+	qp.Apply(&res)
+	assert.Equal(p, res)
+
+	qp, _ = parsed.NewQualityProfileByName("zoological")
+	res = p
+	qp.Apply(&res)
+	assert.Equal("zoological", res.QualityProfile)
+	assert.Equal(3, res.ParseQuality)
+	assert.Equal(parsed.AuthExWarn, res.QualityWarnings[0].Warning)
+	assert.Equal(2, p.QualityWarnings[0].Quality)
+
+	qp, _ = parsed.NewQualityProfile("lenient", "botanical", map[string]int{
+		"Year with page info": 1,
+	})
+	res = p
+	qp.Apply(&res)
+	assert.Equal(1, res.ParseQuality)
+	assert.Equal(1, res.QualityWarnings[1].Quality)
+
+	res = parsed.Parsed{Verbatim: "1234"}
+	qp.Apply(&res)
+	assert.Equal(0, res.ParseQuality)
+	assert.Equal("lenient", res.QualityProfile)
+}
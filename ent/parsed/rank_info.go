@@ -0,0 +1,45 @@
+package parsed
+
+import (
+	"github.com/gnames/gnlib/ent/nomcode"
+	"github.com/gnames/gnparser/ent/rank"
+)
+
+// RankInfo describes a rank of a name with the standard vocabulary of
+// ranks, so ranks written differently ('ssp.', 'subsp.', 'nothosubsp.')
+// can be compared and sorted.
+type RankInfo struct {
+	// ID is the identifier of the rank, for example 'subspecies'.
+	ID string `json:"id"`
+
+	// Level is a number for sorting ranks from the highest to the lowest,
+	// for example 60 for family and 80 for species. It is 0 for unranked
+	// names.
+	Level int `json:"level"`
+
+	// Code is the abbreviation of the nomenclatural code that uses the
+	// rank. It is empty for ranks used by several codes.
+	Code string `json:"code,omitempty"`
+
+	// URI of the rank in the GBIF rank vocabulary. It is empty for ranks
+	// that are not in the vocabulary.
+	URI string `json:"uri,omitempty"`
+}
+
+// NewRankInfo creates RankInfo from a rank given in a name-string. It
+// returns nil for an empty rank.
+func NewRankInfo(s string) *RankInfo {
+	r := rank.New(s)
+	if r == rank.Unknown {
+		return nil
+	}
+	res := RankInfo{
+		ID:    r.ID(),
+		Level: r.Level(),
+		URI:   r.URI(),
+	}
+	if code := r.Code(); code != nomcode.Unknown {
+		res.Code = code.Abbr()
+	}
+	return &res
+}
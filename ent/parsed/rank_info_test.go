@@ -0,0 +1,24 @@
+package parsed_test
+
+import (
+	"testing"
+
+	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRankInfo(t *testing.T) {
+	assert := assert.New(t)
+	assert.Nil(parsed.NewRankInfo(""))
+
+	ri := parsed.NewRankInfo("nothosubsp.")
+	assert.Equal(&parsed.RankInfo{
+		ID:    "subspecies",
+		Level: 81,
+		URI:   "http://rs.gbif.org/vocabulary/gbif/rank/subspecies",
+	}, ri)
+
+	ri = parsed.NewRankInfo("pv.")
+	assert.Equal("pathovar", ri.ID)
+	assert.Equal("ICNP", ri.Code)
+}
@@ -0,0 +1,194 @@
+package parsed
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/gnames/gnfmt"
+	tb "github.com/gnames/tribool"
+)
+
+// SchemaURL is the JSON Schema dialect used by JSONSchema.
+const SchemaURL = "https://json-schema.org/draft/2020-12/schema"
+
+// detailsTypes are all implementations of the Details interface.
+var detailsTypes = []any{
+	DetailsUninomial{},
+	DetailsSpecies{},
+	DetailsInfraspecies{},
+	DetailsComparison{},
+	DetailsApproximation{},
+	DetailsHybridFormula{},
+	DetailsGraftChimeraFormula{},
+	DetailsUninomialICVCN{},
+	DetailsSpeciesICVCN{},
+}
+
+// JSONSchema returns JSON Schema of JSON output of the parser. If flat is
+// true, the schema describes the flattened output (ParsedFlat). Fields
+// without 'omitempty' are required.
+func JSONSchema(flat bool) ([]byte, error) {
+	var root any = Parsed{}
+	title := "Parsed"
+	if flat {
+		root = ParsedFlat{}
+		title = "ParsedFlat"
+	}
+
+	sg := schemaGen{defs: make(map[string]any)}
+	res := sg.object(reflect.TypeOf(root))
+	res["$schema"] = SchemaURL
+	res["title"] = title
+	if len(sg.defs) > 0 {
+		res["$defs"] = sg.defs
+	}
+	enc := gnfmt.GNjson{Pretty: true}
+	return enc.Encode(res)
+}
+
+// schemaGen builds JSON Schema from Go types. Named structs are kept in
+// defs, so recursive types are possible.
+type schemaGen struct {
+	defs map[string]any
+}
+
+var (
+	detailsType    = reflect.TypeFor[Details]()
+	warningType    = reflect.TypeFor[Warning]()
+	wordTypeType   = reflect.TypeFor[WordType]()
+	annotationType = reflect.TypeFor[Annotation]()
+	triboolType    = reflect.TypeFor[tb.Tribool]()
+)
+
+func (sg schemaGen) schema(t reflect.Type) map[string]any {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch t {
+	case detailsType:
+		var oneOf []any
+		for _, v := range detailsTypes {
+			oneOf = append(oneOf, sg.ref(reflect.TypeOf(v)))
+		}
+		return map[string]any{"oneOf": oneOf}
+	case warningType:
+		return enumSchema(warningMap)
+	case wordTypeType:
+		return enumSchema(wordTypeMap)
+	case annotationType:
+		return enumSchema(annotMap)
+	case triboolType:
+		return map[string]any{
+			"type": "string",
+			"enum": []string{"no", "maybe", "yes"},
+		}
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return map[string]any{"type": "string"}
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		return map[string]any{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]any{"type": "number"}
+	case reflect.Slice:
+		return map[string]any{"type": "array", "items": sg.schema(t.Elem())}
+	case reflect.Struct:
+		return sg.ref(t)
+	default:
+		return map[string]any{}
+	}
+}
+
+// ref adds a struct to defs and returns a reference to it.
+func (sg schemaGen) ref(t reflect.Type) map[string]any {
+	name := t.Name()
+	if _, ok := sg.defs[name]; !ok {
+		// placeholder stops recursion
+		sg.defs[name] = nil
+		sg.defs[name] = sg.object(t)
+	}
+	return map[string]any{"$ref": "#/$defs/" + name}
+}
+
+func (sg schemaGen) object(t reflect.Type) map[string]any {
+	props := make(map[string]any)
+	var required []string
+	sg.fields(t, props, &required, false)
+	res := map[string]any{
+		"type":                 "object",
+		"properties":           props,
+		"additionalProperties": false,
+	}
+	if len(required) > 0 {
+		slices.Sort(required)
+		res["required"] = required
+	}
+	return res
+}
+
+// fields adds JSON fields of a struct to props. Fields of embedded structs
+// are added as fields of the struct itself, unless the struct has a field
+// with the same name. Fields of embedded pointers are never required.
+func (sg schemaGen) fields(
+	t reflect.Type,
+	props map[string]any,
+	required *[]string,
+	optional bool,
+) {
+	var embedded []reflect.StructField
+	for i := range t.NumField() {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" || !f.IsExported() {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		if f.Anonymous && name == "" {
+			embedded = append(embedded, f)
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		props[name] = sg.schema(f.Type)
+		if !optional && !strings.Contains(opts, "omitempty") {
+			*required = append(*required, name)
+		}
+	}
+
+	for _, f := range embedded {
+		ft := f.Type
+		isPtr := ft.Kind() == reflect.Pointer
+		if isPtr {
+			ft = ft.Elem()
+		}
+		inner := make(map[string]any)
+		var innerReq []string
+		sg.fields(ft, inner, &innerReq, optional || isPtr)
+		for k, v := range inner {
+			if _, ok := props[k]; ok {
+				continue
+			}
+			props[k] = v
+			if slices.Contains(innerReq, k) {
+				*required = append(*required, k)
+			}
+		}
+	}
+}
+
+func enumSchema[K comparable](m map[K]string) map[string]any {
+	var vals []string
+	for _, v := range m {
+		if v != "" {
+			vals = append(vals, v)
+		}
+	}
+	slices.Sort(vals)
+	return map[string]any{"type": "string", "enum": vals}
+}
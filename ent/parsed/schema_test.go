@@ -0,0 +1,58 @@
+package parsed_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONSchema(t *testing.T) {
+	assert := assert.New(t)
+	bs, err := parsed.JSONSchema(false)
+	require.Nil(t, err)
+
+	var schema struct {
+		Schema     string                     `json:"$schema"`
+		Required   []string                   `json:"required"`
+		Properties map[string]map[string]any  `json:"properties"`
+		Defs       map[string]json.RawMessage `json:"$defs"`
+	}
+	require.Nil(t, json.Unmarshal(bs, &schema))
+	assert.Equal(parsed.SchemaURL, schema.Schema)
+	assert.Contains(schema.Required, "verbatim")
+	assert.NotContains(schema.Required, "details")
+	assert.Equal("integer", schema.Properties["quality"]["type"])
+	assert.Contains(schema.Properties["details"], "oneOf")
+	assert.Contains(schema.Defs, "DetailsInfraspecies")
+	assert.Contains(string(schema.Defs["QualityWarning"]), "Unparsed tail")
+	assert.Contains(string(schema.Defs["Word"]), "SPECIES")
+
+	bs, err = parsed.JSONSchema(true)
+	require.Nil(t, err)
+	require.Nil(t, json.Unmarshal(bs, &schema))
+	assert.Contains(schema.Properties, "canonicalSimple")
+	assert.Equal("array", schema.Properties["qualityWarnings"]["type"])
+}
+
+func TestJSONSchemaEmbedded(t *testing.T) {
+	bs, err := parsed.JSONSchema(false)
+	require.Nil(t, err)
+	var schema struct {
+		Defs map[string]struct {
+			Required   []string       `json:"required"`
+			Properties map[string]any `json:"properties"`
+		} `json:"$defs"`
+	}
+	require.Nil(t, json.Unmarshal(bs, &schema))
+
+	// Comparison.Genus hides Genus of embedded *Species.
+	comp := schema.Defs["Comparison"]
+	assert.Contains(t, comp.Properties, "species")
+	assert.Equal(t, []string{"comparisonMarker"}, comp.Required)
+
+	infra := schema.Defs["Infraspecies"]
+	assert.Contains(t, infra.Required, "genus")
+}
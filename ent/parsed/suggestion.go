@@ -0,0 +1,26 @@
+package parsed
+
+// Suggestion is a corrected canonical form of a name proposed according to
+// orthographic rules of a nomenclatural code.
+type Suggestion struct {
+	// Canonical is the corrected simple canonical form of the name.
+	Canonical string `json:"canonical"`
+
+	// Verbatim is the word of the name-string that needs a correction.
+	Verbatim string `json:"verbatim"`
+
+	// Correction is the corrected form of the word.
+	Correction string `json:"correction"`
+
+	// Rule is a short stable identifier of the orthographic rule, for
+	// example 'CONNECTING_VOWEL'.
+	Rule string `json:"rule"`
+
+	// Reference is the article or recommendation of a nomenclatural code
+	// that describes the rule, for example 'ICN Art. 60.10'.
+	Reference string `json:"reference"`
+
+	// Confidence is a number from 0 to 1 that shows how likely the
+	// correction is right.
+	Confidence float64 `json:"confidence"`
+}
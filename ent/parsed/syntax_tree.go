@@ -0,0 +1,100 @@
+package parsed
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SyntaxTree contains syntax trees that the parsing grammar creates for
+// a name-string. It shows why a name-string is parsed in a particular way.
+type SyntaxTree struct {
+	// Verbatim is the input name-string.
+	Verbatim string `json:"verbatim"`
+
+	// Preprocessed is the part of the name-string that goes to the parsing
+	// grammar. Spans of nodes are positions of characters (not bytes) in
+	// this string.
+	Preprocessed string `json:"preprocessed"`
+
+	// NoParse is true if preprocessing found that the name-string cannot be
+	// parsed, in this case there are no syntax trees.
+	NoParse bool `json:"noParse,omitempty"`
+
+	// Complete is the tree with all rules of the grammar that matched the
+	// name-string.
+	Complete *SyntaxNode `json:"complete,omitempty"`
+
+	// Output is the simplified tree that is used to create parsing results.
+	Output *SyntaxNode `json:"output,omitempty"`
+}
+
+// SyntaxNode is a node of a syntax tree.
+type SyntaxNode struct {
+	// Rule is the name of the grammar rule.
+	Rule string `json:"rule"`
+
+	// Start and End are the span of the node in the preprocessed
+	// name-string.
+	Start int `json:"start"`
+	End   int `json:"end"`
+
+	// Text is the part of the name-string that matched the rule.
+	Text string `json:"text"`
+
+	// Children are nodes of the rule's subrules.
+	Children []SyntaxNode `json:"children,omitempty"`
+}
+
+// DOT returns syntax trees as a Graphviz DOT graph. The complete and the
+// output trees are drawn as separate clusters.
+func (st SyntaxTree) DOT() string {
+	var b strings.Builder
+	b.WriteString("digraph SyntaxTree {\n")
+	fmt.Fprintf(&b, "  label=%s;\n  labelloc=t;\n", dotQuote(st.Verbatim))
+	b.WriteString("  node [shape=box, fontname=\"Helvetica\"];\n")
+	if st.NoParse {
+		fmt.Fprintf(&b, "  noparse [label=%s];\n",
+			dotQuote("NO PARSE\n"+st.Verbatim))
+	}
+	trees := []struct {
+		id, label string
+		node      *SyntaxNode
+	}{
+		{"complete", "Complete Syntax Tree", st.Complete},
+		{"output", "Output Syntax Tree", st.Output},
+	}
+	for _, t := range trees {
+		if t.node == nil {
+			continue
+		}
+		fmt.Fprintf(&b, "  subgraph cluster_%s {\n", t.id)
+		fmt.Fprintf(&b, "    label=%s;\n", dotQuote(t.label))
+		var count int
+		t.node.dot(&b, t.id, &count)
+		b.WriteString("  }\n")
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// dot writes the node and its children, count is used to create unique
+// node IDs. It returns the ID of the node.
+func (n SyntaxNode) dot(b *strings.Builder, prefix string, count *int) string {
+	id := fmt.Sprintf("%s%d", prefix, *count)
+	*count++
+	label := fmt.Sprintf("%s\n%s\n[%d:%d]", n.Rule, n.Text, n.Start, n.End)
+	fmt.Fprintf(b, "    %s [label=%s];\n", id, dotQuote(label))
+	for _, c := range n.Children {
+		cid := c.dot(b, prefix, count)
+		fmt.Fprintf(b, "    %s -> %s;\n", id, cid)
+	}
+	return id
+}
+
+// dotQuote creates a quoted DOT string.
+func dotQuote(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	s = strings.ReplaceAll(s, "\n", `\n`)
+	return `"` + s + `"`
+}
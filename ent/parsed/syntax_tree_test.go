@@ -0,0 +1,37 @@
+package parsed_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyntaxTreeDOT(t *testing.T) {
+	assert := assert.New(t)
+	node := &parsed.SyntaxNode{
+		Rule: "SciName", Start: 0, End: 9, Text: `Aus "bus"`,
+		Children: []parsed.SyntaxNode{
+			{Rule: "GenusWord", Start: 0, End: 3, Text: "Aus"},
+		},
+	}
+	st := parsed.SyntaxTree{
+		Verbatim:     `Aus "bus"`,
+		Preprocessed: `Aus "bus"`,
+		Complete:     node,
+		Output:       node,
+	}
+	res := st.DOT()
+	assert.True(strings.HasPrefix(res, "digraph SyntaxTree {\n"))
+	assert.Contains(res, `label="Aus \"bus\"";`)
+	assert.Contains(res, "subgraph cluster_complete {")
+	assert.Contains(res, "subgraph cluster_output {")
+	assert.Contains(res, `complete0 [label="SciName\nAus \"bus\"\n[0:9]"];`)
+	assert.Contains(res, "output0 -> output1;")
+
+	st = parsed.SyntaxTree{Verbatim: "Acetobacter phage", NoParse: true}
+	res = st.DOT()
+	assert.Contains(res, `noparse [label="NO PARSE\nAcetobacter phage"];`)
+	assert.NotContains(res, "subgraph")
+}
@@ -35,6 +35,8 @@ const (
 	CultivarEpithetWarn
 	DashOtherWarn
 	DotEpithetWarn
+	GenderAgreementWarn
+	GenderAgreementMaybeWarn
 	GenusAbbrWarn
 	GenusUpperCharAfterDash
 	GraftChimeraCharNoSpaceWarn
@@ -99,6 +101,8 @@ var warningMap = map[Warning]string{
 	CultivarEpithetWarn:                   "Cultivar epithet",
 	DashOtherWarn:                         "Atypical hyphen character",
 	DotEpithetWarn:                        "Period character is not allowed in canonical",
+	GenderAgreementWarn:                   "Epithet does not agree in gender with genus",
+	GenderAgreementMaybeWarn:              "Epithet might not agree in gender with genus",
 	GenusAbbrWarn:                         "Abbreviated uninomial word",
 	GenusUpperCharAfterDash:               "Apparent genus with capital character after hyphen",
 	GraftChimeraCharNoSpaceWarn:           "Graft-chimera char is not separated by space",
@@ -172,6 +176,8 @@ var WarningQualityMap = map[Warning]int{
 	CultivarEpithetWarn:                   2,
 	DashOtherWarn:                         2,
 	DotEpithetWarn:                        3,
+	GenderAgreementWarn:                   2,
+	GenderAgreementMaybeWarn:              1,
 	GenusAbbrWarn:                         4,
 	GenusUpperCharAfterDash:               2,
 	GraftChimeraCharNoSpaceWarn:           3,
@@ -215,6 +221,18 @@ var WarningQualityMap = map[Warning]int{
 type QualityWarning struct {
 	Quality int     `json:"quality"`
 	Warning Warning `json:"warning"`
+
+	// Code is a stable identifier of the warning (see Warning.Code).
+	Code string `json:"code"`
+
+	// Message is the message of the warning in the language selected for
+	// parsing. It is empty for DefaultLanguage (see Parsed.Localize).
+	Message string `json:"message,omitempty"`
+
+	// Correction is the corrected word for warnings that can be fixed
+	// automatically, for example an epithet that agrees in gender with
+	// the genus.
+	Correction string `json:"correction,omitempty"`
 }
 
 // String implements fmt.Stringer interface.
@@ -233,6 +251,7 @@ func (w Warning) NewQualityWarning() QualityWarning {
 	return QualityWarning{
 		Quality: w.Quality(),
 		Warning: w,
+		Code:    w.Code(),
 	}
 }
 
@@ -251,7 +270,8 @@ func (w Warning) MarshalJSON() ([]byte, error) {
 	return []byte("\"" + w.String() + "\""), nil
 }
 
-// UnmarshalJSON implements json.Unmarshaller.
+// UnmarshalJSON implements json.Unmarshaller. It accepts messages and
+// codes of warnings.
 func (w *Warning) UnmarshalJSON(bs []byte) error {
 	var err error
 	var ok bool
@@ -259,8 +279,22 @@ func (w *Warning) UnmarshalJSON(bs []byte) error {
 	// json-iter Unmarshal
 	s := strings.Trim(string(bs), `"`)
 	*w, ok = warningStrMap[s]
+	if !ok {
+		*w, ok = warningCodeMap[s]
+	}
 	if !ok {
 		err = errors.New("cannot decode Warning")
 	}
 	return err
 }
+
+// AddWarnings adds warnings found after parsing, for example by the check
+// of gender agreement, and updates ParseQuality of the result.
+func (p *Parsed) AddWarnings(ws ...QualityWarning) {
+	if len(ws) == 0 {
+		return
+	}
+	p.QualityWarnings = append(p.QualityWarnings, ws...)
+	SortQualityWarnings(p.QualityWarnings)
+	p.ParseQuality = max(p.ParseQuality, p.QualityWarnings[0].Quality)
+}
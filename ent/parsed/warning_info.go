@@ -0,0 +1,629 @@
+package parsed
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// WarningInfo documents a warning. Code is a stable identifier of the
+// warning that does not change when the wording of its message changes.
+type WarningInfo struct {
+	// Code is a stable machine-readable identifier of the warning, for
+	// example 'AUTH_EX_NOT_REQUIRED'.
+	Code string `json:"code"`
+
+	// Message is the short description of the warning that appears in
+	// parsing results.
+	Message string `json:"message"`
+
+	// Quality is the default parsing quality of the warning.
+	Quality int `json:"quality"`
+
+	// Explanation describes the problem and why it matters.
+	Explanation string `json:"explanation"`
+
+	// Examples are name-strings that trigger the warning.
+	Examples []string `json:"examples,omitempty"`
+
+	// Articles are relevant articles and recommendations of nomenclatural
+	// codes.
+	Articles []string `json:"articles,omitempty"`
+
+	// Fix suggests how to correct the name-string.
+	Fix string `json:"fix,omitempty"`
+}
+
+// warningInfoMap documents all warnings. Message and Quality are taken
+// from warningMap and WarningQualityMap.
+var warningInfoMap = map[Warning]WarningInfo{
+	TailWarn: {
+		Code: "TAIL_UNPARSED",
+		Explanation: "The end of the name-string was not recognized as a part " +
+			"of a scientific name. It is returned in the 'tail' field. The tail " +
+			"often contains annotations, references or misspelled authors.",
+		Examples: []string{
+			"Döringina Ihering 1929 (synonym)",
+			"Velutina haliotoides (Linnaeus, 1758),",
+		},
+		Fix: "Remove annotations and other text that does not belong to the name.",
+	},
+	ApostrOtherWarn: {
+		Code: "APOSTROPHE_NOT_ASCII",
+		Explanation: "A typographic apostrophe (‘ or ’) is used instead of the " +
+			"ASCII apostrophe. It is normalized to ' in the output.",
+		Examples: []string{"Rhynchonellidae d’Orbigny 1847"},
+		Fix:      "Use the ASCII apostrophe (').",
+	},
+	AuthAmbiguousFiliusWarn: {
+		Code: "AUTH_FILIUS_AMBIGUOUS",
+		Explanation: "'f.' after an author can mean 'filius' (son, as in 'L. f.') " +
+			"or the rank 'forma'. The parser interprets it as filius.",
+		Examples: []string{"Polypodium pectinatum L. f. typica Rosenst."},
+		Articles: []string{"ICN Rec. 46A"},
+		Fix: "Write 'fil.' or attach 'f.' to the author ('L.f.') for filius, " +
+			"use 'forma' or 'fo.' for the rank.",
+	},
+	AuthDoubleParensWarn: {
+		Code: "AUTH_DOUBLE_PARENS",
+		Explanation: "The original authorship is enclosed in two pairs of " +
+			"parentheses.",
+		Examples: []string{"Eichornia crassipes ( (Martius) ) Solms-Laub."},
+		Articles: []string{"ICN Art. 49.1", "ICZN Art. 51.3"},
+		Fix:      "Keep one pair of parentheses around the original authorship.",
+	},
+	AuthEmendWarn: {
+		Code: "AUTH_EMEND_NOT_REQUIRED",
+		Explanation: "Authors who emended the description of a taxon ('emend.') " +
+			"are not a part of the authorship of the name. The parser keeps them " +
+			"in the authorship.",
+		Examples: []string{
+			"Pseudomonas aeruginosa (Schroeter 1872) Migula 1900 emend. Palleroni",
+		},
+		Articles: []string{"ICN Rec. 47A", "ICNP Rule 35"},
+		Fix:      "Remove 'emend.' and authors that follow it, if they are not needed.",
+	},
+	AuthEmendWithoutDotWarn: {
+		Code:        "AUTH_EMEND_NO_PERIOD",
+		Explanation: "The abbreviation 'emend.' is written without a period.",
+		Examples:    []string{"Aus bus Smith emend Jones"},
+		Fix:         "Write 'emend.' with a period.",
+	},
+	AuthExWarn: {
+		Code: "AUTH_EX_NOT_REQUIRED",
+		Explanation: "An 'ex' author proposed the name, but it was validly " +
+			"published by the author that follows 'ex'. Botanists may cite both " +
+			"authors, zoologists do not use 'ex' citations.",
+		Examples: []string{"Glaphyropteris (Fée) C.Presl ex Fée"},
+		Articles: []string{"ICN Art. 46.4", "ICZN Rec. 51E"},
+		Fix: "For zoological names keep only the author who published the name " +
+			"(after 'ex').",
+	},
+	AuthInWarn: {
+		Code: "AUTH_IN_NOT_REQUIRED",
+		Explanation: "An 'in' citation shows the work of another author where " +
+			"the name was published. It is a bibliographic detail, not a part of " +
+			"the authorship.",
+		Examples: []string{
+			"Psoronaias semigranosa von dem Busch in Philippi, 1845",
+		},
+		Articles: []string{"ICN Art. 46.2", "ICZN Art. 50.1.1"},
+		Fix:      "Remove 'in' and the authors that follow it.",
+	},
+	AuthExWithDotWarn: {
+		Code:        "AUTH_EX_WITH_PERIOD",
+		Explanation: "'ex' is a Latin word, not an abbreviation, and has no period.",
+		Examples:    []string{"Arthopyrenia hyalospora Nyl. ex. Banker"},
+		Fix:         "Write 'ex' without a period.",
+	},
+	AuthInWithDotWarn: {
+		Code:        "AUTH_IN_WITH_PERIOD",
+		Explanation: "'in' is a Latin word, not an abbreviation, and has no period.",
+		Fix:         "Write 'in' without a period.",
+	},
+	AuthMissingOneParensWarn: {
+		Code: "AUTH_MISSING_PAREN",
+		Explanation: "Parentheses around the original authorship are not " +
+			"balanced.",
+		Examples: []string{"Ocydromus dalmatinus dalmatinus Dejean, 1831)"},
+		Articles: []string{"ICN Art. 49.1", "ICZN Art. 51.3"},
+		Fix:      "Add the missing parenthesis or remove the extra one.",
+	},
+	AuthQuestionWarn: {
+		Code:        "AUTH_QUESTION_MARK",
+		Explanation: "A question mark is used instead of an unknown author.",
+		Examples:    []string{"Tragacantha leporina (?) Kuntze"},
+		Fix:         "Remove the question mark, or find the author of the name.",
+	},
+	AuthShortWarn: {
+		Code: "AUTH_TOO_SHORT",
+		Explanation: "An author's name is too short to be a real name or " +
+			"a standard abbreviation.",
+		Examples: []string{"Cortinarius angulatus B gracilescens Fr. 1838"},
+		Fix:      "Check the name-string for misplaced words or characters.",
+	},
+	AuthUnknownWarn: {
+		Code: "AUTH_UNKNOWN",
+		Explanation: "The author is given as unknown or anonymous ('anon.', " +
+			"'auct.' etc.).",
+		Examples: []string{"Saccharomyces drosophilae anon."},
+		Articles: []string{"ICZN Art. 14"},
+		Fix:      "Find the author of the name, if possible.",
+	},
+	AuthUpperCaseWarn: {
+		Code:        "AUTH_UPPER_CASE",
+		Explanation: "An author's name is written in capital letters.",
+		Examples:    []string{"Calathus (Lindrothius) KURNAKOV 1961"},
+		Fix:         "Capitalize only the first letter of the author's name.",
+	},
+	BacteriaMaybeWarn: {
+		Code: "GENUS_BACTERIAL_HOMONYM",
+		Explanation: "The genus has the same name as a genus of bacteria. " +
+			"The name might belong to a bacterium or to a plant, animal or fungus.",
+		Examples: []string{"Actinomyces cardiffensis"},
+		Fix: "Set the nomenclatural code or check the classification of " +
+			"the name.",
+	},
+	BotanyAuthorNotSubgenWarn: {
+		Code: "AUTH_OR_SUBGENUS_AMBIGUOUS",
+		Explanation: "A word in parentheses after a uninomial can be a " +
+			"botanical author or a zoological subgenus. The parser treats it as " +
+			"an author.",
+		Examples: []string{"Humiriastrum (Urban) Cuatrecasas, 1961"},
+		Articles: []string{"ICN Art. 49.1", "ICZN Art. 6.1"},
+		Fix:      "Set the nomenclatural code ('botanical' or 'zoological').",
+	},
+	CandidatusName: {
+		Code: "BACTERIA_CANDIDATUS",
+		Explanation: "'Candidatus' marks provisional names of prokaryotes that " +
+			"cannot be cultured yet. Such names are not validly published.",
+		Examples: []string{"Candidatus Halobonum"},
+		Articles: []string{"ICNP Appendix 11"},
+	},
+	CanonicalApostropheWarn: {
+		Code: "CANONICAL_APOSTROPHE",
+		Explanation: "Apostrophes are not allowed in scientific names. " +
+			"They are kept in canonical forms, because removing them changes " +
+			"matching of names.",
+		Examples: []string{"Trophon d'orbignyi Carcelles, 1946"},
+		Articles: []string{"ICN Art. 60", "ICZN Art. 32.5.2"},
+		Fix:      "Remove the apostrophe ('dorbignyi').",
+	},
+	CapWordQuestionWarn: {
+		Code: "UNINOMIAL_QUESTION_MARK",
+		Explanation: "A question mark after a genus or another uninomial shows " +
+			"that its identification is doubtful.",
+		Examples: []string{"Ferganoconcha? oblonga"},
+		Fix:      "Remove the question mark, keep the doubt in another field.",
+	},
+	CharBadWarn: {
+		Code: "CANONICAL_BAD_CHARS",
+		Explanation: "Diacritics and other characters that are not allowed in " +
+			"scientific names were transliterated in canonical forms.",
+		Examples: []string{"Aëtosaurus ferratus"},
+		Articles: []string{"ICN Art. 60.6", "ICZN Art. 32.5.2"},
+		Fix:      "Use plain Latin letters in the name.",
+	},
+	ContainsIgnoredAnnotation: {
+		Code: "ANNOTATION_IGNORED",
+		Explanation: "The annotation 'mihi' ('mine', used by authors for their " +
+			"own names) is ignored.",
+		Examples: []string{"Regulus modestus mihi. Gould 1837"},
+		Fix:      "Remove 'mihi' from the name-string.",
+	},
+	CultivarEpithetWarn: {
+		Code: "CULTIVAR_EPITHET",
+		Explanation: "The name has a cultivar epithet. Cultivar epithets are " +
+			"regulated by the Cultivated Plant Code, not by the Botanical Code.",
+		Articles: []string{"ICNCP Art. 14", "ICNCP Art. 21"},
+		Fix:      "Parse the name with the 'cultivars' nomenclatural code.",
+	},
+	DashOtherWarn: {
+		Code: "HYPHEN_ATYPICAL",
+		Explanation: "A non-breaking hyphen or another atypical hyphen " +
+			"character is used. It is normalized to the ASCII hyphen.",
+		Examples: []string{"Aus bus‑cus Smith"},
+		Articles: []string{"ICN Art. 60.9", "ICZN Art. 32.5.2"},
+		Fix:      "Use the ASCII hyphen (-).",
+	},
+	DotEpithetWarn: {
+		Code: "EPITHET_WITH_PERIOD",
+		Explanation: "An epithet contains a period, usually from an abbreviated " +
+			"word like 'st.' (saint).",
+		Examples: []string{"Cibotium st.-johnii Krajina"},
+		Articles: []string{"ICN Art. 60", "ICZN Art. 32.5.2"},
+		Fix:      "Write the epithet without periods ('stjohnii').",
+	},
+	GenderAgreementWarn: {
+		Code: "GENDER_DISAGREEMENT",
+		Explanation: "An adjectival epithet has to agree in gender with the " +
+			"genus. Endings of epithets are often left unchanged when species " +
+			"are moved to a genus of another gender. The gender of the genus " +
+			"is taken from a dictionary, so the warning appears only when gender " +
+			"agreement is checked.",
+		Examples: []string{"Quercus rubrum L."},
+		Articles: []string{"ICN Art. 23.5", "ICN Art. 32.2", "ICZN Art. 31.2"},
+		Fix: "Change the ending of the epithet, the corrected epithet is in " +
+			"the 'correction' field of the warning.",
+	},
+	GenderAgreementMaybeWarn: {
+		Code: "GENDER_DISAGREEMENT_POSSIBLE",
+		Explanation: "The epithet looks like an adjective that does not agree " +
+			"in gender with the genus, but its ending ('-us', '-a', '-um') is " +
+			"shared by nouns in apposition that do not change with the genus " +
+			"('Equus zebra', 'Vipera berus'). The warning does not lower the " +
+			"quality of the name.",
+		Examples: []string{"Quercus albus L."},
+		Articles: []string{"ICN Art. 23.5", "ICN Art. 32.2", "ICZN Art. 31.2"},
+		Fix: "If the epithet is an adjective, use the form from the " +
+			"'correction' field of the warning.",
+	},
+	GenusAbbrWarn: {
+		Code: "UNINOMIAL_ABBREVIATED",
+		Explanation: "The genus or another uninomial is abbreviated. " +
+			"The name cannot be matched without knowing the full genus.",
+		Examples: []string{"M. alpium"},
+		Fix:      "Write the uninomial in full.",
+	},
+	GenusUpperCharAfterDash: {
+		Code:        "GENUS_UPPER_AFTER_HYPHEN",
+		Explanation: "A hyphenated genus has a capital letter after the hyphen.",
+		Examples:    []string{"Uva-Ursi cinerea (Howell) A. Heller"},
+		Articles:    []string{"ICN Art. 20.3"},
+		Fix:         "Use a lower-case letter after the hyphen ('Uva-ursi').",
+	},
+	GraftChimeraCharNoSpaceWarn: {
+		Code: "GRAFT_CHIMERA_CHAR_NO_SPACE",
+		Explanation: "The graft-chimera sign '+' is not separated by a space " +
+			"from the name.",
+		Examples: []string{"+Crataegomespilus"},
+		Articles: []string{"ICNCP Art. 21"},
+		Fix:      "Add a space after '+'.",
+	},
+	GraftChimeraFormulaIncompleteWarn: {
+		Code: "GRAFT_CHIMERA_FORMULA_INCOMPLETE",
+		Explanation: "A part of a graft-chimera formula has only an epithet " +
+			"without a genus.",
+		Examples: []string{"Cytisus purpureus + anagyroides"},
+		Articles: []string{"ICNCP Art. 21"},
+		Fix:      "Add the genus to every part of the formula.",
+	},
+	GraftChimeraFormulaProbIncompleteWarn: {
+		Code: "GRAFT_CHIMERA_FORMULA_PROBABLY_INCOMPLETE",
+		Explanation: "A graft-chimera formula ends with '+', " +
+			"the second parent is probably missing.",
+		Examples: []string{"Cytisus purpureus +"},
+		Articles: []string{"ICNCP Art. 21"},
+		Fix:      "Add the second parent of the graft-chimera.",
+	},
+	GraftChimeraFormulaWarn: {
+		Code: "GRAFT_CHIMERA_FORMULA",
+		Explanation: "The name is a graft-chimera formula that names both " +
+			"parents. Formulas are not names and cannot be matched as names.",
+		Examples: []string{"Cytisus purpureus + Laburnum anagyroides"},
+		Articles: []string{"ICNCP Art. 21"},
+	},
+	GraftChimeraNamedWarn: {
+		Code:        "GRAFT_CHIMERA_NAMED",
+		Explanation: "The name of a graft-chimera is marked with '+'.",
+		Examples:    []string{"+ Crataegomespilus"},
+		Articles:    []string{"ICNCP Art. 21"},
+	},
+	GreekLetterInRank: {
+		Code: "RANK_GREEK_LETTER",
+		Explanation: "An infraspecific rank is enumerated with a Greek letter, " +
+			"an old practice that is not used anymore.",
+		Examples: []string{"Aristotelia fruticosa var. δ. microphylla Hook.f."},
+		Fix:      "Remove the Greek letter.",
+	},
+	HTMLTagsEntitiesWarn: {
+		Code: "HTML_TAGS_ENTITIES",
+		Explanation: "The name-string contains HTML tags or entities. They are " +
+			"removed or converted before parsing.",
+		Examples: []string{"<i>Velutina halioides</i> (Linnaeus, 1758)"},
+		Fix:      "Remove HTML markup from the name-string.",
+	},
+	HybridCharNoSpaceWarn: {
+		Code: "HYBRID_CHAR_NO_SPACE",
+		Explanation: "The hybrid sign '×' is not separated by a space from " +
+			"the name of a nothotaxon.",
+		Examples: []string{"×Agropogon P. Fourn. 1934"},
+		Articles: []string{"ICN Art. H.3.1"},
+		Fix:      "Add a space after '×'.",
+	},
+	HybridFormulaIncompleteWarn: {
+		Code: "HYBRID_FORMULA_INCOMPLETE",
+		Explanation: "A part of a hybrid formula has only an epithet without " +
+			"a genus.",
+		Examples: []string{"Barbus cf macrotaenia × toppini"},
+		Articles: []string{"ICN Art. H.2"},
+		Fix:      "Add the genus to every part of the formula.",
+	},
+	HybridFormulaProbIncompleteWarn: {
+		Code: "HYBRID_FORMULA_PROBABLY_INCOMPLETE",
+		Explanation: "A hybrid formula ends with the hybrid sign, the second " +
+			"parent is probably missing.",
+		Examples: []string{"Arthopyrenia hyalospora x"},
+		Articles: []string{"ICN Art. H.2"},
+		Fix:      "Add the second parent of the hybrid.",
+	},
+	HybridFormulaWarn: {
+		Code: "HYBRID_FORMULA",
+		Explanation: "The name is a hybrid formula that names both parents. " +
+			"Formulas are not names and cannot be matched as names.",
+		Examples: []string{"Arthopyrenia hyalospora X Hydnellum scrobiculatum"},
+		Articles: []string{"ICN Art. H.2"},
+	},
+	HybridNamedWarn: {
+		Code: "HYBRID_NAMED",
+		Explanation: "The name of a nothotaxon is marked with '×' or with " +
+			"a 'notho-' rank.",
+		Examples: []string{"Crataegus curvisepala nvar. naviculiformis T. Petauer"},
+		Articles: []string{"ICN Art. H.3"},
+	},
+	LowCaseWarn: {
+		Code: "NAME_LOWER_CASE",
+		Explanation: "The name-string starts with a lower-case letter. Such " +
+			"names are parsed only if capitalization is on.",
+		Examples: []string{"bubo bubo"},
+		Fix:      "Capitalize the first letter of the name.",
+	},
+	NameApproxWarn: {
+		Code: "NAME_APPROXIMATION",
+		Explanation: "The name is an approximation ('sp.', 'aff.', 'nr.', '?'), " +
+			"the specimen is not identified to a species.",
+		Examples: []string{"Acarinina aff. pentacamerata"},
+	},
+	NameComparisonWarn: {
+		Code: "NAME_COMPARISON",
+		Explanation: "The name has a comparison marker ('cf.'), " +
+			"the identification of the specimen is not certain.",
+		Examples: []string{"Calidris cf. cooperi"},
+	},
+	RankUncommonWarn: {
+		Code: "RANK_UNCOMMON",
+		Explanation: "An infraspecific rank is rare or not recognized by " +
+			"modern codes.",
+		Examples: []string{"Plantago major prol. lutulenta (Lamotte) Rouy"},
+		Articles: []string{"ICN Art. 4", "ICZN Art. 45.6"},
+		Fix:      "Use a standard rank ('subsp.', 'var.', 'f.').",
+	},
+	SpaceNonStandardWarn: {
+		Code: "SPACE_NON_STANDARD",
+		Explanation: "The name-string contains non-breaking spaces, " +
+			"underscores or other non-standard space characters. They are " +
+			"normalized to spaces.",
+		Examples: []string{"Oxalis_barrelieri"},
+		Fix:      "Use ordinary spaces between words.",
+	},
+	SpanishAndAsSeparator: {
+		Code:        "AUTH_SPANISH_AND",
+		Explanation: "The Spanish 'y' separates authors instead of '&'.",
+		Examples:    []string{"Aus bus Smith y Jones"},
+		Articles:    []string{"ICN Rec. 46C.1"},
+		Fix:         "Use '&' or 'et' between authors.",
+	},
+	SpeciesNumericWarn: {
+		Code: "EPITHET_NUMERIC_PREFIX",
+		Explanation: "An epithet starts with a number, as in old names like " +
+			"'4-gibbus'. The number is spelled out in Latin in the normalized form.",
+		Examples: []string{"Acrosoma 12-spinosa Keyserling, 1892"},
+		Articles: []string{"ICN Art. 23.3", "ICZN Art. 32.5.2"},
+		Fix:      "Spell out the number ('quadrigibbus').",
+	},
+	SubgenusAbbrWarn: {
+		Code:        "SUBGENUS_ABBREVIATED",
+		Explanation: "The subgenus is abbreviated.",
+		Examples:    []string{"Phalaena (Tin.) guttella Fab."},
+		Articles:    []string{"ICZN Art. 6.1"},
+		Fix:         "Write the subgenus in full.",
+	},
+	SuperspeciesWarn: {
+		Code: "SUBGENUS_OR_SUPERSPECIES",
+		Explanation: "A lower-case word in parentheses after the genus can be " +
+			"a superspecies (aggregate) or a misspelled subgenus.",
+		Examples: []string{
+			"Acanthoderes (acanthoderes) satanas Aurivillius, 1923",
+		},
+		Articles: []string{"ICZN Art. 6.1", "ICZN Art. 6.2"},
+		Fix:      "Capitalize the subgenus, or remove the superspecies.",
+	},
+	UTF8ConvBadWarn: {
+		Code: "UTF8_BAD_CONVERSION",
+		Explanation: "The name-string contains the Unicode replacement " +
+			"character '�', a result of a broken conversion to UTF-8.",
+		Examples: []string{"Fusinus eucos�nius"},
+		Fix:      "Fix the encoding of the source data.",
+	},
+	UninomialComboWarn: {
+		Code: "UNINOMIAL_COMBINATION",
+		Explanation: "Two uninomials are combined with a rank, like a genus " +
+			"and its subdivision.",
+		Examples: []string{"Agaricus tr. Hypholoma Fr."},
+		Articles: []string{"ICN Art. 21.1"},
+	},
+	UninomialWithRank: {
+		Code:        "UNINOMIAL_WITH_RANK",
+		Explanation: "A uninomial is preceded by its rank.",
+		Examples:    []string{"subgen. Psammophrynopsis Koch, 1953"},
+		Articles:    []string{"ICN Art. 21.1"},
+		Fix:         "Combine the uninomial with its genus, or remove the rank.",
+	},
+	WhiteSpaceTrailWarn: {
+		Code:        "WHITESPACE_TRAILING",
+		Explanation: "The name-string ends with spaces.",
+		Examples:    []string{"Smasvirus BUCT598 "},
+		Fix:         "Remove trailing spaces.",
+	},
+	YearCharWarn: {
+		Code: "YEAR_WITH_CHAR",
+		Explanation: "A letter follows the year ('1935h'). Letters distinguish " +
+			"publications of the same author in the same year.",
+		Examples: []string{"Platypus bicaudatulus Schedl, 1935h"},
+		Articles: []string{"ICZN Art. 22"},
+		Fix:      "Remove the letter from the year.",
+	},
+	YearDotWarn: {
+		Code:        "YEAR_WITH_PERIOD",
+		Explanation: "The year contains a period.",
+		Fix:         "Remove the period.",
+	},
+	YearMisplacedWarn: {
+		Code: "YEAR_MISPLACED",
+		Explanation: "The year is placed after the original authorship in " +
+			"parentheses and is not a part of any authorship.",
+		Examples: []string{"Lobodon (Hombrot & Jacquinot, 1842), 2020"},
+		Articles: []string{"ICZN Art. 22"},
+		Fix:      "Remove the year or move it next to its author.",
+	},
+	YearOrigMisplacedWarn: {
+		Code: "YEAR_BASIONYM_MISPLACED",
+		Explanation: "The year of the original authorship is placed outside " +
+			"of parentheses.",
+		Examples: []string{"Zophosis persis (Chatanay), 1914"},
+		Articles: []string{"ICZN Art. 22A.3"},
+		Fix:      "Move the year inside the parentheses.",
+	},
+	YearPageWarn: {
+		Code: "YEAR_WITH_PAGE",
+		Explanation: "The year is followed by a page number. Pages are " +
+			"bibliographic details, not a part of the authorship.",
+		Examples: []string{"Recilia truncatus Dash & Viraktamath, 1998: 29"},
+		Fix:      "Remove the page number.",
+	},
+	YearParensWarn: {
+		Code: "YEAR_IN_PARENS",
+		Explanation: "The year is in parentheses without its author. In " +
+			"zoology parentheses enclose the author and the year of the original " +
+			"combination together.",
+		Examples: []string{"Platypus bicaudatulus Schedl (1935)"},
+		Articles: []string{"ICZN Art. 51.3"},
+		Fix:      "Remove the parentheses around the year.",
+	},
+	YearQuestionWarn: {
+		Code:        "YEAR_QUESTION_MARK",
+		Explanation: "The year has a question mark, it is not certain.",
+		Examples:    []string{"Tridentella tangeroae Bruce, 198?"},
+		Articles:    []string{"ICZN Art. 21"},
+		Fix:         "Find the exact year of publication.",
+	},
+	YearRangeWarn: {
+		Code: "YEAR_RANGE",
+		Explanation: "A range of years is given instead of the year of " +
+			"publication.",
+		Examples: []string{"Eurodryas orientalis Herrich-Schäffer 1845-1847"},
+		Articles: []string{"ICZN Art. 21"},
+		Fix:      "Find the exact year of publication.",
+	},
+	YearSqBracketsWarn: {
+		Code: "YEAR_IN_SQ_BRACKETS",
+		Explanation: "The year is in square brackets, it was determined from " +
+			"evidence outside of the publication.",
+		Examples: []string{"Anthoscopus Cabanis [1851]"},
+		Articles: []string{"ICZN Rec. 22A.2.3"},
+	},
+}
+
+var warningCodeMap = func() map[string]Warning {
+	res := make(map[string]Warning)
+	for k, v := range warningInfoMap {
+		res[v.Code] = k
+	}
+	return res
+}()
+
+// Code returns a stable identifier of the warning.
+func (w Warning) Code() string {
+	return warningInfoMap[w].Code
+}
+
+// Info returns the documentation of the warning.
+func (w Warning) Info() WarningInfo {
+	res := warningInfoMap[w]
+	res.Message = w.String()
+	res.Quality = w.Quality()
+	return res
+}
+
+// NewWarningFromCode returns the warning that corresponds to a code.
+func NewWarningFromCode(code string) (Warning, error) {
+	w, ok := warningCodeMap[strings.ToUpper(code)]
+	if !ok {
+		return w, fmt.Errorf("unknown warning code '%s'", code)
+	}
+	return w, nil
+}
+
+// WarningInfos returns documentation of all warnings sorted by their
+// codes.
+func WarningInfos() []WarningInfo {
+	res := make([]WarningInfo, 0, len(warningInfoMap))
+	for k := range warningInfoMap {
+		res = append(res, k.Info())
+	}
+	slices.SortFunc(res, func(a, b WarningInfo) int {
+		return strings.Compare(a.Code, b.Code)
+	})
+	return res
+}
+
+// WarningsMarkdown returns documentation of warnings as a Markdown
+// document. Warnings are grouped by their default quality.
+func WarningsMarkdown() string {
+	var b strings.Builder
+	b.WriteString(`# Quality categories
+
+## Quality 0
+
+Parsing failed.
+
+## Quality 1
+
+Parsing finished without detecting any problems.
+`)
+	infos := WarningInfos()
+	for q := 1; q <= 4; q++ {
+		var ws []WarningInfo
+		for _, v := range infos {
+			if v.Quality == q {
+				ws = append(ws, v)
+			}
+		}
+		if len(ws) == 0 {
+			continue
+		}
+		if q == 1 {
+			b.WriteString("\nWarnings of quality 1 are informational.\n")
+		} else {
+			fmt.Fprintf(&b, "\n## Quality %d\n", q)
+		}
+		for _, v := range ws {
+			v.markdown(&b)
+		}
+	}
+	return b.String()
+}
+
+// Markdown returns the description of a warning in Markdown format.
+func (wi WarningInfo) Markdown() string {
+	var b strings.Builder
+	wi.markdown(&b)
+	return strings.TrimSpace(b.String()) + "\n"
+}
+
+func (wi WarningInfo) markdown(b *strings.Builder) {
+	fmt.Fprintf(b, "\n### %s\n\n", wi.Code)
+	fmt.Fprintf(b, "Message: %s\n\n%s\n", wi.Message, wi.Explanation)
+	if len(wi.Examples) > 0 {
+		b.WriteString("\nExamples:\n\n")
+		for _, v := range wi.Examples {
+			fmt.Fprintf(b, "- `%s`\n", v)
+		}
+	}
+	if len(wi.Articles) > 0 {
+		fmt.Fprintf(b, "\nCode articles: %s.\n", strings.Join(wi.Articles, ", "))
+	}
+	if wi.Fix != "" {
+		fmt.Fprintf(b, "\nFix: %s\n", wi.Fix)
+	}
+}
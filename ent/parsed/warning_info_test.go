@@ -0,0 +1,66 @@
+package parsed_test
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/gnames/gnfmt"
+	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWarningInfos(t *testing.T) {
+	assert := assert.New(t)
+	codeRe := regexp.MustCompile(`^[A-Z][A-Z0-9_]+$`)
+	infos := parsed.WarningInfos()
+	assert.Equal(len(parsed.WarningQualityMap), len(infos))
+
+	codes := make(map[string]struct{})
+	for i, v := range infos {
+		assert.Regexp(codeRe, v.Code)
+		assert.NotEmpty(v.Message, v.Code)
+		assert.NotEmpty(v.Explanation, v.Code)
+		assert.NotContains(codes, v.Code)
+		codes[v.Code] = struct{}{}
+		if i > 0 {
+			assert.Less(infos[i-1].Code, v.Code)
+		}
+
+		w, err := parsed.NewWarningFromCode(strings.ToLower(v.Code))
+		require.Nil(t, err)
+		assert.Equal(v.Code, w.Code())
+		assert.Equal(v.Message, w.String())
+		assert.Equal(v.Quality, w.Quality())
+	}
+
+	_, err := parsed.NewWarningFromCode("NO_SUCH_WARNING")
+	assert.NotNil(err)
+}
+
+func TestWarningCodeJSON(t *testing.T) {
+	assert := assert.New(t)
+	enc := gnfmt.GNjson{}
+	qw := parsed.AuthExWarn.NewQualityWarning()
+	res, err := enc.Encode(qw)
+	require.Nil(t, err)
+	assert.Equal(
+		`{"quality":2,"warning":"`+"`ex`"+` authors are not required (ICZN only)","code":"AUTH_EX_NOT_REQUIRED"}`,
+		string(res),
+	)
+
+	var w parsed.Warning
+	err = enc.Decode([]byte(`"AUTH_EX_NOT_REQUIRED"`), &w)
+	assert.Nil(err)
+	assert.Equal(parsed.AuthExWarn, w)
+}
+
+func TestWarningsMarkdown(t *testing.T) {
+	assert := assert.New(t)
+	md := parsed.WarningsMarkdown()
+	assert.True(strings.HasPrefix(md, "# Quality categories"))
+	assert.Contains(md, "## Quality 4")
+	assert.Contains(md, "### AUTH_EX_NOT_REQUIRED\n\nMessage: `ex` authors")
+	assert.Contains(md, "Code articles: ICN Art. 46.4, ICZN Rec. 51E.")
+}
@@ -19,6 +19,9 @@ type Word struct {
 	Start int `json:"start"`
 	// End is the index of the end of a word.
 	End int `json:"end"`
+	// Label is a human-readable name of the word type in the language
+	// selected for parsing. It is empty for DefaultLanguage.
+	Label string `json:"label,omitempty"`
 }
 
 // NormalizeByType is useful when searching for a word alone.
@@ -16,6 +16,10 @@ type Parser interface {
 		keepHTML, capitalize, preserveDiaereses, compactAuthors bool,
 	) ScientificNameNode
 	Debug(name string) []byte
+
+	// DebugTree parses a name-string and returns its complete and output
+	// syntax trees.
+	DebugTree(name string) parsed.SyntaxTree
 }
 
 // ScientificNameNode is the Abstract Syntax Tree of a name-string.
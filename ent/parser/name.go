@@ -25,6 +25,9 @@ func appendCanonical(c1 *canonical, c2 *canonical, sep string) *canonical {
 // contains the value of the word, its semantic meaning and its
 // position in the string.
 func (sn *scientificNameNode) Words() []parsed.Word {
+	if sn.nameData == nil {
+		return nil
+	}
 	return sn.words()
 }
 
@@ -551,7 +554,9 @@ func (comp *comparisonNode) details() parsed.Details {
 			Authorship: comp.InfraSpEpithet.Authorship.details(),
 		}
 		if comp.InfraSpEpithet.Rank != nil {
-			co.InfraSpecies.Rank = comp.InfraSpEpithet.Rank.Word.Normalized
+			rank := comp.InfraSpEpithet.Rank.Word.Normalized
+			co.InfraSpecies.Rank = rank
+			co.InfraSpecies.RankInfo = parsed.NewRankInfo(rank)
 		}
 
 	}
@@ -728,6 +733,7 @@ func (inf *infraspEpithetNode) details() parsed.InfraspeciesElem {
 	res := parsed.InfraspeciesElem{
 		Value:      inf.Word.Normalized,
 		Rank:       rank,
+		RankInfo:   parsed.NewRankInfo(rank),
 		Authorship: inf.Authorship.details(),
 	}
 	return res
@@ -851,9 +857,10 @@ func (u *uninomialComboNode) details() parsed.Details {
 		u1Norm = u.Uninomial1.Word.Normalized
 	}
 	ud := parsed.Uninomial{
-		Value:  u.Uninomial2.Word.Normalized,
-		Rank:   u.Rank.Word.Normalized,
-		Parent: u1Norm,
+		Value:    u.Uninomial2.Word.Normalized,
+		Rank:     u.Rank.Word.Normalized,
+		RankInfo: parsed.NewRankInfo(u.Rank.Word.Normalized),
+		Parent:   u1Norm,
 	}
 	if u.Uninomial2.Authorship != nil {
 		ud.Authorship = u.Uninomial2.Authorship.details()
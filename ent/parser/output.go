@@ -1,8 +1,6 @@
 package parser
 
 import (
-	"cmp"
-	"slices"
 	"strings"
 
 	"github.com/gnames/gnparser/ent/parsed"
@@ -94,13 +92,6 @@ func prepareWarnings(ws map[parsed.Warning]struct{}) []parsed.QualityWarning {
 		i++
 	}
 
-	slices.SortFunc(res, func(a, b parsed.QualityWarning) int {
-		res := cmp.Compare(b.Quality, a.Quality)
-		if res != 0 {
-			return res
-		}
-		return cmp.Compare(a.Warning.String(), b.Warning.String())
-	})
-
+	parsed.SortQualityWarnings(res)
 	return res
 }
@@ -24,7 +24,7 @@ func (p *Engine) Debug(s string) []byte {
 		return b.Bytes()
 	}
 	p.Buffer = string(ppr.Body)
-	fmt.Println(p.Buffer)
+	fmt.Fprintln(&b, p.Buffer)
 	p.fullReset()
 	p.parse()
 	p.outputAST()
@@ -35,6 +35,46 @@ func (p *Engine) Debug(s string) []byte {
 	return b.Bytes()
 }
 
+// DebugTree takes a string, parses it, and returns its complete and
+// output syntax trees.
+func (p *Engine) DebugTree(s string) parsed.SyntaxTree {
+	res := parsed.SyntaxTree{Verbatim: s}
+	ppr := preprocess.Preprocess(p.preParser, []byte(s))
+	if ppr.NoParse || ppr.Virus {
+		res.NoParse = true
+		return res
+	}
+	p.Buffer = string(ppr.Body)
+	res.Preprocessed = p.Buffer
+	p.fullReset()
+	p.parse()
+	p.outputAST()
+	buf := []rune(p.Buffer)
+	res.Complete = syntaxNode(p.AST(), buf)
+	if p.root != nil && p.root.pegRule == ruleSciName {
+		res.Output = syntaxNode(p.root, buf)
+	}
+	return res
+}
+
+// syntaxNode converts a node of the PEG engine and its children to
+// a SyntaxNode.
+func syntaxNode(n *node32, buf []rune) *parsed.SyntaxNode {
+	if n == nil {
+		return nil
+	}
+	res := parsed.SyntaxNode{
+		Rule:  rul3s[n.pegRule],
+		Start: int(n.begin),
+		End:   int(n.end),
+		Text:  string(buf[n.begin:n.end]),
+	}
+	for c := n.up; c != nil; c = c.next {
+		res.Children = append(res.Children, *syntaxNode(c, buf))
+	}
+	return &res
+}
+
 // PreprocessAndParse takes a string and returns back the Abstract
 // Syntax Tree of the scientific names. The AST is later used to
 // create the final output.
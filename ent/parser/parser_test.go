@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/gnames/gnlib/ent/nomcode"
+	"github.com/gnames/gnparser/ent/parsed"
 	"github.com/gnames/gnparser/ent/parser"
 	"github.com/stretchr/testify/assert"
 )
@@ -73,6 +74,61 @@ func TestToOutput(t *testing.T) {
 	}
 }
 
+// TestRankInference checks ranks of uninomials inferred from their endings.
+func TestRankInference(t *testing.T) {
+	assert := assert.New(t)
+	p := parser.New()
+	testData := []struct {
+		name, rank string
+		code       nomcode.Code
+		inferred   bool
+	}{
+		{"Felidae", "", nomcode.Unknown, false},
+		{"Felidae", "fam.", nomcode.Zoological, true},
+		{"Pinaceae Lindl.", "fam.", nomcode.Unknown, true},
+		{"Carabinae", "", nomcode.Unknown, false},
+		{"Bembidiini", "trib.", nomcode.Unknown, true},
+		{"Rosales", "ord.", nomcode.Unknown, true},
+		{"Apoidea", "superfam.", nomcode.Unknown, true},
+		{"Magnoliophyta", "div.", nomcode.Unknown, true},
+		{"Magnoliidae", "", nomcode.Unknown, false},
+		{"Magnoliidae", "subcl.", nomcode.Botanical, true},
+		{"Pinoideae", "subfam.", nomcode.Botanical, true},
+		{"Poeae", "trib.", nomcode.Botanical, true},
+		{"Carabinae", "subtrib.", nomcode.Botanical, true},
+		{"Bembidiini", "", nomcode.Botanical, false},
+		{"Pinaceae", "", nomcode.Zoological, false},
+		{"Carabinae", "subfam.", nomcode.Zoological, true},
+		{"Enterobacterales", "ord.", nomcode.Bacterial, true},
+		{"Pinus", "", nomcode.Unknown, false},
+		{"Ini", "", nomcode.Unknown, false},
+		{"Poaceae subtrib. Scolochloinae", "subtrib.", nomcode.Unknown, false},
+		{"Pinaceae ×Aus", "", nomcode.Unknown, false},
+		{"Aus bus", "sp.", nomcode.Unknown, false},
+	}
+	for _, v := range testData {
+		sn := p.PreprocessAndParse(
+			v.name, "test_version", v.code, true, false, false, false,
+		)
+		out := sn.ToOutput(true, false)
+		parser.InferRank(&out, v.code)
+		msg := v.name + " " + v.code.String()
+		assert.Equal(v.rank, out.Rank, msg)
+		assert.Equal(v.inferred, out.RankInferred, msg)
+		if d, ok := out.Details.(parsed.DetailsUninomial); ok {
+			assert.Equal(v.rank, d.Uninomial.Rank, msg)
+		}
+	}
+
+	// ranks are not inferred without InferRank
+	sn := p.PreprocessAndParse(
+		"Pinaceae", "test_version", nomcode.Unknown, true, false, false, false,
+	)
+	out := sn.ToOutput(false, false)
+	assert.Equal("", out.Rank)
+	assert.False(out.RankInferred)
+}
+
 // TestSpecGroupOption checks if stem is cut when WithSpeciesGroupCut is true.
 func TestSpecGroupOption(t *testing.T) {
 	assert := assert.New(t)
@@ -110,3 +166,36 @@ func TestSpecGroupOption(t *testing.T) {
 		assert.Equal(v.stemmed, out.Canonical.Stemmed, msg)
 	}
 }
+
+func TestDebugTree(t *testing.T) {
+	assert := assert.New(t)
+	p := parser.New()
+
+	res := p.DebugTree("Bubo bubö L.")
+	assert.Equal("Bubo bubö L.", res.Preprocessed)
+	assert.False(res.NoParse)
+	assert.Equal("SciName", res.Complete.Rule)
+	assert.Equal("SciName", res.Output.Rule)
+	// spans are in characters, not bytes
+	assert.Equal(12, res.Output.End)
+
+	var epithet parsed.SyntaxNode
+	var find func(n parsed.SyntaxNode)
+	find = func(n parsed.SyntaxNode) {
+		if n.Rule == "SpeciesEpithet" {
+			epithet = n
+		}
+		for _, c := range n.Children {
+			find(c)
+		}
+	}
+	find(*res.Output)
+	assert.Equal("bubö L.", epithet.Text)
+	assert.Equal(5, epithet.Start)
+
+	res = p.DebugTree("Not a name")
+	assert.Nil(res.Output)
+
+	res = p.DebugTree("Acetobacter phage")
+	assert.True(res.NoParse)
+}
@@ -0,0 +1,125 @@
+package parser
+
+import (
+	"strings"
+
+	"github.com/gnames/gnlib/ent/nomcode"
+	"github.com/gnames/gnparser/ent/parsed"
+)
+
+// rankSuffix is a standard ending of names of a rank above genus.
+type rankSuffix struct {
+	suffix, rank string
+}
+
+// Suffixes are ordered from the longest to the shortest, so the first match
+// is the right one ('-oideae' before '-eae').
+var (
+	// zooSuffixes are endings of family-group names (ICZN Art. 29.2,
+	// Recommendation 29A). The subtribe ending '-ina' is omitted, because
+	// it is too common in genera.
+	zooSuffixes = []rankSuffix{
+		{"oidea", "superfam."},
+		{"idae", "fam."},
+		{"inae", "subfam."},
+		{"ini", "trib."},
+	}
+
+	// botSuffixes are endings of names above genus (ICN Art. 16.3, 17.1,
+	// 19.1, 19.3).
+	botSuffixes = []rankSuffix{
+		{"phytina", "subdiv."},
+		{"phyceae", "cl."},
+		{"mycetes", "cl."},
+		{"oideae", "subfam."},
+		{"opsida", "cl."},
+		{"phyta", "div."},
+		{"aceae", "fam."},
+		{"ineae", "subord."},
+		{"anae", "superord."},
+		{"idae", "subcl."},
+		{"inae", "subtrib."},
+		{"ales", "ord."},
+		{"eae", "trib."},
+	}
+
+	// bactSuffixes are endings of names from order to subtribe
+	// (ICNP Rule 9).
+	bactSuffixes = []rankSuffix{
+		{"oideae", "subfam."},
+		{"aceae", "fam."},
+		{"ineae", "subord."},
+		{"inae", "subtrib."},
+		{"ales", "ord."},
+		{"eae", "trib."},
+	}
+
+	// anySuffixes are used when the code is unknown. Endings '-idae' and
+	// '-inae' mean different ranks in zoology and botany, so they give
+	// no rank.
+	anySuffixes = []rankSuffix{
+		{"phytina", "subdiv."},
+		{"phyceae", "cl."},
+		{"mycetes", "cl."},
+		{"oideae", "subfam."},
+		{"opsida", "cl."},
+		{"oidea", "superfam."},
+		{"phyta", "div."},
+		{"aceae", "fam."},
+		{"ineae", "subord."},
+		{"anae", "superord."},
+		{"idae", ""},
+		{"inae", ""},
+		{"ales", "ord."},
+		{"eae", "trib."},
+		{"ini", "trib."},
+	}
+)
+
+// InferRank adds a rank to a parsed uninomial that has no rank, if the
+// uninomial has a standard ending of the rank under the nomenclatural
+// code. Such ranks are marked by the RankInferred field.
+func InferRank(p *parsed.Parsed, code nomcode.Code) {
+	if !p.Parsed || p.Cardinality != 1 || p.Rank != "" ||
+		p.Hybrid != nil || p.Cultivar {
+		return
+	}
+	rank := inferRank(p.Canonical.Simple, code)
+	if rank == "" {
+		return
+	}
+	p.Rank, p.RankInferred = rank, true
+	if d, ok := p.Details.(parsed.DetailsUninomial); ok {
+		d.Uninomial.Rank = rank
+		d.Uninomial.RankInfo = parsed.NewRankInfo(rank)
+		p.Details = d
+	}
+}
+
+// inferRank returns a rank of a uninomial according to the standard
+// ending of its name under the nomenclatural code. It returns an empty
+// string if the ending is not standard for the code.
+func inferRank(uninomial string, code nomcode.Code) string {
+	var suffixes []rankSuffix
+	switch code {
+	case nomcode.Zoological:
+		suffixes = zooSuffixes
+	case nomcode.Botanical, nomcode.Cultivars:
+		suffixes = botSuffixes
+	case nomcode.Bacterial:
+		suffixes = bactSuffixes
+	case nomcode.Unknown:
+		suffixes = anySuffixes
+	}
+
+	for _, v := range suffixes {
+		// a stem of at least 2 letters keeps short genera out.
+		if len(uninomial) < len(v.suffix)+2 {
+			continue
+		}
+		if strings.HasSuffix(uninomial, v.suffix) {
+			return v.rank
+		}
+	}
+	return ""
+}
@@ -0,0 +1,229 @@
+// Package rank provides a standard vocabulary of taxonomic ranks.
+//
+// Names give ranks in many forms ('ssp.', 'subsp.', 'subspec.',
+// 'nothosubsp.'). The package maps all of them to one Rank, that has an
+// identifier, a level for sorting ranks from the highest to the lowest,
+// the nomenclatural code that uses the rank, and a URI of the rank in the
+// GBIF rank vocabulary. Ranks of nothotaxa are the same as ranks of the
+// corresponding non-hybrid taxa (ICN Art. H.1), and ranks of agamospecies
+// are the same as ranks of species.
+package rank
+
+import (
+	"strings"
+
+	"github.com/gnames/gnlib/ent/nomcode"
+)
+
+// Rank is a taxonomic rank.
+type Rank int
+
+// Ranks are ordered from the highest to the lowest. Ranks that are not
+// placed in the hierarchy are at the end.
+const (
+	Unknown Rank = iota
+	Realm
+	Subrealm
+	Kingdom
+	Subkingdom
+	Phylum
+	Subphylum
+	Class
+	Subclass
+	Superorder
+	Order
+	Suborder
+	Superfamily
+	Family
+	Subfamily
+	Supertribe
+	Tribe
+	Subtribe
+	Genus
+	Subgenus
+	Section
+	Subsection
+	Series
+	Subseries
+	Species
+	Subspecies
+	Natio
+	Convariety
+	Proles
+	Race
+	Variety
+	Subvariety
+	Form
+	Subform
+	FormaSpecialis
+	Pathovar
+	Morph
+	Aberration
+	Strain
+	InfraspecificName
+	Unranked
+)
+
+// URIPrefix is the prefix of URIs of ranks in the GBIF rank vocabulary.
+const URIPrefix = "http://rs.gbif.org/vocabulary/gbif/rank/"
+
+// data contains identifier, level and code of a rank. Levels leave gaps
+// for ranks that might be added later.
+type data struct {
+	id    string
+	level int
+	code  nomcode.Code
+}
+
+var rankData = map[Rank]data{
+	Realm:             {"realm", 10, nomcode.Virus},
+	Subrealm:          {"subrealm", 11, nomcode.Virus},
+	Kingdom:           {"kingdom", 20, nomcode.Unknown},
+	Subkingdom:        {"subkingdom", 21, nomcode.Unknown},
+	Phylum:            {"phylum", 30, nomcode.Unknown},
+	Subphylum:         {"subphylum", 31, nomcode.Unknown},
+	Class:             {"class", 40, nomcode.Unknown},
+	Subclass:          {"subclass", 41, nomcode.Unknown},
+	Superorder:        {"superorder", 49, nomcode.Unknown},
+	Order:             {"order", 50, nomcode.Unknown},
+	Suborder:          {"suborder", 51, nomcode.Unknown},
+	Superfamily:       {"superfamily", 59, nomcode.Zoological},
+	Family:            {"family", 60, nomcode.Unknown},
+	Subfamily:         {"subfamily", 61, nomcode.Unknown},
+	Supertribe:        {"supertribe", 62, nomcode.Unknown},
+	Tribe:             {"tribe", 63, nomcode.Unknown},
+	Subtribe:          {"subtribe", 64, nomcode.Unknown},
+	Genus:             {"genus", 70, nomcode.Unknown},
+	Subgenus:          {"subgenus", 71, nomcode.Unknown},
+	Section:           {"section", 72, nomcode.Botanical},
+	Subsection:        {"subsection", 73, nomcode.Botanical},
+	Series:            {"series", 74, nomcode.Botanical},
+	Subseries:         {"subseries", 75, nomcode.Botanical},
+	Species:           {"species", 80, nomcode.Unknown},
+	Subspecies:        {"subspecies", 81, nomcode.Unknown},
+	Natio:             {"natio", 82, nomcode.Zoological},
+	Convariety:        {"convariety", 83, nomcode.Cultivars},
+	Proles:            {"proles", 84, nomcode.Botanical},
+	Race:              {"race", 84, nomcode.Unknown},
+	Variety:           {"variety", 85, nomcode.Botanical},
+	Subvariety:        {"subvariety", 86, nomcode.Botanical},
+	Form:              {"form", 87, nomcode.Botanical},
+	Subform:           {"subform", 88, nomcode.Botanical},
+	FormaSpecialis:    {"formaSpecialis", 89, nomcode.Botanical},
+	Pathovar:          {"pathovar", 89, nomcode.Bacterial},
+	Morph:             {"morph", 89, nomcode.Zoological},
+	Aberration:        {"aberration", 89, nomcode.Zoological},
+	Strain:            {"strain", 90, nomcode.Bacterial},
+	InfraspecificName: {"infraspecificName", 89, nomcode.Unknown},
+	Unranked:          {"unranked", 0, nomcode.Unknown},
+}
+
+// noURI are ranks that are not in the GBIF rank vocabulary.
+var noURI = map[Rank]struct{}{
+	Unknown:  {},
+	Realm:    {},
+	Subrealm: {},
+}
+
+// tokens maps rank tokens of the grammar (without periods) and ranks of
+// the ICVCN to ranks.
+var tokens = map[string]Rank{
+	// ICVCN ranks
+	"realm": Realm, "subrealm": Subrealm, "kingdom": Kingdom,
+	"subkingdom": Subkingdom, "phylum": Phylum, "subphylum": Subphylum,
+	"class": Class, "subclass": Subclass, "order": Order,
+	"suborder": Suborder, "family": Family, "subfamily": Subfamily,
+	"genus": Genus, "species": Species,
+
+	// RankUninomialPlain, RankUninomialNotho and ranks inferred from
+	// endings of uninomials. Division is the same rank as phylum
+	// (ICN Art. 3.1).
+	"div": Phylum, "subdiv": Subphylum, "cl": Class, "subcl": Subclass,
+	"superord": Superorder, "ord": Order, "subord": Suborder,
+	"superfam": Superfamily, "fam": Family, "subfam": Subfamily,
+	"supertrib": Supertribe, "trib": Tribe, "tr": Tribe,
+	"subtrib": Subtribe, "subtr": Subtribe, "gen": Genus,
+	"subgen": Subgenus, "subg": Subgenus, "subgeen": Subgenus,
+	"sect": Section, "subsect": Subsection, "ser": Series,
+	"subser": Subseries, "unr": Unranked,
+
+	// binomials
+	"sp": Species,
+
+	// RankSsp, RankVar, RankForma
+	"subsp": Subspecies, "ssp": Subspecies, "subspec": Subspecies,
+	"var": Variety, "[var.]": Variety, "variety": Variety,
+	"f": Form, "fa": Form, "fo": Form, "fm": Form, "fma": Form,
+	"form": Form, "forma": Form,
+
+	// RankOther
+	"morph": Morph, "convar": Convariety, "pseudovar": InfraspecificName,
+	"subvar": Subvariety, "subf": Subform, "race": Race, "pv": Pathovar,
+	"pathovar": Pathovar, "ab": Aberration, "ab. n": Aberration,
+	"st": Strain,
+
+	// RankOtherUncommon, other tokens are not known ranks
+	"natio": Natio, "nat": Natio, "f.sp": FormaSpecialis, "prol": Proles,
+
+	// RankAgamo
+	"agamosp": Species, "agamossp": Subspecies, "agamovar": Variety,
+
+	// RankNotho, 'su' and 'supsp' are common misspellings of 'subsp.',
+	// 'morth' of 'morph'.
+	"nvar": Variety, "morth": Morph, "su": Subspecies, "supsp": Subspecies,
+}
+
+// New converts a rank from a name-string to Rank. It accepts tokens of
+// ranks with or without periods, ranks of nothotaxa and ranks of the
+// ICVCN. Tokens of ranks that are not known ('*', 'α', 'mut.') are
+// returned as InfraspecificName, empty strings as Unknown.
+func New(s string) Rank {
+	s = strings.ToLower(strings.TrimSpace(s))
+	s = strings.TrimSuffix(s, ".")
+	if s == "" {
+		return Unknown
+	}
+	if r, ok := tokens[s]; ok {
+		return r
+	}
+	if nothoRank, ok := strings.CutPrefix(s, "notho"); ok {
+		nothoRank = strings.TrimSpace(nothoRank)
+		if r, ok := tokens[nothoRank]; ok {
+			return r
+		}
+	}
+	return InfraspecificName
+}
+
+// ID returns the identifier of the rank, it is the same as the last
+// part of the URI of the rank.
+func (r Rank) ID() string {
+	return rankData[r].id
+}
+
+// Level returns a number for sorting ranks from the highest to the
+// lowest. Ranks of the same level are used by different codes.
+// Unranked and Unknown ranks have level 0.
+func (r Rank) Level() int {
+	return rankData[r].level
+}
+
+// Code returns the nomenclatural code that uses the rank. It is
+// nomcode.Unknown for ranks that are used by several codes.
+func (r Rank) Code() nomcode.Code {
+	return rankData[r].code
+}
+
+// URI returns the URI of the rank in the GBIF rank vocabulary. It is empty
+// for ranks that are not in the vocabulary, like ICVCN realms.
+func (r Rank) URI() string {
+	if _, ok := noURI[r]; ok {
+		return ""
+	}
+	return URIPrefix + r.ID()
+}
+
+// String implements fmt.Stringer interface.
+func (r Rank) String() string {
+	return r.ID()
+}
@@ -0,0 +1,77 @@
+package rank_test
+
+import (
+	"testing"
+
+	"github.com/gnames/gnlib/ent/nomcode"
+	"github.com/gnames/gnparser/ent/rank"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew(t *testing.T) {
+	assert := assert.New(t)
+	tests := []struct {
+		msg, token string
+		res        rank.Rank
+	}{
+		{"empty", "", rank.Unknown},
+		{"ssp", "ssp.", rank.Subspecies},
+		{"subsp", "subsp.", rank.Subspecies},
+		{"subspec", "subspec", rank.Subspecies},
+		{"notho ssp", "nothossp.", rank.Subspecies},
+		{"notho typo", "nothosu.", rank.Subspecies},
+		{"nvar", "nvar.", rank.Variety},
+		{"var brackets", "[var.]", rank.Variety},
+		{"forma", "fma.", rank.Form},
+		{"forma specialis", "f.sp.", rank.FormaSpecialis},
+		{"convar", "convar.", rank.Convariety},
+		{"pathovar", "pv.", rank.Pathovar},
+		{"aberration", "ab. n.", rank.Aberration},
+		{"natio", "nat.", rank.Natio},
+		{"agamo", "agamosp.", rank.Species},
+		{"section", "sect.", rank.Section},
+		{"notho section", "notho sect.", rank.Section},
+		{"notho genus", "nothogen.", rank.Genus},
+		{"subgenus typo", "subgeen.", rank.Subgenus},
+		{"division", "div.", rank.Phylum},
+		{"family", "fam.", rank.Family},
+		{"unranked", "unr.", rank.Unranked},
+		{"icvcn", "family", rank.Family},
+		{"icvcn realm", "realm", rank.Realm},
+		{"uppercase", "Var.", rank.Variety},
+		{"greek", "β", rank.InfraspecificName},
+		{"mutation", "mut.", rank.InfraspecificName},
+	}
+
+	for _, v := range tests {
+		assert.Equal(v.res, rank.New(v.token), v.msg)
+	}
+}
+
+func TestLevel(t *testing.T) {
+	assert := assert.New(t)
+	ranks := []string{"realm", "kingdom", "div.", "cl.", "ord.", "fam.",
+		"subfam.", "trib.", "genus", "subg.", "sect.", "ser.", "sp.", "ssp.",
+		"var.", "subvar.", "f.", "subf."}
+	for i := 1; i < len(ranks); i++ {
+		assert.Less(
+			rank.New(ranks[i-1]).Level(), rank.New(ranks[i]).Level(), ranks[i],
+		)
+	}
+	assert.Equal(0, rank.New("unr.").Level())
+}
+
+func TestRankData(t *testing.T) {
+	assert := assert.New(t)
+	r := rank.New("var.")
+	assert.Equal("variety", r.ID())
+	assert.Equal("variety", r.String())
+	assert.Equal(nomcode.Botanical, r.Code())
+	assert.Equal("http://rs.gbif.org/vocabulary/gbif/rank/variety", r.URI())
+
+	r = rank.New("ssp.")
+	assert.Equal(nomcode.Unknown, r.Code())
+	assert.Equal("", rank.Unknown.URI())
+	assert.Equal("", rank.Realm.URI())
+	assert.Equal("realm", rank.Realm.ID())
+}
@@ -209,7 +209,7 @@ func checkNounSuffix(sw StemmedWord) StemmedWord {
 			stem := string(wrdR[:len(wrdR)-len(v)])
 			if len(stem) >= 2 {
 				sw.Stem = stem
-				sw.Suffix = string(wrdR[len(v):])
+				sw.Suffix = string(wrdR[len(wrdR)-len(v):])
 			}
 		}
 	}
@@ -18,6 +18,11 @@ func TestStemmer(t *testing.T) {
 		assert.Equal(t, "detorque", stemmer.Stem("detorque").Stem)
 		assert.Equal(t, "something", stemmer.Stem("somethingque").Stem)
 	})
+	t.Run("keeps removed suffix", func(t *testing.T) {
+		assert.Equal(t, "a", stemmer.Stem("nigra").Suffix)
+		assert.Equal(t, "ius", stemmer.Stem("varius").Suffix)
+		assert.Equal(t, "", stemmer.Stem("niger").Suffix)
+	})
 	t.Run("removes suffixes correctly", func(t *testing.T) {
 		for k, v := range stemsDict {
 			assert.Equal(t, v, stemmer.Stem(k).Stem)
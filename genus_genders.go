@@ -0,0 +1,22 @@
+package gnparser
+
+import (
+	"maps"
+
+	"github.com/gnames/gnparser/ent/gender"
+	"github.com/gnames/gnparser/io/dict"
+)
+
+// LoadGenusGenders reads genders of genera from a file and adds them to
+// the built-in dictionary. Genders from the file replace built-in genders
+// of the same genera. Every line of the file contains a genus and its
+// gender ('m', 'f' or 'n') separated by a tab or spaces.
+func LoadGenusGenders(path string) (map[string]gender.Gender, error) {
+	m, err := dict.LoadGenusGenders(path)
+	if err != nil {
+		return nil, err
+	}
+	res := maps.Clone(dict.Dict.GenusGender)
+	maps.Copy(res, m)
+	return res, nil
+}
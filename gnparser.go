@@ -11,9 +11,13 @@ import (
 
 	"github.com/gnames/gnfmt"
 	"github.com/gnames/gnlib/ent/gnvers"
+	"github.com/gnames/gnparser/ent/codeinfer"
+	"github.com/gnames/gnparser/ent/gender"
 	"github.com/gnames/gnparser/ent/nameidx"
+	"github.com/gnames/gnparser/ent/orthography"
 	"github.com/gnames/gnparser/ent/parsed"
 	"github.com/gnames/gnparser/ent/parser"
+	"github.com/gnames/gnparser/io/dict"
 )
 
 // gnparser is an implementation of GNparser interface.
@@ -53,6 +57,13 @@ func (gnp gnparser) Debug(s string) []byte {
 	return gnp.parser.Debug(s)
 }
 
+// DebugTree returns complete and 'output' syntax trees of a string. It
+// creates a new parsing engine for every call, so it is safe to use from
+// many goroutines, for example by web services.
+func (gnp gnparser) DebugTree(s string) parsed.SyntaxTree {
+	return parser.New().DebugTree(s)
+}
+
 // Parse function parses input string according to configurations.
 // It takes a string and returns an parsed.Parsed object.
 func (gnp gnparser) ParseName(s string) parsed.Parsed {
@@ -73,9 +84,76 @@ func (gnp gnparser) ParseName(s string) parsed.Parsed {
 		gnp.cfg.WithDetails,
 		gnp.cfg.WithSpeciesGroupCut,
 	)
+	if gnp.cfg.WithRankInference {
+		parser.InferRank(&res, gnp.cfg.Code)
+	}
+	if gnp.cfg.WithSuggestions {
+		words := nameWords(res, sciNameNode)
+		res.Suggestions = orthography.Suggest(res, words, gnp.cfg.Code)
+	}
+	if gnp.cfg.WithGenderAgreement {
+		words := nameWords(res, sciNameNode)
+		res.AddWarnings(gender.Check(res, words, gnp.genusGenders())...)
+	}
+	if gnp.cfg.WithCodeInference {
+		words := nameWords(res, sciNameNode)
+		res.NomCodeInferred = codeinfer.Infer(res, words)
+	}
+	// the hierarchy depends on the inferred code.
+	if gnp.cfg.WithHierarchy {
+		res.Hierarchy = gnp.hierarchy(res, sciNameNode)
+	}
+	gnp.cfg.QualityProfile.Apply(&res)
+	res.Localize(gnp.cfg.Language)
 	return res
 }
 
+// nameWords returns parsed words of a name. Words are taken from the
+// syntax tree, because they are not a part of the output without details.
+func nameWords(
+	res parsed.Parsed,
+	sn parser.ScientificNameNode,
+) []parsed.Word {
+	if w, ok := sn.(interface{ Words() []parsed.Word }); ok {
+		return w.Words()
+	}
+	return res.Words
+}
+
+// hierarchy returns names implied by a parsed name. Details are taken from
+// the syntax tree, because they are not a part of the output without
+// details. Without details authorship has no groups of authors, the same
+// as the authorship of the name.
+func (gnp gnparser) hierarchy(
+	res parsed.Parsed,
+	sn parser.ScientificNameNode,
+) []parsed.HierarchyElem {
+	if d, ok := sn.(interface{ Details() parsed.Details }); ok {
+		res.Details = d.Details()
+	}
+	hr := res.NameHierarchy()
+	if gnp.cfg.WithDetails {
+		return hr
+	}
+	for i := range hr {
+		if au := hr[i].Authorship; au != nil {
+			au2 := *au
+			au2.Original, au2.Combination = nil, nil
+			hr[i].Authorship = &au2
+		}
+	}
+	return hr
+}
+
+// genusGenders returns genders of genera from the configuration, or from
+// the built-in dictionary if they are not set.
+func (gnp gnparser) genusGenders() map[string]gender.Gender {
+	if gnp.cfg.GenusGenders != nil {
+		return gnp.cfg.GenusGenders
+	}
+	return dict.Dict.GenusGender
+}
+
 // ParseNames function takes input names and returns parsed results.
 func (gnp gnparser) ParseNames(names []string) []parsed.Parsed {
 	res := make([]parsed.Parsed, len(names))
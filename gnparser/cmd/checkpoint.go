@@ -0,0 +1,234 @@
+package cmd
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"log/slog"
+	"os"
+	"slices"
+
+	"github.com/gnames/gnfmt"
+	"github.com/gnames/gnparser"
+)
+
+// checkpointExt is added to the output path to get the path of its
+// checkpoint file.
+const checkpointExt = ".checkpoint"
+
+// ckpt records progress of parsing when checkpoints are enabled, it is nil
+// otherwise.
+var ckpt *checkpoint
+
+// checkpoint is the state of a long parsing job. It is saved next to the
+// output file, so an interrupted job can continue from the last
+// fully written name-string.
+type checkpoint struct {
+	path string
+	rw   *resultWriter
+
+	// Inputs are the input files of the job.
+	Inputs []string `json:"inputs"`
+
+	// Format, Details, Flatten, Source and Compression are output settings
+	// that have to stay the same when the job continues.
+	Format      string `json:"format"`
+	Details     bool   `json:"details"`
+	Flatten     bool   `json:"flatten"`
+	Source      bool   `json:"source"`
+	Compression string `json:"compression,omitempty"`
+
+	// Parsing are settings that change parsing results, they have to stay
+	// the same when the job continues as well.
+	Parsing parsingSettings `json:"parsing"`
+
+	// Names is the number of name-strings written to the output.
+	Names int `json:"names"`
+
+	// SourceFile and SourceLine are the location of the last written
+	// name-string.
+	SourceFile string `json:"sourceFile"`
+	SourceLine int    `json:"sourceLine"`
+
+	// Offset is the size of the output with all written results.
+	Offset int64 `json:"offset"`
+
+	// Done is true when all input is parsed.
+	Done bool `json:"done"`
+}
+
+// parsingSettings are settings of the parser that change parsing results.
+// Dictionaries of the settings are kept as digests of their content.
+type parsingSettings struct {
+	Code              string `json:"code"`
+	QualityProfile    string `json:"qualityProfile"`
+	Qualities         string `json:"qualities"`
+	Language          string `json:"language"`
+	Capitalize        bool   `json:"capitalize"`
+	IgnoreHTMLTags    bool   `json:"ignoreHTMLTags"`
+	PreserveDiaereses bool   `json:"preserveDiaereses"`
+	CompactAuthors    bool   `json:"compactAuthors"`
+	SpeciesGroupCut   bool   `json:"speciesGroupCut"`
+	Suggestions       bool   `json:"suggestions"`
+	GenderAgreement   bool   `json:"genderAgreement"`
+	GenusGenders      string `json:"genusGenders"`
+	Hierarchy         bool   `json:"hierarchy"`
+	CodeInference     bool   `json:"codeInference"`
+	RankInference     bool   `json:"rankInference"`
+}
+
+func newParsingSettings(cfg gnparser.Config) parsingSettings {
+	return parsingSettings{
+		Code:              cfg.Code.ID(),
+		QualityProfile:    cfg.QualityProfile.Name,
+		Qualities:         digest(cfg.QualityProfile.Qualities),
+		Language:          cfg.Language,
+		Capitalize:        cfg.WithCapitalization,
+		IgnoreHTMLTags:    cfg.IgnoreHTMLTags,
+		PreserveDiaereses: cfg.WithPreserveDiaereses,
+		CompactAuthors:    cfg.WithCompactAuthors,
+		SpeciesGroupCut:   cfg.WithSpeciesGroupCut,
+		Suggestions:       cfg.WithSuggestions,
+		GenderAgreement:   cfg.WithGenderAgreement,
+		GenusGenders:      digest(cfg.GenusGenders),
+		Hierarchy:         cfg.WithHierarchy,
+		CodeInference:     cfg.WithCodeInference,
+		RankInference:     cfg.WithRankInference,
+	}
+}
+
+// digest returns a SHA-256 digest of a dictionary, or an empty string
+// for an empty one. Keys of maps are sorted by JSON encoding, so the
+// same content always has the same digest.
+func digest[K comparable, V any](m map[K]V) string {
+	if len(m) == 0 {
+		return ""
+	}
+	data, err := json.Marshal(m)
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// newCheckpoint creates a checkpoint for the output path. With resume it
+// loads a saved checkpoint and checks that it was made for the same job.
+// If there is no saved checkpoint, the job starts from the beginning.
+func newCheckpoint(
+	outputPath string,
+	inputs []string,
+	cfg gnparser.Config,
+	compression string,
+	resume bool,
+) (*checkpoint, error) {
+	res := &checkpoint{
+		path:        outputPath + checkpointExt,
+		Inputs:      inputs,
+		Format:      cfg.Format.String(),
+		Details:     cfg.WithDetails,
+		Flatten:     cfg.WithFlatOutput,
+		Source:      withSource,
+		Compression: compression,
+		Parsing:     newParsingSettings(cfg),
+	}
+	if !resume {
+		return res, nil
+	}
+
+	data, err := os.ReadFile(res.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return res, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot read checkpoint %s: %w", res.path, err)
+	}
+	var saved checkpoint
+	enc := gnfmt.GNjson{}
+	if err = enc.Decode(data, &saved); err != nil {
+		return nil, fmt.Errorf("cannot read checkpoint %s: %w", res.path, err)
+	}
+	if !slices.Equal(saved.Inputs, res.Inputs) ||
+		saved.Format != res.Format || saved.Details != res.Details ||
+		saved.Flatten != res.Flatten || saved.Source != res.Source ||
+		saved.Compression != res.Compression {
+		return nil, fmt.Errorf(
+			"checkpoint %s was made for different input or output settings",
+			res.path,
+		)
+	}
+	if saved.Parsing != res.Parsing {
+		return nil, fmt.Errorf(
+			"checkpoint %s was made with different parsing settings",
+			res.path,
+		)
+	}
+	saved.path = res.path
+	return &saved, nil
+}
+
+// saveCheckpoint saves the checkpoint after some results are written.
+func saveCheckpoint() {
+	if err := ckpt.save(false); err != nil {
+		slog.Error("Cannot save checkpoint", "error", err)
+		os.Exit(1)
+	}
+}
+
+// skip removes name-strings that are already written from the input.
+// Their number is taken before parsing, because results of parsing
+// change it while the input is read.
+func (c *checkpoint) skip(
+	names iter.Seq2[string, source],
+) iter.Seq2[string, source] {
+	written := c.Names
+	return func(yield func(string, source) bool) {
+		var count int
+		for name, src := range names {
+			count++
+			if count <= written {
+				continue
+			}
+			if !yield(name, src) {
+				return
+			}
+		}
+	}
+}
+
+// add records that results of n more name-strings are written, the last
+// of them came from src.
+func (c *checkpoint) add(n int, src source) {
+	c.Names += n
+	c.SourceFile = src.file
+	c.SourceLine = src.line
+}
+
+// save writes all results to the output and records the checkpoint.
+// The checkpoint file is replaced atomically, so it is never left half
+// written.
+func (c *checkpoint) save(done bool) error {
+	offset, err := c.rw.Sync()
+	if err != nil {
+		return fmt.Errorf("cannot write output: %w", err)
+	}
+	c.Offset = offset
+	c.Done = done
+
+	enc := gnfmt.GNjson{Pretty: true}
+	data, err := enc.Encode(c)
+	if err != nil {
+		return err
+	}
+	tmp := c.path + ".tmp"
+	if err = os.WriteFile(tmp, data, 0644); err != nil {
+		return fmt.Errorf("cannot save checkpoint: %w", err)
+	}
+	if err = os.Rename(tmp, c.path); err != nil {
+		return fmt.Errorf("cannot save checkpoint: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,204 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gnames/gnfmt"
+	"github.com/gnames/gnlib/ent/nomcode"
+	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/ent/gender"
+	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// namesNum is the number of name-strings in the input of test jobs.
+const namesNum = 95
+
+// testInput creates an input file with name-strings for test jobs.
+func testInput(t *testing.T) string {
+	t.Helper()
+	var sb strings.Builder
+	for i := range namesNum {
+		fmt.Fprintf(&sb, "Aus bus cus Linnaeus, %d\n", 1700+i)
+	}
+	path := filepath.Join(t.TempDir(), "names.txt")
+	err := os.WriteFile(path, []byte(sb.String()), 0644)
+	require.Nil(t, err)
+	return path
+}
+
+// runJob parses the input to the output with a checkpoint, as parse
+// does with --checkpoint or --resume. If stopAt is positive, the job is
+// interrupted after reading stopAt name-strings, as it happens on
+// SIGINT.
+func runJob(
+	t *testing.T,
+	input, out string,
+	cfg gnparser.Config,
+	resume bool,
+	stopAt int,
+) *checkpoint {
+	t.Helper()
+	var err error
+	ckpt, err = newCheckpoint(out, []string{input}, cfg, "", resume)
+	require.Nil(t, err)
+	output, err = newResultWriter(out, "", ckpt.Offset)
+	require.Nil(t, err)
+	ckpt.rw = output
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	names := readNames([]string{input})
+	if stopAt > 0 {
+		names = stopAfter(names, stopAt, cancel)
+	}
+	parseInput(ctx, names, cfg)
+	require.Nil(t, output.Close())
+	return ckpt
+}
+
+// stopAfter cancels the job when n name-strings are read.
+func stopAfter(
+	names iter.Seq2[string, source],
+	n int,
+	cancel context.CancelFunc,
+) iter.Seq2[string, source] {
+	return func(yield func(string, source) bool) {
+		var count int
+		for name, src := range names {
+			count++
+			if count > n {
+				cancel()
+			}
+			if !yield(name, src) {
+				return
+			}
+		}
+	}
+}
+
+// resetGlobals restores the state of the command after a test.
+func resetGlobals(t *testing.T) {
+	bs, out := batchSize, output
+	t.Cleanup(func() {
+		ckpt = nil
+		batchSize, output = bs, out
+	})
+}
+
+func TestResume(t *testing.T) {
+	resetGlobals(t)
+	batchSize = 10
+	input := testInput(t)
+	dir := t.TempDir()
+
+	tests := []struct {
+		msg    string
+		stream bool
+		stopAt int
+	}{
+		{"batch", false, 35},
+		{"batch at the end of a batch", false, 40},
+		{"stream", true, 35},
+		{"stream at the start", true, 1},
+	}
+
+	for i, v := range tests {
+		cfg := gnparser.NewConfig(
+			gnparser.OptFormat(gnfmt.CSV),
+			gnparser.OptIsTest(true),
+			gnparser.OptWithStream(v.stream),
+			gnparser.OptJobsNum(4),
+		)
+
+		full := filepath.Join(dir, fmt.Sprintf("full%d.csv", i))
+		c := runJob(t, input, full, cfg, false, 0)
+		assert.True(t, c.Done, v.msg)
+		assert.Equal(t, namesNum, c.Names, v.msg)
+
+		out := filepath.Join(dir, fmt.Sprintf("out%d.csv", i))
+		c = runJob(t, input, out, cfg, false, v.stopAt)
+		assert.False(t, c.Done, v.msg)
+		assert.Equal(t, v.stopAt, c.Names, v.msg)
+		assert.Equal(t, v.stopAt, c.SourceLine, v.msg)
+
+		c = runJob(t, input, out, cfg, true, 0)
+		assert.True(t, c.Done, v.msg)
+		assert.Equal(t, namesNum, c.Names, v.msg)
+
+		want, err := os.ReadFile(full)
+		require.Nil(t, err)
+		got, err := os.ReadFile(out)
+		require.Nil(t, err)
+		assert.Equal(t, string(want), string(got), v.msg)
+		lines := strings.Count(string(got), "\n")
+		assert.Equal(t, namesNum+1, lines, v.msg)
+	}
+}
+
+func TestCheckpointSettings(t *testing.T) {
+	resetGlobals(t)
+	batchSize = 10
+	input := testInput(t)
+	out := filepath.Join(t.TempDir(), "out.csv")
+
+	zoo, err := parsed.NewQualityProfileByName("zoological")
+	require.Nil(t, err)
+	custom, err := parsed.NewQualityProfile("zoological", "zoological",
+		map[string]int{"AUTH_EX_NOT_REQUIRED": 2})
+	require.Nil(t, err)
+	genders := map[string]gender.Gender{"Aus": gender.Feminine}
+
+	base := []gnparser.Option{
+		gnparser.OptFormat(gnfmt.CSV),
+		gnparser.OptIsTest(true),
+		gnparser.OptQualityProfile(zoo),
+	}
+	runJob(t, input, out, gnparser.NewConfig(base...), false, 35)
+
+	tests := []struct {
+		msg string
+		opt gnparser.Option
+		err string
+	}{
+		{"same", gnparser.OptJobsNum(2), ""},
+		{"format", gnparser.OptFormat(gnfmt.TSV), "output settings"},
+		{"code", gnparser.OptCode(nomcode.Zoological), "parsing settings"},
+		{"profile", gnparser.OptQualityProfile(parsed.QualityProfile{}),
+			"parsing settings"},
+		{"qualities", gnparser.OptQualityProfile(custom), "parsing settings"},
+		{"language", gnparser.OptLanguage("es"), "parsing settings"},
+		{"capitalize", gnparser.OptWithCapitaliation(true),
+			"parsing settings"},
+		{"suggestions", gnparser.OptWithSuggestions(true),
+			"parsing settings"},
+		{"gender", gnparser.OptWithGenderAgreement(true), "parsing settings"},
+		{"genders", gnparser.OptGenusGenders(genders), "parsing settings"},
+		{"hierarchy", gnparser.OptWithHierarchy(true), "parsing settings"},
+		{"code inference", gnparser.OptWithCodeInference(true),
+			"parsing settings"},
+		{"rank inference", gnparser.OptWithRankInference(true),
+			"parsing settings"},
+		{"species group", gnparser.OptWithSpeciesGroupCut(true),
+			"parsing settings"},
+	}
+
+	for _, v := range tests {
+		cfg := gnparser.NewConfig(append(base, v.opt)...)
+		c, err := newCheckpoint(out, []string{input}, cfg, "", true)
+		if v.err == "" {
+			assert.Nil(t, err, v.msg)
+			assert.Equal(t, 35, c.Names, v.msg)
+			continue
+		}
+		assert.NotNil(t, err, v.msg)
+		assert.Contains(t, err.Error(), v.err, v.msg)
+	}
+}
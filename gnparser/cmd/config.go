@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/io/web"
+	"github.com/spf13/cobra"
+)
+
+// configEnv is the environment variable with a path to the config file.
+const configEnv = gnparser.EnvPrefix + "CONFIG"
+
+// loadSettings sets opts and webOpts from the config file and GNPARSER_*
+// environment
+// variables. Command line flags are applied after them, so the precedence
+// is:
+//
+//	defaults < config file < environment variables < command line flags
+func loadSettings(cmd *cobra.Command) error {
+	path, _ := cmd.Flags().GetString("config")
+	if path == "" {
+		path = os.Getenv(configEnv)
+	}
+	// the default config file is optional, a given one has to exist.
+	optional := path == ""
+	if optional {
+		var err error
+		if path, err = gnparser.ConfigPath(); err != nil {
+			path = ""
+		}
+	}
+
+	var file gnparser.Settings
+	if path != "" {
+		var err error
+		file, err = gnparser.NewSettingsFromFile(path)
+		if errors.Is(err, os.ErrNotExist) && optional {
+			err = nil
+		}
+		if err != nil {
+			return fmt.Errorf("cannot read config file: %w", err)
+		}
+	}
+
+	env, err := gnparser.NewSettingsFromEnv(os.Environ())
+	if err != nil {
+		return fmt.Errorf("cannot read environment variables: %w", err)
+	}
+
+	for _, s := range []gnparser.Settings{file, env} {
+		// Parquet is an output of the command line app, not of the parser.
+		if s.Format != nil {
+			withParquet = *s.Format == "parquet"
+			if withParquet {
+				s.Format = nil
+			}
+		}
+		o, err := s.Options()
+		if err != nil {
+			return fmt.Errorf("wrong settings: %w", err)
+		}
+		opts = append(opts, o...)
+		webOpts = append(webOpts, web.SettingsOptions(s)...)
+	}
+	return nil
+}
+
+// settingsPreRun loads settings before any command runs.
+func settingsPreRun(cmd *cobra.Command, _ []string) {
+	if err := loadSettings(cmd); err != nil {
+		slog.Error("Cannot load settings", "error", err)
+		os.Exit(1)
+	}
+}
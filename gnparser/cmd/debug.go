@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/gnames/gnfmt"
+	"github.com/gnames/gnparser"
+	"github.com/spf13/cobra"
+)
+
+// debugCmd shows the parsing tree of a name.
+var debugCmd = &cobra.Command{
+	Use:   "debug name",
+	Short: "Shows Abstract Syntax Tree of a parsed name-string",
+	Long: `Shows Abstract Syntax Tree (AST) created by the parsing grammar for a
+name-string. It helps to find out why a name is parsed in a particular way.
+
+The complete tree contains all rules that matched the name-string, the
+output tree is a simplified tree used to create parsing results. Trees are
+shown as text, as JSON (rule name, span and text of every node), or as
+a Graphviz DOT graph.`,
+	Example: `gnparser debug "Aus (Bus) cus" -n bot
+gnparser debug "Aus bus L." -f json
+gnparser debug "Aus bus L." -f dot | dot -Tsvg > ast.svg`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		parsingFlags(cmd)
+		gnp := gnparser.New(gnparser.NewConfig(opts...))
+
+		format, _ := cmd.Flags().GetString("format")
+		switch format {
+		case "", "text":
+			fmt.Println(string(gnp.Debug(args[0])))
+		case "json":
+			enc := gnfmt.GNjson{Pretty: true}
+			res, err := enc.Encode(gnp.DebugTree(args[0]))
+			if err != nil {
+				slog.Error("Cannot encode syntax tree", "error", err)
+				os.Exit(1)
+			}
+			fmt.Println(string(res))
+		case "dot":
+			fmt.Print(gnp.DebugTree(args[0]).DOT())
+		default:
+			slog.Error("Unknown debug format, use 'text', 'json' or 'dot'",
+				"format", format)
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(debugCmd)
+
+	debugCmd.Flags().StringP("format", "f", "text",
+		"format of syntax trees: 'text', 'json' or 'dot'")
+	addParsingFlags(debugCmd.Flags())
+}
@@ -1,13 +1,19 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
 	"log/slog"
+	"os"
+	"slices"
 
 	"github.com/gnames/gnfmt"
 	"github.com/gnames/gnlib/ent/nomcode"
 	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/gnames/gnparser/io/web"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 )
 
 func batchSizeFlag(cmd *cobra.Command) {
@@ -17,6 +23,17 @@ func batchSizeFlag(cmd *cobra.Command) {
 	}
 }
 
+// boolFlag returns the value of a boolean flag and true if the flag is
+// given in the command line. Flags that are not given do not override
+// settings from the config file and environment.
+func boolFlag(cmd *cobra.Command, name string) (bool, bool) {
+	if !cmd.Flags().Changed(name) {
+		return false, false
+	}
+	b, _ := cmd.Flags().GetBool(name)
+	return b, true
+}
+
 func codeFlag(cmd *cobra.Command) {
 	s, _ := cmd.Flags().GetString("nomenclatural-code")
 	if s == "" {
@@ -29,9 +46,40 @@ func codeFlag(cmd *cobra.Command) {
 	opts = append(opts, gnparser.OptCode(code))
 }
 
+func qualityProfileFlag(cmd *cobra.Command) {
+	s, _ := cmd.Flags().GetString("quality-profile")
+	if s == "" {
+		return
+	}
+	qp, err := gnparser.LoadQualityProfile(s)
+	if err != nil {
+		slog.Error("Cannot load quality profile", "error", err)
+		os.Exit(1)
+	}
+	opts = append(opts, gnparser.OptQualityProfile(qp))
+}
+
+func genderDictFlag(cmd *cobra.Command) {
+	s, _ := cmd.Flags().GetString("gender-dict")
+	if s == "" {
+		return
+	}
+	genders, err := gnparser.LoadGenusGenders(s)
+	if err != nil {
+		slog.Error("Cannot load genders of genera", "error", err)
+		os.Exit(1)
+	}
+	opts = append(opts, gnparser.OptGenusGenders(genders))
+}
+
 func formatFlag(cmd *cobra.Command) {
 	s, _ := cmd.Flags().GetString("format")
+	if s == "parquet" {
+		withParquet = true
+		return
+	}
 	if s != "" {
+		withParquet = false
 		frmt, err := gnfmt.NewFormat(s)
 		if err != nil {
 			slog.Warn("Unknown format input, using default: CSV", "inut", s)
@@ -49,9 +97,8 @@ func jobsNumFlag(cmd *cobra.Command) {
 }
 
 func ignoreHTMLTagsFlag(cmd *cobra.Command) {
-	ignoreTags, _ := cmd.Flags().GetBool("ignore_tags")
-	if ignoreTags {
-		opts = append(opts, gnparser.OptIgnoreHTMLTags(true))
+	if b, ok := boolFlag(cmd, "ignore_tags"); ok {
+		opts = append(opts, gnparser.OptIgnoreHTMLTags(b))
 	}
 }
 
@@ -63,6 +110,14 @@ func portFlag(cmd *cobra.Command) int {
 	return webPort
 }
 
+func grpcPortFlag(cmd *cobra.Command) int {
+	grpcPort, _ := cmd.Flags().GetInt("grpc-port")
+	if grpcPort > 0 {
+		opts = append(opts, gnparser.OptGRPCPort(grpcPort))
+	}
+	return grpcPort
+}
+
 func versionFlag(cmd *cobra.Command) bool {
 	version, _ := cmd.Flags().GetBool("version")
 	if version {
@@ -74,16 +129,14 @@ func versionFlag(cmd *cobra.Command) bool {
 }
 
 func withCapitalizeFlag(cmd *cobra.Command) {
-	b, _ := cmd.Flags().GetBool("capitalize")
-	if b {
-		opts = append(opts, gnparser.OptWithCapitaliation(true))
+	if b, ok := boolFlag(cmd, "capitalize"); ok {
+		opts = append(opts, gnparser.OptWithCapitaliation(b))
 	}
 }
 
 func withDetailsFlag(cmd *cobra.Command) {
-	withDet, _ := cmd.Flags().GetBool("details")
-	if withDet {
-		opts = append(opts, gnparser.OptWithDetails(true))
+	if b, ok := boolFlag(cmd, "details"); ok {
+		opts = append(opts, gnparser.OptWithDetails(b))
 	}
 }
 
@@ -95,43 +148,310 @@ func withEnableCultivarsFlag(cmd *cobra.Command) {
 }
 
 func withNoOrderFlag(cmd *cobra.Command) {
-	withOrd, _ := cmd.Flags().GetBool("unordered")
-	if withOrd {
-		opts = append(opts, gnparser.OptWithNoOrder(true))
+	if b, ok := boolFlag(cmd, "unordered"); ok {
+		opts = append(opts, gnparser.OptWithNoOrder(b))
 	}
 }
 
 func withPreserveDiaeresesFlag(cmd *cobra.Command) {
-	b, _ := cmd.Flags().GetBool("diaereses")
-	if b {
-		opts = append(opts, gnparser.OptWithPreserveDiaereses(true))
+	if b, ok := boolFlag(cmd, "diaereses"); ok {
+		opts = append(opts, gnparser.OptWithPreserveDiaereses(b))
 	}
 }
 
 func withCompactAuthorsFlag(cmd *cobra.Command) {
-	b, _ := cmd.Flags().GetBool("compact-authors")
-	if b {
-		opts = append(opts, gnparser.OptWithCompactAuthors(true))
+	if b, ok := boolFlag(cmd, "compact-authors"); ok {
+		opts = append(opts, gnparser.OptWithCompactAuthors(b))
 	}
 }
 
 func withFlatOutputFlag(cmd *cobra.Command) {
-	b, _ := cmd.Flags().GetBool("flatten-output")
-	if b {
-		opts = append(opts, gnparser.OptWithFlatOutput(true))
+	if b, ok := boolFlag(cmd, "flatten-output"); ok {
+		opts = append(opts, gnparser.OptWithFlatOutput(b))
 	}
 }
 
 func spGrCutFlag(cmd *cobra.Command) {
-	b, _ := cmd.Flags().GetBool("species-group-cut")
-	if b {
-		opts = append(opts, gnparser.OptWithSpeciesGroupCut(true))
+	if b, ok := boolFlag(cmd, "species-group-cut"); ok {
+		opts = append(opts, gnparser.OptWithSpeciesGroupCut(b))
+	}
+}
+
+func withSuggestionsFlag(cmd *cobra.Command) {
+	if b, ok := boolFlag(cmd, "suggestions"); ok {
+		opts = append(opts, gnparser.OptWithSuggestions(b))
+	}
+}
+
+func withGenderAgreementFlag(cmd *cobra.Command) {
+	if b, ok := boolFlag(cmd, "gender-agreement"); ok {
+		opts = append(opts, gnparser.OptWithGenderAgreement(b))
+	}
+}
+
+func withHierarchyFlag(cmd *cobra.Command) {
+	if b, ok := boolFlag(cmd, "hierarchy"); ok {
+		opts = append(opts, gnparser.OptWithHierarchy(b))
+	}
+}
+
+func withCodeInferenceFlag(cmd *cobra.Command) {
+	if b, ok := boolFlag(cmd, "infer-code"); ok {
+		opts = append(opts, gnparser.OptWithCodeInference(b))
+	}
+}
+
+func withRankInferenceFlag(cmd *cobra.Command) {
+	if b, ok := boolFlag(cmd, "infer-rank"); ok {
+		opts = append(opts, gnparser.OptWithRankInference(b))
 	}
 }
 
 func withStreamFlag(cmd *cobra.Command) {
-	withDet, _ := cmd.Flags().GetBool("stream")
-	if withDet {
-		opts = append(opts, gnparser.OptWithStream(true))
+	if b, ok := boolFlag(cmd, "stream"); ok {
+		opts = append(opts, gnparser.OptWithStream(b))
+	}
+}
+
+func webLimitsFlags(cmd *cobra.Command) {
+	if i, _ := cmd.Flags().GetInt("max-names"); i > 0 {
+		webOpts = append(webOpts, web.OptMaxNames(i))
+	}
+	if i, _ := cmd.Flags().GetInt("max-name-length"); i > 0 {
+		webOpts = append(webOpts, web.OptMaxNameLength(i))
+	}
+	if i, _ := cmd.Flags().GetInt64("max-body-size"); i > 0 {
+		webOpts = append(webOpts, web.OptMaxBodySize(i))
+	}
+	if f, _ := cmd.Flags().GetFloat64("rate-limit"); f > 0 {
+		webOpts = append(webOpts, web.OptRateLimit(f))
+	}
+	if i, _ := cmd.Flags().GetInt("rate-burst"); i > 0 {
+		webOpts = append(webOpts, web.OptRateBurst(i))
+	}
+}
+
+func withSourceFlag(cmd *cobra.Command) {
+	withSource, _ = cmd.Flags().GetBool("with-source")
+}
+
+// outputFlags sets where results go from output, compress, checkpoint
+// and resume flags. Checkpoints need input files and an output file.
+func outputFlags(
+	cmd *cobra.Command,
+	paths []string,
+	cfg gnparser.Config,
+) error {
+	flags := cmd.Flags()
+	path, _ := flags.GetString("output")
+	s, _ := flags.GetString("compress")
+	withCheckpoint, _ := flags.GetBool("checkpoint")
+	resume, _ := flags.GetBool("resume")
+
+	compression, err := compressionName(s)
+	if err != nil {
+		return err
 	}
+
+	var offset int64
+	if withCheckpoint || resume {
+		switch {
+		case path == "":
+			return errors.New("checkpoints need an output file (--output)")
+		case withParquet:
+			return errors.New("parquet output cannot be continued from a checkpoint")
+		case len(paths) == 0 || slices.Contains(paths, stdinPath):
+			return errors.New("checkpoints need input files")
+		}
+		ckpt, err = newCheckpoint(path, paths, cfg, compression, resume)
+		if err != nil {
+			return err
+		}
+		offset = ckpt.Offset
+	}
+
+	rw, err := newResultWriter(path, compression, offset)
+	if err != nil {
+		return err
+	}
+	output = rw
+	if ckpt != nil {
+		ckpt.rw = rw
+	}
+	return nil
+}
+
+func langFlag(cmd *cobra.Command) {
+	s, _ := cmd.Flags().GetString("lang")
+	if s == "" {
+		return
+	}
+	lang, err := parsed.NewLanguage(s)
+	if err != nil {
+		slog.Error("Cannot set language", "error", err)
+		os.Exit(1)
+	}
+	opts = append(opts, gnparser.OptLanguage(lang))
+}
+
+// addParsingFlags adds flags that change how names are parsed.
+func addParsingFlags(f *pflag.FlagSet) {
+	f.BoolP("compact-authors", "a", false,
+		"remove spaces between initials of authors")
+
+	f.BoolP("capitalize", "c", false,
+		"capitalize the first letter of input name-strings")
+
+	f.BoolP("cultivar", "C", false,
+		`parse according to  cultivar code ICNCP
+(DEPRECATED, use nomenclatural-code instead)`,
+	)
+
+	f.BoolP("diaereses", "D", false, "preserve diaereses in names")
+
+	f.Bool("gender-agreement", false,
+		"warn about epithets that do not agree in gender with their genus")
+
+	f.String("gender-dict", "",
+		`file with genders of genera ('Genus<TAB>m|f|n' lines), adds
+to the built-in dictionary of --gender-agreement`)
+
+	f.Bool("hierarchy", false,
+		`add names implied by a name: genus, species and autonyms
+(JSON output only)`)
+
+	f.BoolP("ignore_tags", "i", false,
+		"ignore HTML entities and tags when parsing.")
+
+	f.Bool("infer-code", false,
+		`infer a nomenclatural code of a name with the evidence for it
+(JSON output only)`)
+
+	f.Bool("infer-rank", false,
+		`add ranks to uninomials with standard endings of ranks
+('Pinaceae' is 'fam.')`)
+
+	f.String("lang", "",
+		`language of warning messages and labels of words:
+'en', 'es', 'pt', 'fr' or 'de'`)
+
+	codeHelp := `Modifies the parser's behavior in ambiguous cases, sometimes
+introducing additional parsing rules.
+
+Accepted values are:
+  - 'bact', 'icnp', 'bacterial' for bacterial code
+  - 'bot', 'icn', 'botanical' for botanical code
+  - 'cult', 'icncp', 'cultivar' for cultivar code
+  - 'vir', 'virus', 'viral', 'ictv', 'icvcn' for viral code
+  - 'zoo', 'iczn', 'zoological' for zoological code
+
+If not set, the parser will attempt to determine the appropriate code/s.`
+	f.StringP("nomenclatural-code", "n", "", codeHelp)
+
+	f.String("quality-profile", "",
+		`assigns qualities to warnings: 'default', a nomenclatural code
+('zoological', 'botanical', 'cultivars', 'bacterial') or a YAML file`)
+
+	f.Bool("species-group-cut", false,
+		"cut autonym/species group names to species for stemmed version")
+
+	f.Bool("suggestions", false,
+		`suggest corrected canonical forms according to orthographic
+rules of nomenclatural codes (JSON output only)`)
+}
+
+// parsingFlags applies flags added by addParsingFlags.
+func parsingFlags(cmd *cobra.Command) {
+	ignoreHTMLTagsFlag(cmd)
+	withCapitalizeFlag(cmd)
+	withEnableCultivarsFlag(cmd)
+	// overrides Cultivar flag
+	codeFlag(cmd)
+	withPreserveDiaeresesFlag(cmd)
+	withCompactAuthorsFlag(cmd)
+	spGrCutFlag(cmd)
+	qualityProfileFlag(cmd)
+	langFlag(cmd)
+	withSuggestionsFlag(cmd)
+	withGenderAgreementFlag(cmd)
+	genderDictFlag(cmd)
+	withHierarchyFlag(cmd)
+	withCodeInferenceFlag(cmd)
+	withRankInferenceFlag(cmd)
+}
+
+// addOutputFlags adds flags for parsing files or STDIN and for the
+// output of results.
+func addOutputFlags(f *pflag.FlagSet) {
+	f.IntP("batch_size", "b", 0,
+		"maximum number of names in a batch send for processing.")
+
+	f.Bool("checkpoint", false,
+		`saves progress next to the output file (needs --output),
+an interrupted job can continue with --resume`)
+
+	f.String("compress", "",
+		"compress output with 'gzip', 'zstd' or 'xz'")
+
+	f.BoolP("details", "d", false, "provides more details")
+
+	formatHelp := `Sets the output format.
+
+Accepted values are:
+  - 'csv': Comma-separated values
+  - 'tsv': Tab-separated values
+  - 'compact': Compact JSON format
+  - 'pretty': Human-readable JSON format
+  - 'parquet': Apache Parquet file with flattened results (binary,
+    redirect it to a file)
+
+If not set, the output format defaults to 'csv'.`
+	f.StringP("format", "f", "", formatHelp)
+
+	f.BoolP("flatten-output", "F", false, "flattens nested JSON results")
+
+	f.IntP("jobs", "j", 0,
+		"number of threads to run. CPU's threads number is the default.")
+
+	f.StringP("output", "o", "",
+		"writes results to a file instead of STDOUT")
+
+	f.BoolP("quiet", "q", false, "do not show progress")
+
+	f.Bool("resume", false,
+		"continues parsing from the checkpoint of the output file")
+
+	f.BoolP("stream", "s", false,
+		"parse one name at a time in a stream instead of a batch parsing")
+
+	f.BoolP("unordered", "u", false,
+		"output and input are in different order")
+
+	f.Bool("with-source", false,
+		`adds file and line of name-strings to results
+(default when more than one file is parsed)`)
+}
+
+// addServiceFlags adds flags of web and gRPC services.
+func addServiceFlags(f *pflag.FlagSet) {
+	f.IntP("port", "p", 0,
+		"starts web site and REST server on the port.")
+
+	f.Int("grpc-port", 0,
+		"starts gRPC server on the port.")
+
+	f.Int("max-names", 0,
+		"web-service: maximum number of names in a request (default 10000)")
+
+	f.Int("max-name-length", 0,
+		"web-service: maximum length of a name in bytes (default 1000)")
+
+	f.Int64("max-body-size", 0,
+		"web-service: maximum size of a request body in bytes (default 10MB)")
+
+	f.Float64("rate-limit", 0,
+		"web-service: requests per second allowed from one client (0 is no limit)")
+
+	f.Int("rate-burst", 0,
+		"web-service: requests one client can send at once (default is rate-limit)")
 }
@@ -0,0 +1,183 @@
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"compress/bzip2"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"iter"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/ulikunitz/xz"
+)
+
+// stdinPath is the path of STDIN input.
+const stdinPath = "-"
+
+// source is the location of a name-string in the input.
+type source struct {
+	file string
+	line int
+}
+
+// Magic numbers of supported compression formats.
+var (
+	gzipMagic  = []byte{0x1f, 0x8b}
+	bzip2Magic = []byte("BZh")
+	zstdMagic  = []byte{0x28, 0xb5, 0x2f, 0xfd}
+	xzMagic    = []byte{0xfd, '7', 'z', 'X', 'Z', 0x00}
+)
+
+// inputPaths converts command line arguments into a list of files.
+// Arguments can be files, directories (all files inside are used) or glob
+// patterns, stdinPath stands for STDIN. If an argument does not match any
+// file, it is returned in the second slice.
+func inputPaths(args []string) ([]string, []string, error) {
+	var paths, missing []string
+	for _, arg := range args {
+		if arg == stdinPath {
+			paths = append(paths, arg)
+			continue
+		}
+		matches := []string{arg}
+		if _, err := os.Stat(arg); err != nil {
+			var gerr error
+			if matches, gerr = filepath.Glob(arg); gerr != nil || len(matches) == 0 {
+				missing = append(missing, arg)
+				continue
+			}
+		}
+
+		for _, m := range matches {
+			files, err := dirFiles(m)
+			if err != nil {
+				return nil, nil, err
+			}
+			paths = append(paths, files...)
+		}
+	}
+	return paths, missing, nil
+}
+
+// dirFiles returns all regular files of a directory and its
+// subdirectories in lexical order. For a file it returns the file.
+func dirFiles(path string) ([]string, error) {
+	var res []string
+	err := filepath.WalkDir(path, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.Type().IsRegular() {
+			res = append(res, p)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot read %s: %w", path, err)
+	}
+	slices.Sort(res)
+	return res, nil
+}
+
+// openInput opens a file, or STDIN for stdinPath. Data compressed by gzip,
+// bzip2, zstd or xz is decompressed transparently.
+func openInput(path string) (io.Reader, func(), error) {
+	f := os.Stdin
+	if path != stdinPath {
+		var err error
+		if f, err = os.Open(path); err != nil {
+			return nil, nil, fmt.Errorf("cannot open %s: %w", path, err)
+		}
+	}
+	closeFile := func() {
+		if f != os.Stdin {
+			f.Close()
+		}
+	}
+
+	r, err := decompress(bufio.NewReader(f))
+	if err != nil {
+		closeFile()
+		return nil, nil, fmt.Errorf("cannot decompress %s: %w", path, err)
+	}
+	closeInput := func() {
+		if c, ok := r.(io.Closer); ok {
+			c.Close()
+		}
+		closeFile()
+	}
+	return r, closeInput, nil
+}
+
+// decompress detects compression by magic numbers. Data without known
+// compression is returned as is.
+func decompress(br *bufio.Reader) (io.Reader, error) {
+	// Peek returns an error for short inputs, what is peeked is enough.
+	head, _ := br.Peek(len(xzMagic))
+	switch {
+	case bytes.HasPrefix(head, gzipMagic):
+		return gzip.NewReader(br)
+	case bytes.HasPrefix(head, bzip2Magic):
+		return bzip2.NewReader(br), nil
+	case bytes.HasPrefix(head, zstdMagic):
+		d, err := zstd.NewReader(br)
+		if err != nil {
+			return nil, err
+		}
+		return d.IOReadCloser(), nil
+	case bytes.HasPrefix(head, xzMagic):
+		return xz.NewReader(br)
+	default:
+		return br, nil
+	}
+}
+
+// readNames returns name-strings from all inputs, one per line, with
+// their locations. Files that cannot be read are reported and skipped.
+func readNames(paths []string) iter.Seq2[string, source] {
+	return func(yield func(string, source) bool) {
+		for _, path := range paths {
+			r, closeInput, err := openInput(path)
+			if err != nil {
+				slog.Error("Cannot read input", "error", err)
+				continue
+			}
+
+			sc := bufio.NewScanner(r)
+			src := source{file: path}
+			for sc.Scan() {
+				src.line++
+				if !yield(sc.Text(), src) {
+					closeInput()
+					return
+				}
+			}
+			if err := sc.Err(); err != nil {
+				slog.Error("File reading failed", "error", err, "path", path)
+			}
+			closeInput()
+		}
+	}
+}
+
+// untilDone stops input when ctx is canceled.
+func untilDone(
+	ctx context.Context,
+	names iter.Seq2[string, source],
+) iter.Seq2[string, source] {
+	return func(yield func(string, source) bool) {
+		for name, src := range names {
+			if ctx.Err() != nil || !yield(name, src) {
+				return
+			}
+		}
+	}
+}
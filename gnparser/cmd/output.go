@@ -0,0 +1,227 @@
+package cmd
+
+import (
+	"bufio"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/gnames/gnfmt"
+	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/klauspost/compress/zstd"
+	"github.com/ulikunitz/xz"
+)
+
+var (
+	// output receives parsing results.
+	output = &resultWriter{file: os.Stdout}
+
+	// withSource adds the file and the line of a name-string to its
+	// parsing results.
+	withSource bool
+)
+
+// resultWriter sends results to a file or STDOUT, compressing them if
+// needed. Compressed data is written as a sequence of independent frames
+// (gzip members, zstd frames, xz streams), so a file can be cut at the end
+// of any frame and continued later.
+type resultWriter struct {
+	file        *os.File
+	buf         *bufio.Writer
+	compression string
+	zw          io.WriteCloser
+}
+
+// compressionName returns the name of a compression format for its
+// name or file extension. Empty string means no compression.
+func compressionName(s string) (string, error) {
+	switch s {
+	case "", "xz":
+		return s, nil
+	case "gz", "gzip":
+		return "gzip", nil
+	case "zst", "zstd":
+		return "zstd", nil
+	default:
+		return "", fmt.Errorf(
+			"unknown compression '%s', use 'gzip', 'zstd' or 'xz'", s,
+		)
+	}
+}
+
+// newResultWriter creates a resultWriter for a path, or STDOUT if path is
+// empty. If offset is positive, the file is cut to the offset and results
+// are appended after it. Compression is a name from compressionName.
+func newResultWriter(
+	path, compression string,
+	offset int64,
+) (*resultWriter, error) {
+	res := &resultWriter{file: os.Stdout, compression: compression}
+	if path == "" {
+		return res, nil
+	}
+
+	flag := os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+	if offset > 0 {
+		flag = os.O_WRONLY
+	}
+	f, err := os.OpenFile(path, flag, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("cannot open output %s: %w", path, err)
+	}
+	if offset > 0 {
+		if err = f.Truncate(offset); err == nil {
+			_, err = f.Seek(offset, io.SeekStart)
+		}
+		if err != nil {
+			f.Close()
+			return nil, fmt.Errorf("cannot continue output %s: %w", path, err)
+		}
+	}
+	res.file = f
+	// only files are buffered, STDOUT results reach pipes immediately.
+	res.buf = bufio.NewWriter(f)
+	return res, nil
+}
+
+// toTerminal is true when results are shown in a terminal.
+func (w *resultWriter) toTerminal() bool {
+	stat, err := w.file.Stat()
+	return err == nil && (stat.Mode()&os.ModeCharDevice) != 0
+}
+
+func (w *resultWriter) Write(p []byte) (int, error) {
+	if w.compression != "" && w.zw == nil {
+		if err := w.newFrame(); err != nil {
+			return 0, err
+		}
+	}
+	if w.zw != nil {
+		return w.zw.Write(p)
+	}
+	if w.buf != nil {
+		return w.buf.Write(p)
+	}
+	return w.file.Write(p)
+}
+
+func (w *resultWriter) newFrame() error {
+	var dst io.Writer = w.file
+	if w.buf != nil {
+		dst = w.buf
+	}
+	var err error
+	switch w.compression {
+	case "gzip":
+		w.zw = gzip.NewWriter(dst)
+	case "zstd":
+		w.zw, err = zstd.NewWriter(dst)
+	case "xz":
+		w.zw, err = xz.NewWriter(dst)
+	}
+	if err != nil {
+		return fmt.Errorf("cannot create %s output: %w", w.compression, err)
+	}
+	return nil
+}
+
+// Sync finishes the current compressed frame, flushes buffered data and
+// returns the size of the written output file.
+func (w *resultWriter) Sync() (int64, error) {
+	if err := w.flush(); err != nil {
+		return 0, err
+	}
+	return w.file.Seek(0, io.SeekCurrent)
+}
+
+func (w *resultWriter) flush() error {
+	if w.zw != nil {
+		err := w.zw.Close()
+		w.zw = nil
+		if err != nil {
+			return err
+		}
+	}
+	if w.buf != nil {
+		return w.buf.Flush()
+	}
+	return nil
+}
+
+// Close writes all remaining data and closes the output file.
+func (w *resultWriter) Close() error {
+	err := w.flush()
+	if w.file == os.Stdout {
+		return nil
+	}
+	if cerr := w.file.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+// printHeader prints CSV/TSV header. With source it starts with the source
+// file and line columns.
+func printHeader(f gnfmt.Format, withDetails bool) {
+	// continued output has its header already.
+	if ckpt != nil && ckpt.Offset > 0 {
+		return
+	}
+	header := parsed.HeaderCSV(f, withDetails)
+	if header == "" {
+		return
+	}
+	if withSource {
+		sep := csvSep(f)
+		header = gnfmt.ToCSV([]string{"SourceFile", "SourceLine"}, sep) +
+			string(sep) + header
+	}
+	fmt.Fprintln(output, header)
+}
+
+// printResult prints a parsing result. With source, CSV/TSV rows start with
+// the file and the line of the name-string, and JSON gets sourceFile and
+// sourceLine fields.
+func printResult(p parsed.Parsed, src source, f gnfmt.Format, flatten bool) {
+	if !withSource {
+		fmt.Fprintln(output, p.Output(f, flatten))
+		return
+	}
+
+	switch f {
+	case gnfmt.CSV, gnfmt.TSV:
+		sep := csvSep(f)
+		loc := gnfmt.ToCSV([]string{src.file, strconv.Itoa(src.line)}, sep)
+		fmt.Fprintln(output, loc+string(sep)+p.Output(f, flatten))
+	default:
+		enc := gnfmt.GNjson{Pretty: f == gnfmt.PrettyJSON}
+		var res []byte
+		if flatten {
+			res, _ = enc.Encode(struct {
+				parsed.ParsedFlat
+				sourceFields
+			}{p.Flatten(), sourceFields{src.file, src.line}})
+		} else {
+			res, _ = enc.Encode(struct {
+				parsed.Parsed
+				sourceFields
+			}{p, sourceFields{src.file, src.line}})
+		}
+		fmt.Fprintln(output, string(res))
+	}
+}
+
+// sourceFields are added to JSON results with source.
+type sourceFields struct {
+	SourceFile string `json:"sourceFile"`
+	SourceLine int    `json:"sourceLine"`
+}
+
+func csvSep(f gnfmt.Format) rune {
+	if f == gnfmt.TSV {
+		return '\t'
+	}
+	return ','
+}
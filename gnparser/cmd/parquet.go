@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"bufio"
+	"log/slog"
+	"os"
+
+	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/gnames/gnparser/io/parquetio"
+)
+
+// withParquet is true when results are written in Apache Parquet format.
+var withParquet bool
+
+// parquetOutput writes parsing results to the output in Apache Parquet
+// format.
+type parquetOutput struct {
+	buf *bufio.Writer
+	w   *parquetio.Writer
+}
+
+// newParquetOutput creates Parquet output with row groups of the batch
+// size. Parquet is a binary format, so STDOUT has to be redirected to
+// a file or a pipe.
+func newParquetOutput(withDetails bool) *parquetOutput {
+	if output.toTerminal() {
+		slog.Error("Parquet output is binary, redirect it to a file")
+		os.Exit(1)
+	}
+	buf := bufio.NewWriter(output)
+	w := parquetio.NewWriter(buf, withDetails, batchSize)
+	if withSource {
+		w = parquetio.NewWriterWithSource(buf, withDetails, batchSize)
+	}
+	return &parquetOutput{buf: buf, w: w}
+}
+
+func (p *parquetOutput) write(res []parsed.Parsed, srcs []source) {
+	var pqSrcs []parquetio.Source
+	if withSource {
+		pqSrcs = make([]parquetio.Source, len(srcs))
+		for i, v := range srcs {
+			pqSrcs[i] = parquetio.Source{File: v.file, Line: v.line}
+		}
+	}
+	if err := p.w.WriteWithSource(res, pqSrcs); err != nil {
+		slog.Error("Cannot write parquet output", "error", err)
+		os.Exit(1)
+	}
+}
+
+func (p *parquetOutput) close() {
+	err := p.w.Close()
+	if err == nil {
+		err = p.buf.Flush()
+	}
+	if err != nil {
+		slog.Error("Cannot write parquet output", "error", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"log/slog"
+	"os"
+
+	"github.com/gnames/gnparser"
+	"github.com/spf13/cobra"
+)
+
+// parseCmd parses names from files, STDIN or command line.
+var parseCmd = &cobra.Command{
+	Use:   "parse [files_or_name...]",
+	Short: "Parses names from files, STDIN or a name given as an argument",
+	Long: `Parses name-strings, one per line, from files, directories, glob
+patterns or STDIN. Compressed files are decompressed transparently.
+If the only argument is not a file, it is parsed as a name-string.`,
+	Example: `gnparser parse "Homo sapiens Linnaeus 1758" -f pretty
+gnparser parse names.txt.gz -d -f compact > parsed_names.json
+cat names.txt | gnparser parse -s`,
+	Args: cobra.ArbitraryArgs,
+	Run:  runParse,
+}
+
+func init() {
+	rootCmd.AddCommand(parseCmd)
+
+	addParsingFlags(parseCmd.Flags())
+	addOutputFlags(parseCmd.Flags())
+}
+
+// runParse parses names with settings from flags of parse and root
+// commands.
+func runParse(cmd *cobra.Command, args []string) {
+	formatFlag(cmd)
+	jobsNumFlag(cmd)
+	parsingFlags(cmd)
+	withDetailsFlag(cmd)
+	withStreamFlag(cmd)
+	withNoOrderFlag(cmd)
+	withFlatOutputFlag(cmd)
+	batchSizeFlag(cmd)
+	cfg := gnparser.NewConfig(opts...)
+	batchSize = cfg.BatchSize
+
+	quiet, _ := cmd.Flags().GetBool("quiet")
+	if quiet {
+		slog.SetLogLoggerLevel(10)
+	}
+
+	withSourceFlag(cmd)
+	if !parse(cmd, args, cfg) {
+		os.Exit(1)
+	}
+}
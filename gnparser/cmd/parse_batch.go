@@ -1,10 +1,7 @@
 package cmd
 
 import (
-	"bufio"
-	"fmt"
-	"io"
-	"log/slog"
+	"iter"
 	"sync"
 	"time"
 
@@ -13,41 +10,45 @@ import (
 	"github.com/gnames/gnparser/ent/parsed"
 )
 
+// batchResult keeps parsing results of a batch together with locations of
+// their name-strings.
+type batchResult struct {
+	res  []parsed.Parsed
+	srcs []source
+}
+
 func parseBatch(
 	gnp gnparser.GNparser,
-	f io.Reader,
+	names iter.Seq2[string, source],
 ) {
-	batch := make([]string, batchSize)
-	chOut := make(chan []parsed.Parsed)
+	batch := make([]string, 0, batchSize)
+	srcs := make([]source, 0, batchSize)
+	chOut := make(chan batchResult)
 	start := time.Now()
 	var wg sync.WaitGroup
 
 	wg.Add(1)
 	go processResults(chOut, &wg, gnp.Format(), gnp.WithFlatOutput(), gnp.WithDetails())
 
-	sc := bufio.NewScanner(f)
-	var i, count int
-	for sc.Scan() {
-		batch[count] = sc.Text()
-		count++
-		if count == batchSize {
+	var i int
+	for name, src := range names {
+		batch = append(batch, name)
+		srcs = append(srcs, src)
+		if len(batch) == batchSize {
 			i++
-			progressLog(start, count*i)
-			chOut <- gnp.ParseNames(batch)
-			batch = make([]string, batchSize)
-			count = 0
+			progressLog(start, batchSize*i)
+			chOut <- batchResult{res: gnp.ParseNames(batch), srcs: srcs}
+			batch = make([]string, 0, batchSize)
+			srcs = make([]source, 0, batchSize)
 		}
 	}
-	chOut <- gnp.ParseNames(batch[:count])
+	chOut <- batchResult{res: gnp.ParseNames(batch), srcs: srcs}
 	close(chOut)
-	if err := sc.Err(); err != nil {
-		slog.Error("File reading failed", "error", err)
-	}
 	wg.Wait()
 }
 
 func processResults(
-	out <-chan []parsed.Parsed,
+	out <-chan batchResult,
 	wg *sync.WaitGroup,
 	f gnfmt.Format,
 	flatten bool,
@@ -55,14 +56,24 @@ func processResults(
 ) {
 	defer wg.Done()
 
-	header := parsed.HeaderCSV(f, withDetails)
-	if header != "" {
-		fmt.Println(header)
+	if withParquet {
+		pq := newParquetOutput(withDetails)
+		for br := range out {
+			pq.write(br.res, br.srcs)
+		}
+		pq.close()
+		return
 	}
 
-	for pr := range out {
-		for i := range pr {
-			fmt.Println(pr[i].Output(f, flatten))
+	printHeader(f, withDetails)
+
+	for br := range out {
+		for i := range br.res {
+			printResult(br.res[i], br.srcs[i], f, flatten)
+		}
+		if ckpt != nil && len(br.srcs) > 0 {
+			ckpt.add(len(br.srcs), br.srcs[len(br.srcs)-1])
+			saveCheckpoint()
 		}
 	}
 }
@@ -1,11 +1,8 @@
 package cmd
 
 import (
-	"bufio"
 	"context"
-	"fmt"
-	"io"
-	"log/slog"
+	"iter"
 	"sync"
 	"time"
 
@@ -14,18 +11,42 @@ import (
 	"github.com/gnames/gnparser/ent/parsed"
 )
 
+// sources keeps locations of name-strings that are being parsed in a
+// stream, keyed by their index.
+type sources struct {
+	sync.Mutex
+	data map[int]source
+}
+
+func (s *sources) add(idx int, src source) {
+	s.Lock()
+	s.data[idx] = src
+	s.Unlock()
+}
+
+// pop returns and forgets the location of a name-string.
+func (s *sources) pop(idx int) source {
+	s.Lock()
+	defer s.Unlock()
+	res := s.data[idx]
+	delete(s.data, idx)
+	return res
+}
+
 func getNames(
 	ctx context.Context,
-	f io.Reader,
+	names iter.Seq2[string, source],
+	srcs *sources,
 ) <-chan nameidx.NameIdx {
 	chIn := make(chan nameidx.NameIdx)
-	sc := bufio.NewScanner(f)
 
 	go func() {
 		defer close(chIn)
 		var count int
-		for sc.Scan() {
-			nameString := sc.Text()
+		for nameString, src := range names {
+			if withSource || ckpt != nil {
+				srcs.add(count, src)
+			}
 			select {
 			case <-ctx.Done():
 				return
@@ -34,34 +55,35 @@ func getNames(
 			count++
 		}
 	}()
-	if err := sc.Err(); err != nil {
-		slog.Error("Cannot read data", "error", err)
-	}
 	return chIn
 }
 
 func parseStream(
 	gnp gnparser.GNparser,
-	f io.Reader,
+	names iter.Seq2[string, source],
 ) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
-	chIn := getNames(ctx, f)
+	srcs := &sources{data: make(map[int]source)}
+	chIn := getNames(ctx, names, srcs)
 	chOut := make(chan parsed.Parsed)
 	var wg sync.WaitGroup
 	wg.Add(1)
 
 	go gnp.ParseNameStream(ctx, chIn, chOut)
 
-	// process parsing results
+	// process parsing results, with source they come in the input order.
 	go func() {
 		defer cancel()
 		defer wg.Done()
 		start := time.Now()
 
-		header := parsed.HeaderCSV(gnp.Format(), gnp.WithDetails())
-		if header != "" {
-			fmt.Println(header)
+		var pq *parquetOutput
+		if withParquet {
+			pq = newParquetOutput(gnp.WithDetails())
+			defer pq.close()
+		} else {
+			printHeader(gnp.Format(), gnp.WithDetails())
 		}
 
 		var count int
@@ -77,7 +99,18 @@ func parseStream(
 				if !ok {
 					return
 				}
-				fmt.Println(v.Output(gnp.Format(), gnp.WithFlatOutput()))
+				src := srcs.pop(count - 1)
+				if pq != nil {
+					pq.write([]parsed.Parsed{v}, []source{src})
+					continue
+				}
+				printResult(v, src, gnp.Format(), gnp.WithFlatOutput())
+				if ckpt != nil {
+					ckpt.add(1, src)
+					if count%batchSize == 0 {
+						saveCheckpoint()
+					}
+				}
 			}
 		}
 	}()
@@ -0,0 +1,82 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+
+	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/io/repl"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+// replCmd runs an interactive parsing session.
+var replCmd = &cobra.Command{
+	Use:   "repl",
+	Short: "Runs an interactive parsing session",
+	Long: `Runs an interactive session with line editing and history. Every typed
+name-string is parsed and shown with colorized words and highlighted
+warnings. Commands that start with ':' change settings of the session, for
+example ':code bot', ':details on' or ':format flat'. ':debug' shows syntax
+trees of the last name, ':help' shows all commands.
+
+If STDIN is not a terminal, lines are read without prompt and colors.`,
+	Example: `gnparser repl -n zoo`,
+	Args:    cobra.NoArgs,
+	Run: func(cmd *cobra.Command, _ []string) {
+		parsingFlags(cmd)
+		withDetailsFlag(cmd)
+		withFlatOutputFlag(cmd)
+		cfg := gnparser.NewConfig(opts...)
+
+		noColor, _ := cmd.Flags().GetBool("no-color")
+		fd := int(os.Stdin.Fd())
+		if !term.IsTerminal(fd) {
+			r := repl.New(cfg, false)
+			if err := r.Run(repl.NewLineReader(os.Stdin), os.Stdout); err != nil {
+				slog.Error("REPL stopped", "error", err)
+				os.Exit(1)
+			}
+			return
+		}
+
+		state, err := term.MakeRaw(fd)
+		if err != nil {
+			slog.Error("Cannot use terminal", "error", err)
+			os.Exit(1)
+		}
+		defer func() { _ = term.Restore(fd, state) }()
+
+		color := !noColor && os.Getenv("NO_COLOR") == ""
+		t := term.NewTerminal(
+			struct {
+				io.Reader
+				io.Writer
+			}{os.Stdin, os.Stdout},
+			repl.Prompt,
+		)
+		if color {
+			t.SetPrompt(string(t.Escape.Cyan) + repl.Prompt + string(t.Escape.Reset))
+		}
+		fmt.Fprintf(t, "GNparser %s. Type ':help' for commands, ':quit' to exit.\n",
+			gnparser.Version)
+
+		if err = repl.New(cfg, color).Run(t, t); err != nil {
+			_ = term.Restore(fd, state)
+			slog.Error("REPL stopped", "error", err)
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(replCmd)
+
+	f := replCmd.Flags()
+	f.BoolP("details", "d", false, "shows details and words")
+	f.BoolP("flatten-output", "F", false, "flattens nested JSON results")
+	f.Bool("no-color", false, "do not use colors")
+	addParsingFlags(f)
+}
@@ -2,39 +2,51 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
+	"iter"
 	"log/slog"
 	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
 	"github.com/dustin/go-humanize"
-	"github.com/gnames/gnfmt"
 	"github.com/gnames/gnparser"
 	"github.com/gnames/gnparser/ent/parsed"
 	"github.com/gnames/gnparser/io/web"
-	"github.com/gnames/gnsys"
 	"github.com/spf13/cobra"
 )
 
-// debug is true when output shows Abstract Synthax Tree instead of
-// parsed results.
-const debug = false
-
 var (
 	// opts is a container for configuration options
 	opts []gnparser.Option
 
+	// webOpts is a container for limits of web and gRPC services.
+	webOpts []web.Option
+
 	// batchSize determines the size of a batch sent to gnparser workers.
 	batchSize int
 )
 
 // rootCmd represents the base command when called without any subcommands
 var rootCmd = &cobra.Command{
-	Use:   "gnparser file_or_name",
+	Use:   "gnparser [files_or_name...]",
 	Short: "Parses scientific names into their semantic elements.",
+	// names and files are positional arguments, they are not subcommands.
+	Args: cobra.ArbitraryArgs,
 	Long: `
 Parses scientific names into their semantic elements.
 
+Without a subcommand gnparser works as 'gnparser parse', or as
+'gnparser serve' if -p or --grpc-port flags are given.
+
+Settings are taken from the config file ($XDG_CONFIG_HOME/gnparser/gnparser.yaml
+or --config), GNPARSER_* environment variables and command line flags.
+Later sources override earlier ones:
+
+  defaults < config file < environment variables < command line flags
+
 To see version:
 gnparser -V
 
@@ -48,82 +60,58 @@ gnparser "Homo sapiens Linnaeus 1758" -f compact [flags]
 or
 gnparser "Homo sapiens Linnaeus 1758" -f pretty [flags]
 
+To save results of parsing a file in Apache Parquet format:
+gnparser names.txt -f parquet -d > parsed_names.parquet
+
 To parse with maximum amount of details:
 gnparser "Homo sapiens Linnaeus 1758" -d -f pretty
 
 To parse many names from a file (one name per line):
 gnparser names.txt [flags] > parsed_names.txt
 
+To parse compressed files (gzip, bzip2, zstd, xz), many files, glob
+patterns or directories, adding file and line of every name to results:
+gnparser names.txt.gz more_names.txt.zst "lists/*.txt" dir_of_lists
+
+To compress output:
+gnparser names.txt.xz --compress zstd > parsed_names.csv.zst
+
+To save progress of a long job and continue it after an interruption:
+gnparser huge_names.txt.gz -o parsed_names.csv --checkpoint
+gnparser huge_names.txt.gz -o parsed_names.csv --checkpoint --resume
+
 To leave HTML tags and entities intact when parsing (faster)
 gnparser names.txt -n > parsed_names.txt
 
 To start web service on port 8080 with 5 concurrent jobs:
 gnparser -j 5 -p 8080
+
+To start web service with at most 1000 names per request and 10 requests
+per second from one client:
+gnparser -p 8080 --max-names 1000 --rate-limit 10
+
+To start gRPC service on port 8778 together with web service:
+gnparser -p 8080 --grpc-port 8778
  `,
 
+	PersistentPreRun: settingsPreRun,
+
 	Run: func(cmd *cobra.Command, args []string) {
 		if versionFlag(cmd) {
 			os.Exit(0)
 		}
 
-		if debug {
-			opts = append(opts, gnparser.OptDebug(true))
-		}
-
-		formatFlag(cmd)
-		jobsNumFlag(cmd)
-		ignoreHTMLTagsFlag(cmd)
-		withDetailsFlag(cmd)
-		withStreamFlag(cmd)
-		withNoOrderFlag(cmd)
-		withCapitalizeFlag(cmd)
-		withEnableCultivarsFlag(cmd)
-		// overrides Cultivar flag
-		codeFlag(cmd)
-		withPreserveDiaeresesFlag(cmd)
-		withCompactAuthorsFlag(cmd)
-		withFlatOutputFlag(cmd)
-		batchSizeFlag(cmd)
-		spGrCutFlag(cmd)
-		port := portFlag(cmd)
-		cfg := gnparser.NewConfig(opts...)
-		batchSize = cfg.BatchSize
-
-		if port != 0 {
-
-			// Create a JSON handler
-			handler := slog.NewJSONHandler(os.Stdout, nil)
-			logger := slog.New(handler).With(
-				slog.String("gnApp", "gnparser"),
-			)
-			slog.SetDefault(logger)
-
-			webopts := []gnparser.Option{
-				gnparser.OptFormat(gnfmt.CompactJSON),
-			}
-			cfg = gnparser.NewConfig(webopts...)
-			gnp := gnparser.New(cfg)
-			gnps := web.NewGNparserService(gnp, port)
-			web.Run(gnps)
-			os.Exit(0)
-		}
-
-		quiet, _ := cmd.Flags().GetBool("quiet")
-		if quiet {
-			slog.SetLogLoggerLevel(10)
+		// services started by root flags are kept for backward
+		// compatibility, 'gnparser serve' is the preferred way.
+		port, _ := cmd.Flags().GetInt("port")
+		grpcPort, _ := cmd.Flags().GetInt("grpc-port")
+		if port != 0 || grpcPort != 0 {
+			opts = append(opts, gnparser.OptPort(port), gnparser.OptGRPCPort(grpcPort))
+			runServices(cmd)
+			return
 		}
 
-		if len(args) == 0 {
-			processStdin(cmd, cfg)
-			os.Exit(0)
-		}
-		data := getInput(cmd, args)
-
-		if debug {
-			debugName(data, cfg)
-			os.Exit(0)
-		}
-		parse(data, cfg)
+		runParse(cmd, args)
 	},
 }
 
@@ -138,92 +126,15 @@ func Execute() {
 }
 
 func init() {
-	rootCmd.Flags().BoolP("compact-authors", "a", false,
-		"remove spaces between initials of authors")
-
-	rootCmd.Flags().IntP("batch_size", "b", 0,
-		"maximum number of names in a batch send for processing.")
-
-	rootCmd.Flags().BoolP("cultivar", "C", false,
-		`parse according to  cultivar code ICNCP
-(DEPRECATED, use nomenclatural-code instead)`,
-	)
-
-	codeHelp := `Modifies the parser's behavior in ambiguous cases, sometimes
-introducing additional parsing rules.
-
-Accepted values are:
-  - 'bact', 'icnp', 'bacterial' for bacterial code
-  - 'bot', 'icn', 'botanical' for botanical code
-  - 'cult', 'icncp', 'cultivar' for cultivar code
-  - 'vir', 'virus', 'viral', 'ictv', 'icvcn' for viral code
-  - 'zoo', 'iczn', 'zoological' for zoological code
-
-If not set, the parser will attempt to determine the appropriate code/s.`
-	rootCmd.Flags().StringP("nomenclatural-code", "n", "", codeHelp)
-
-	rootCmd.Flags().BoolP("capitalize", "c", false,
-		"capitalize the first letter of input name-strings")
-
-	rootCmd.Flags().BoolP("diaereses", "D", false,
-		"preserve diaereses in names")
-
-	rootCmd.Flags().BoolP("details", "d", false, "provides more details")
-
-	formatHelp := `Sets the output format.
-
-Accepted values are:
-  - 'csv': Comma-separated values
-  - 'tsv': Tab-separated values
-  - 'compact': Compact JSON format
-  - 'pretty': Human-readable JSON format
-
-If not set, the output format defaults to 'csv'.`
-	rootCmd.Flags().StringP("format", "f", "", formatHelp)
-
-	rootCmd.Flags().BoolP("ignore_tags", "i", false,
-		"ignore HTML entities and tags when parsing.")
-
-	rootCmd.Flags().IntP("jobs", "j", 0,
-		"number of threads to run. CPU's threads number is the default.")
-
-	rootCmd.Flags().IntP("port", "p", 0,
-		"starts web site and REST server on the port.")
-
-	rootCmd.Flags().BoolP("quiet", "q", false, "do not show progress")
-
-	rootCmd.Flags().BoolP("stream", "s", false,
-		"parse one name at a time in a stream instead of a batch parsing")
-
-	rootCmd.Flags().BoolP("unordered", "u", false,
-		"output and input are in different order")
+	rootCmd.PersistentFlags().String("config", "",
+		"path to the config file (default $XDG_CONFIG_HOME/gnparser/gnparser.yaml)")
 
 	rootCmd.PersistentFlags().BoolP("version", "V", false,
 		"shows build version and date, ignores other flags.")
 
-	rootCmd.Flags().
-		BoolP(
-			"species-group-cut", "", false,
-			"cut autonym/species group names to species for stemmed version",
-		)
-
-	rootCmd.Flags().BoolP(
-		"flatten-output", "F", false, "flattens nested JSON results",
-	)
-}
-
-func processStdin(cmd *cobra.Command, cfg gnparser.Config) {
-	if !checkStdin() {
-		_ = cmd.Help()
-		return
-	}
-	gnp := gnparser.New(cfg)
-
-	if cfg.WithStream {
-		parseStream(gnp, os.Stdin)
-	} else {
-		parseBatch(gnp, os.Stdin)
-	}
+	addParsingFlags(rootCmd.Flags())
+	addOutputFlags(rootCmd.Flags())
+	addServiceFlags(rootCmd.Flags())
 }
 
 func checkStdin() bool {
@@ -235,61 +146,134 @@ func checkStdin() bool {
 	return (stat.Mode() & os.ModeCharDevice) == 0
 }
 
-func getInput(cmd *cobra.Command, args []string) string {
-	var data string
-	switch len(args) {
-	case 1:
-		data = args[0]
+// parse parses names from files, directories, glob patterns or STDIN.
+// A single argument that is not a file is parsed as a name-string.
+// It returns false if parsing could not be done.
+func parse(
+	cmd *cobra.Command,
+	args []string,
+	cfg gnparser.Config,
+) bool {
+	if len(args) == 0 {
+		if !checkStdin() {
+			_ = cmd.Help()
+			return true
+		}
+		args = []string{stdinPath}
+	}
+
+	paths, missing, err := inputPaths(args)
+	if err != nil {
+		slog.Error("Cannot find input files", "error", err)
+		return false
+	}
+	if len(args) == 1 && len(missing) == 1 {
+		paths = nil
+	} else if len(missing) > 0 {
+		slog.Error("Cannot find input files", "paths", missing)
+		return false
+	} else if len(paths) == 0 {
+		slog.Error("No files to parse", "input", args)
+		return false
+	}
+
+	if len(paths) > 1 {
+		withSource = true
+	}
+	if err = outputFlags(cmd, paths, cfg); err != nil {
+		slog.Error("Cannot create output", "error", err)
+		return false
+	}
+
+	switch {
+	case len(paths) == 0:
+		parseString(gnparser.New(cfg), args[0])
+	case ckpt != nil && ckpt.Done:
+		slog.Info("All names are parsed already", "checkpoint", ckpt.path)
 	default:
-		_ = cmd.Help()
-		os.Exit(0)
+		parseFiles(paths, cfg)
+	}
+
+	if err = output.Close(); err != nil {
+		slog.Error("Cannot write output", "error", err)
+		return false
 	}
-	return data
+	return true
 }
 
-func debugName(
-	data string,
-	cfg gnparser.Config,
-) {
-	gnp := gnparser.New(cfg)
-	res := gnp.Debug(data)
-	fmt.Println(string(res))
+// parseFiles parses names from input files. On SIGINT or SIGTERM it stops
+// reading input, writes results of names that are read already and saves
+// the checkpoint.
+func parseFiles(paths []string, cfg gnparser.Config) {
+	ctx, stop := signal.NotifyContext(
+		context.Background(), syscall.SIGINT, syscall.SIGTERM,
+	)
+	defer stop()
+	go func() {
+		<-ctx.Done()
+		// the second signal stops the program at once.
+		stop()
+	}()
+
+	parseInput(ctx, readNames(paths), cfg)
 }
 
-func parse(
-	data string,
+// parseInput parses names until the input ends or the context is done.
+// Results of names that are read already are written and the checkpoint is
+// saved in both cases.
+func parseInput(
+	ctx context.Context,
+	names iter.Seq2[string, source],
 	cfg gnparser.Config,
 ) {
+	if (withSource || ckpt != nil) && cfg.WithNoOrder {
+		slog.Warn("Output keeps input order with sources or checkpoints")
+		cfg.WithNoOrder = false
+	}
+
+	if ckpt != nil {
+		names = ckpt.skip(names)
+	}
+	names = untilDone(ctx, names)
+
 	gnp := gnparser.New(cfg)
+	if cfg.WithStream {
+		parseStream(gnp, names)
+	} else {
+		parseBatch(gnp, names)
+	}
 
-	path := string(data)
-	exists, _ := gnsys.FileExists(path)
-	if exists {
-		f, err := os.OpenFile(path, os.O_RDONLY, os.ModePerm)
-		if err != nil {
-			slog.Error("Cannot open file", "error", err, "path", path)
-		}
-		if cfg.WithStream {
-			parseStream(gnp, f)
-		} else {
-			parseBatch(gnp, f)
+	interrupted := ctx.Err() != nil
+	if ckpt != nil {
+		if err := ckpt.save(!interrupted); err != nil {
+			slog.Error("Cannot save checkpoint", "error", err)
 		}
-		f.Close()
-	} else {
-		parseString(gnp, data)
 	}
+	if !interrupted {
+		return
+	}
+	if ckpt != nil {
+		slog.Warn("Parsing interrupted, use --resume to continue",
+			"names", humanize.Comma(int64(ckpt.Names)),
+			"checkpoint", ckpt.path,
+		)
+		return
+	}
+	slog.Warn("Parsing interrupted")
 }
 
 func parseString(gnp gnparser.GNparser, name string) {
 	res := gnp.ParseName(name)
-	f := gnp.Format()
-
-	header := parsed.HeaderCSV(f, gnp.WithDetails())
-	if header != "" {
-		fmt.Println(header)
+	// a name from the command line has no file and line.
+	var src source
+	if withParquet {
+		pq := newParquetOutput(gnp.WithDetails())
+		pq.write([]parsed.Parsed{res}, []source{src})
+		pq.close()
+		return
 	}
-
-	fmt.Println(res.Output(f, gnp.WithFlatOutput()))
+	printHeader(gnp.Format(), gnp.WithDetails())
+	printResult(res, src, gnp.Format(), gnp.WithFlatOutput())
 }
 
 func progressLog(start time.Time, namesNum int) {
@@ -0,0 +1,37 @@
+package cmd
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/spf13/cobra"
+)
+
+// schemaCmd prints JSON Schema of the parsing output.
+var schemaCmd = &cobra.Command{
+	Use:   "schema",
+	Short: "Prints JSON Schema of JSON output",
+	Long: `Prints JSON Schema (draft 2020-12) of results in 'compact' and 'pretty'
+formats. With --flatten-output the schema describes flattened results.`,
+	Example: `gnparser schema > gnparser.schema.json
+gnparser schema -F`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, _ []string) {
+		flat, _ := cmd.Flags().GetBool("flatten-output")
+		res, err := parsed.JSONSchema(flat)
+		if err != nil {
+			slog.Error("Cannot create schema", "error", err)
+			os.Exit(1)
+		}
+		fmt.Println(string(res))
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(schemaCmd)
+
+	schemaCmd.Flags().BoolP("flatten-output", "F", false,
+		"schema of flattened results")
+}
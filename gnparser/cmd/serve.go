@@ -0,0 +1,127 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+
+	"github.com/gnames/gnfmt"
+	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/io/grpcsrv"
+	"github.com/gnames/gnparser/io/jsonrpc"
+	"github.com/gnames/gnparser/io/web"
+	"github.com/spf13/cobra"
+)
+
+// serveCmd runs gnparser as a long-lived service.
+var serveCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "Runs web, gRPC or JSON-RPC services",
+	Long: `Runs gnparser as a long-lived service.
+
+By default it starts the web site and REST API on port 8080 (or the port
+from settings). The --grpc-port flag adds gRPC service, '--port 0' turns
+the web service off.
+
+With --stdio it runs JSON-RPC 2.0 service instead. Requests are read
+from STDIN, one JSON object per line, responses are written to STDOUT, one
+per line. Logs go to STDERR.
+
+Methods:
+  parse        {"name": "...", "options": {...}}
+  parseBatch   {"names": ["...", ...], "options": {...}}
+  changeConfig {"withDetails": true, "code": "bot", "jobsNum": 4, ...}
+  version      {}
+  debug        {"name": "..."}
+
+Options are the same as options of REST API POST requests.`,
+	Example: `gnparser serve -j 5
+gnparser serve -p 8080 --grpc-port 8778 --max-names 1000 --rate-limit 10
+echo '{"jsonrpc":"2.0","id":1,"method":"parse","params":{"name":"Aus bus"}}' |
+  gnparser serve --stdio`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, _ []string) {
+		stdio, _ := cmd.Flags().GetBool("stdio")
+		if !stdio {
+			// an explicit '--port 0' turns the web service off.
+			if cmd.Flags().Changed("port") {
+				port, _ := cmd.Flags().GetInt("port")
+				opts = append(opts, gnparser.OptPort(port))
+			}
+			grpcPortFlag(cmd)
+			runServices(cmd)
+			return
+		}
+
+		jobsNumFlag(cmd)
+		opts = append(opts, gnparser.OptFormat(gnfmt.CompactJSON))
+		cfg := gnparser.NewConfig(opts...)
+
+		ctx, stop := signal.NotifyContext(
+			context.Background(), syscall.SIGINT, syscall.SIGTERM,
+		)
+		defer stop()
+
+		srv := jsonrpc.New(cfg)
+		if err := srv.Serve(ctx, os.Stdin, os.Stdout); err != nil {
+			slog.Error("JSON-RPC service stopped", "error", err)
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(serveCmd)
+
+	f := serveCmd.Flags()
+	f.Bool("stdio", false,
+		"use STDIN and STDOUT for line-delimited JSON-RPC messages")
+	f.IntP("jobs", "j", 0,
+		"number of threads to run. CPU's threads number is the default.")
+	addServiceFlags(f)
+}
+
+// runServices starts web and gRPC services on ports from settings and
+// waits until they stop.
+func runServices(cmd *cobra.Command) {
+	jobsNumFlag(cmd)
+	webLimitsFlags(cmd)
+
+	// Create a JSON handler
+	handler := slog.NewJSONHandler(os.Stdout, nil)
+	logger := slog.New(handler).With(
+		slog.String("gnApp", "gnparser"),
+	)
+	slog.SetDefault(logger)
+
+	// parsing settings come with requests, settings and flags set
+	// jobs number and limits of the service.
+	webopts := append(opts, gnparser.OptFormat(gnfmt.CompactJSON))
+	cfg := gnparser.NewConfig(webopts...)
+	if cfg.Port == 0 && cfg.GRPCPort == 0 {
+		fmt.Fprintln(os.Stderr, "Both web and gRPC services are turned off.")
+		os.Exit(1)
+	}
+	gnp := gnparser.New(cfg)
+	webCfg := web.NewConfig(webOpts...)
+
+	var wg sync.WaitGroup
+	if cfg.GRPCPort != 0 {
+		wg.Go(func() {
+			if err := grpcsrv.Run(gnp, cfg.GRPCPort, webCfg); err != nil {
+				slog.Error("Cannot run gRPC service", "error", err)
+				os.Exit(1)
+			}
+		})
+	}
+	if cfg.Port != 0 {
+		gnps := web.NewGNparserService(gnp, cfg.Port)
+		web.Run(gnps, webCfg)
+	}
+	wg.Wait()
+	os.Exit(0)
+}
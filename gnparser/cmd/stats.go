@@ -0,0 +1,151 @@
+package cmd
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+
+	"github.com/gnames/gnfmt"
+	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/spf13/cobra"
+)
+
+// statsCmd shows statistics of parsing results.
+var statsCmd = &cobra.Command{
+	Use:   "stats [files...]",
+	Short: "Shows statistics of parsing results",
+	Long: `Parses name-strings from files, directories, glob patterns or STDIN
+and shows statistics of results in JSON format instead of the results
+themselves: distribution of quality and cardinality, ranks, warnings and
+numbers of hybrids, surrogates and viruses.`,
+	Example: `gnparser stats names.txt.gz
+cat names.txt | gnparser stats -n zoo`,
+	Run: func(cmd *cobra.Command, args []string) {
+		jobsNumFlag(cmd)
+		parsingFlags(cmd)
+		batchSizeFlag(cmd)
+		cfg := gnparser.NewConfig(opts...)
+		batchSize = cfg.BatchSize
+
+		if len(args) == 0 {
+			if !checkStdin() {
+				_ = cmd.Help()
+				return
+			}
+			args = []string{stdinPath}
+		}
+		paths, missing, err := inputPaths(args)
+		if err != nil {
+			slog.Error("Cannot find input files", "error", err)
+			os.Exit(1)
+		}
+		if len(missing) > 0 {
+			slog.Error("Cannot find input files", "paths", missing)
+			os.Exit(1)
+		}
+
+		res := parseStats(gnparser.New(cfg), paths)
+		enc := gnfmt.GNjson{Pretty: true}
+		out, err := enc.Encode(res)
+		if err != nil {
+			slog.Error("Cannot encode statistics", "error", err)
+			os.Exit(1)
+		}
+		fmt.Println(string(out))
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(statsCmd)
+
+	f := statsCmd.Flags()
+	f.IntP("batch_size", "b", 0,
+		"maximum number of names in a batch send for processing.")
+	f.IntP("jobs", "j", 0,
+		"number of threads to run. CPU's threads number is the default.")
+	addParsingFlags(f)
+}
+
+// stats are summaries of parsing results.
+type stats struct {
+	// Names is the number of parsed name-strings.
+	Names int `json:"names"`
+
+	// Parsed is the number of name-strings that were parsed successfully.
+	Parsed int `json:"parsed"`
+
+	// Quality is the number of names for every parsing quality.
+	Quality map[string]int `json:"quality"`
+
+	// Cardinality is the number of names for every cardinality.
+	Cardinality map[string]int `json:"cardinality"`
+
+	// Ranks is the number of names for every rank.
+	Ranks map[string]int `json:"ranks"`
+
+	// Warnings is the number of names with every warning.
+	Warnings map[string]int `json:"warnings"`
+
+	// Hybrids, Surrogates, Viruses, Bacteria and Cultivars are
+	// numbers of names of these kinds.
+	Hybrids    int `json:"hybrids"`
+	Surrogates int `json:"surrogates"`
+	Viruses    int `json:"viruses"`
+	Bacteria   int `json:"bacteria"`
+	Cultivars  int `json:"cultivars"`
+}
+
+// parseStats parses names from paths in batches and collects statistics.
+func parseStats(gnp gnparser.GNparser, paths []string) stats {
+	res := stats{
+		Quality:     make(map[string]int),
+		Cardinality: make(map[string]int),
+		Ranks:       make(map[string]int),
+		Warnings:    make(map[string]int),
+	}
+	batch := make([]string, 0, batchSize)
+	for name := range readNames(paths) {
+		batch = append(batch, name)
+		if len(batch) == batchSize {
+			res.add(gnp.ParseNames(batch))
+			batch = batch[:0]
+		}
+	}
+	res.add(gnp.ParseNames(batch))
+	return res
+}
+
+func (s *stats) add(ps []parsed.Parsed) {
+	for _, p := range ps {
+		s.Names++
+		s.Quality[strconv.Itoa(p.ParseQuality)]++
+		if p.Virus {
+			s.Viruses++
+		}
+		if !p.Parsed {
+			continue
+		}
+		s.Parsed++
+		s.Cardinality[strconv.Itoa(p.Cardinality)]++
+		if p.Rank != "" {
+			s.Ranks[p.Rank]++
+		}
+		for _, w := range p.QualityWarnings {
+			s.Warnings[w.Warning.String()]++
+		}
+		if p.Hybrid != nil {
+			s.Hybrids++
+		}
+		if p.Surrogate != nil {
+			s.Surrogates++
+		}
+		if p.Bacteria != nil && p.Bacteria.Bool() {
+			s.Bacteria++
+		}
+		if p.Cultivar {
+			s.Cultivars++
+		}
+	}
+}
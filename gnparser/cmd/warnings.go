@@ -0,0 +1,104 @@
+package cmd
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/gnames/gnfmt"
+	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/spf13/cobra"
+)
+
+// warningsCmd describes quality warnings of parsing results.
+var warningsCmd = &cobra.Command{
+	Use:   "warnings [CODE...]",
+	Short: "Lists quality warnings and their explanations",
+	Long: `Lists all quality warnings with their stable codes, default qualities and
+messages. If warning codes are given, shows explanations, examples,
+articles of nomenclatural codes and suggested fixes for these warnings.
+Warnings are shown as text, JSON or Markdown.`,
+	Example: `gnparser warnings
+gnparser warnings AUTH_EX_NOT_REQUIRED
+gnparser warnings -f json`,
+	Run: func(cmd *cobra.Command, args []string) {
+		infos := parsed.WarningInfos()
+		if len(args) > 0 {
+			infos = make([]parsed.WarningInfo, len(args))
+			for i, v := range args {
+				w, err := parsed.NewWarningFromCode(v)
+				if err != nil {
+					slog.Error("Cannot find warning", "error", err)
+					os.Exit(1)
+				}
+				infos[i] = w.Info()
+			}
+		}
+
+		format, _ := cmd.Flags().GetString("format")
+		switch format {
+		case "", "text":
+			if len(args) == 0 {
+				for _, v := range infos {
+					fmt.Printf("%-28s %d  %s\n", v.Code, v.Quality, v.Message)
+				}
+				return
+			}
+			for i, v := range infos {
+				if i > 0 {
+					fmt.Println()
+				}
+				fmt.Print(warningText(v))
+			}
+		case "json":
+			enc := gnfmt.GNjson{Pretty: true}
+			res, err := enc.Encode(infos)
+			if err != nil {
+				slog.Error("Cannot encode warnings", "error", err)
+				os.Exit(1)
+			}
+			fmt.Println(string(res))
+		case "md":
+			if len(args) == 0 {
+				fmt.Print(parsed.WarningsMarkdown())
+				return
+			}
+			for i, v := range infos {
+				if i > 0 {
+					fmt.Println()
+				}
+				fmt.Print(v.Markdown())
+			}
+		default:
+			slog.Error("Unknown warnings format, use 'text', 'json' or 'md'",
+				"format", format)
+			os.Exit(1)
+		}
+	},
+}
+
+// warningText describes a warning in plain text.
+func warningText(wi parsed.WarningInfo) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s (quality %d)\n", wi.Code, wi.Quality)
+	fmt.Fprintf(&b, "  Message:  %s\n", wi.Message)
+	fmt.Fprintf(&b, "  %s\n", wi.Explanation)
+	for _, v := range wi.Examples {
+		fmt.Fprintf(&b, "  Example:  %s\n", v)
+	}
+	if len(wi.Articles) > 0 {
+		fmt.Fprintf(&b, "  Articles: %s\n", strings.Join(wi.Articles, ", "))
+	}
+	if wi.Fix != "" {
+		fmt.Fprintf(&b, "  Fix:      %s\n", wi.Fix)
+	}
+	return b.String()
+}
+
+func init() {
+	rootCmd.AddCommand(warningsCmd)
+
+	warningsCmd.Flags().StringP("format", "f", "text",
+		"format of warnings: 'text', 'json' or 'md'")
+}
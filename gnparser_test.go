@@ -11,6 +11,8 @@ import (
 	"github.com/gnames/gnfmt"
 	"github.com/gnames/gnlib/ent/nomcode"
 	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/ent/combination"
+	"github.com/gnames/gnparser/ent/gender"
 	"github.com/gnames/gnparser/ent/parsed"
 	"github.com/gnames/gnsys"
 	"github.com/stretchr/testify/assert"
@@ -295,6 +297,303 @@ func TestNomCode(t *testing.T) {
 	}
 }
 
+func TestQualityProfile(t *testing.T) {
+	assert := assert.New(t)
+	name := "Aus bus Smith ex Jones"
+	tests := []struct {
+		msg, profile, res string
+		quality           int
+	}{
+		{"default", "default", "", 2},
+		{"zoo", "zoo", "zoological", 3},
+		{"bot", "bot", "botanical", 1},
+	}
+
+	for _, v := range tests {
+		qp, err := gnparser.LoadQualityProfile(v.profile)
+		assert.Nil(err, v.msg)
+		cfg := gnparser.NewConfig(gnparser.OptQualityProfile(qp))
+		gnp := gnparser.New(cfg)
+		res := gnp.ParseName(name)
+		assert.Equal(v.quality, res.ParseQuality, v.msg)
+		assert.Equal(v.quality, res.QualityWarnings[0].Quality, v.msg)
+		assert.Equal(v.res, res.QualityProfile, v.msg)
+		assert.Equal(v.res, res.Flatten().QualityProfile, v.msg)
+	}
+}
+
+func TestSuggestions(t *testing.T) {
+	assert := assert.New(t)
+	tests := []struct {
+		msg, name string
+		code      nomcode.Code
+		canonical string
+		num       int
+	}{
+		{"vowel", "Acer tiliaefolia L.", nomcode.Botanical, "Acer tiliifolia", 1},
+		{"diacritics", "Aus büsi", nomcode.Zoological, "Aus busi", 2},
+		{"capitalized", "Quercus Robur L.", nomcode.Unknown, "Quercus robur", 1},
+		{"zoo vowel", "Aus tiliaefolia", nomcode.Zoological, "", 0},
+		{"correct", "Bubo bubo (L.) 1758", nomcode.Unknown, "", 0},
+	}
+
+	for _, v := range tests {
+		for _, details := range []bool{true, false} {
+			cfg := gnparser.NewConfig(
+				gnparser.OptCode(v.code),
+				gnparser.OptWithDetails(details),
+				gnparser.OptWithSuggestions(true),
+			)
+			res := gnparser.New(cfg).ParseName(v.name)
+			assert.Len(res.Suggestions, v.num, v.msg)
+			if v.num > 0 {
+				assert.Equal(v.canonical, res.Suggestions[0].Canonical, v.msg)
+			}
+		}
+	}
+
+	gnp := gnparser.New(gnparser.NewConfig())
+	res := gnp.ParseName("Acer tiliaefolia L.")
+	assert.Nil(res.Suggestions)
+}
+
+func TestGenderAgreement(t *testing.T) {
+	assert := assert.New(t)
+	tests := []struct {
+		msg, name, correction string
+		quality               int
+	}{
+		{"feminine", "Quercus rubrum L.", "rubra", 2},
+		{"masculine", "Passer domestica (L., 1758)", "domesticus", 2},
+		{"neuter", "Acer campestris L.", "campestre", 2},
+		{"er adjective", "Pinus nigrum Arnold", "nigra", 2},
+		{"infraspecies", "Quercus robur var. rubrum", "rubra", 2},
+		{"agrees", "Quercus rubra L.", "", 1},
+		{"noun", "Pinus silvicola", "", 1},
+		{"genitive", "Quercus smithii", "", 1},
+		{"unknown genus", "Aus rubrum", "", 1},
+		{"ambiguous", "Quercus albus L.", "alba", 1},
+		{"apposition", "Equus zebra L.", "", 1},
+		{"apposition -us", "Vipera berus (Linnaeus, 1758)", "", 1},
+		{"substantive", "Felis catus Linnaeus, 1758", "", 1},
+	}
+
+	for _, v := range tests {
+		for _, details := range []bool{true, false} {
+			cfg := gnparser.NewConfig(
+				gnparser.OptWithDetails(details),
+				gnparser.OptWithGenderAgreement(true),
+			)
+			res := gnparser.New(cfg).ParseName(v.name)
+			assert.Equal(v.quality, res.ParseQuality, v.msg)
+			if v.correction == "" {
+				assert.Empty(res.QualityWarnings, v.msg)
+				continue
+			}
+			// ambiguous epithets do not change the quality.
+			w := parsed.GenderAgreementWarn
+			if v.quality == 1 {
+				w = parsed.GenderAgreementMaybeWarn
+			}
+			qw := res.QualityWarnings[0]
+			assert.Equal(w, qw.Warning, v.msg)
+			assert.Equal(v.correction, qw.Correction, v.msg)
+		}
+	}
+
+	gnp := gnparser.New(gnparser.NewConfig())
+	res := gnp.ParseName("Quercus rubrum L.")
+	assert.Empty(res.QualityWarnings)
+
+	genders := map[string]gender.Gender{"Aus": gender.Feminine}
+	cfg := gnparser.NewConfig(
+		gnparser.OptWithGenderAgreement(true),
+		gnparser.OptGenusGenders(genders),
+	)
+	res = gnparser.New(cfg).ParseName("Aus rubrum")
+	assert.Equal("rubra", res.QualityWarnings[0].Correction)
+}
+
+func TestCombination(t *testing.T) {
+	assert := assert.New(t)
+	cfg := gnparser.NewConfig(gnparser.OptWithDetails(true))
+	gnp := gnparser.New(cfg)
+	p := gnp.ParseName("Aus bus Smith, 1900")
+	target := combination.Target{Genus: "Cus", Authors: "Jones"}
+	name, res, err := combination.Parse(p, target, gnp)
+	assert.Nil(err)
+	assert.Equal("Cus bus (Smith, 1900)", name)
+	assert.Equal(name, res.Verbatim)
+	assert.Equal("Cus bus", res.Canonical.Simple)
+	assert.Equal("Smith", res.Authorship.Original.Authors[0])
+
+	// the code of the parser is used for the new combination.
+	gnp = gnparser.New(gnparser.NewConfig(
+		gnparser.OptWithDetails(true),
+		gnparser.OptCode(nomcode.Botanical),
+	))
+	p = gnp.ParseName("Aus bus Smith, 1900")
+	name, _, err = combination.Parse(p, target, gnp)
+	assert.Nil(err)
+	assert.Equal("Cus bus (Smith 1900) Jones", name)
+
+	p = gnp.ParseName("Acer rubrum L.")
+	target = combination.Target{
+		Genus:   "Quercus",
+		Authors: "Jones",
+		Gender:  gender.Feminine,
+	}
+	name, res, err = combination.Parse(p, target, gnp)
+	assert.Nil(err)
+	assert.Equal("Quercus rubra (L.) Jones", name)
+	assert.Equal("Jones", res.Authorship.Combination.Authors[0])
+
+	p = gnparser.New(gnparser.NewConfig()).ParseName("Aus bus Smith, 1900")
+	_, _, err = combination.Parse(p, target, gnp)
+	assert.NotNil(err)
+}
+
+func TestHierarchy(t *testing.T) {
+	assert := assert.New(t)
+	name := "Aus bus (Smith) Jones var. cus Doe"
+	for _, details := range []bool{true, false} {
+		cfg := gnparser.NewConfig(
+			gnparser.OptWithDetails(details),
+			gnparser.OptWithHierarchy(true),
+		)
+		res := gnparser.New(cfg).ParseName(name)
+		assert.Len(res.Hierarchy, 4)
+		assert.Equal("Aus bus var. bus", res.Hierarchy[2].Canonical.Full)
+		assert.True(res.Hierarchy[2].IsAutonym)
+		au := res.Hierarchy[1].Authorship
+		assert.Equal("(Smith) Jones", au.Normalized)
+		assert.Equal(details, au.Original != nil)
+		assert.Equal("Doe", res.Hierarchy[3].Authorship.Normalized)
+	}
+
+	res := gnparser.New(gnparser.NewConfig()).ParseName(name)
+	assert.Nil(res.Hierarchy)
+
+	// nominotypical subspecies gets the authorship of the species, if the
+	// code is set to ICZN or inferred as ICZN.
+	name = "Aus bus Linnaeus, 1758 cus Smith, 1900"
+	for _, opt := range []gnparser.Option{
+		gnparser.OptCode(nomcode.Zoological),
+		gnparser.OptWithCodeInference(true),
+	} {
+		cfg := gnparser.NewConfig(gnparser.OptWithHierarchy(true), opt)
+		res = gnparser.New(cfg).ParseName(name)
+		assert.Len(res.Hierarchy, 4)
+		autonym := res.Hierarchy[2]
+		assert.Equal("Aus bus bus", autonym.Canonical.Full)
+		assert.True(autonym.IsAutonym)
+		if assert.NotNil(autonym.Authorship) {
+			assert.Equal("Linnaeus 1758", autonym.Authorship.Normalized)
+		}
+	}
+	res = gnparser.New(gnparser.NewConfig(gnparser.OptWithHierarchy(true))).
+		ParseName(name)
+	assert.Nil(res.Hierarchy[2].Authorship)
+}
+
+func TestCodeInference(t *testing.T) {
+	assert := assert.New(t)
+	name := "Aus bus var. cus (L.) Mill."
+	for _, details := range []bool{true, false} {
+		cfg := gnparser.NewConfig(
+			gnparser.OptWithDetails(details),
+			gnparser.OptWithCodeInference(true),
+		)
+		res := gnparser.New(cfg).ParseName(name)
+		assert.Equal("ICN", res.NomCodeInferred.Code)
+		assert.Equal(0.87, res.NomCodeInferred.Confidence)
+		assert.Len(res.NomCodeInferred.Evidence, 4)
+	}
+
+	cfg := gnparser.NewConfig(gnparser.OptWithCodeInference(true))
+	res := gnparser.New(cfg).ParseName("Aus bus")
+	assert.Nil(res.NomCodeInferred)
+
+	res = gnparser.New(gnparser.NewConfig()).ParseName(name)
+	assert.Nil(res.NomCodeInferred)
+}
+
+func TestRankInference(t *testing.T) {
+	assert := assert.New(t)
+	cfg := gnparser.NewConfig(
+		gnparser.OptWithDetails(true),
+		gnparser.OptWithRankInference(true),
+	)
+	res := gnparser.New(cfg).ParseName("Pinaceae Lindl.")
+	assert.Equal("fam.", res.Rank)
+	assert.True(res.RankInferred)
+	d, ok := res.Details.(parsed.DetailsUninomial)
+	assert.True(ok)
+	assert.Equal("fam.", d.Uninomial.Rank)
+	assert.Equal("family", d.Uninomial.RankInfo.ID)
+
+	cfg = gnparser.NewConfig(
+		gnparser.OptCode(nomcode.Zoological),
+		gnparser.OptWithRankInference(true),
+	)
+	res = gnparser.New(cfg).ParseName("Felidae")
+	assert.Equal("fam.", res.Rank)
+
+	res = gnparser.New(gnparser.NewConfig()).ParseName("Pinaceae")
+	assert.Equal("", res.Rank)
+	assert.False(res.RankInferred)
+}
+
+func TestLanguage(t *testing.T) {
+	assert := assert.New(t)
+	name := "Aus bus Smith, 1887: 12"
+	tests := []struct {
+		msg, lang, cfgLang, message, label string
+	}{
+		{"default", "", "", "", ""},
+		{"en", "en", "", "", ""},
+		{"es", "es", "es", "Año con número de página", "Género"},
+		{"pt-BR", "pt-BR", "pt", "Ano com número de página", "Gênero"},
+		{"unknown", "ru", "", "", ""},
+	}
+
+	for _, v := range tests {
+		cfg := gnparser.NewConfig(
+			gnparser.OptLanguage(v.lang),
+			gnparser.OptWithDetails(true),
+		)
+		assert.Equal(v.cfgLang, cfg.Language, v.msg)
+		res := gnparser.New(cfg).ParseName(name)
+		assert.Equal(parsed.YearPageWarn, res.QualityWarnings[0].Warning, v.msg)
+		assert.Equal(v.message, res.QualityWarnings[0].Message, v.msg)
+		assert.Equal(v.label, res.Words[0].Label, v.msg)
+	}
+}
+
+func TestWarningExamples(t *testing.T) {
+	// some warnings appear only with cultivars code, with
+	// capitalization, or with the gender agreement check.
+	gnps := []gnparser.GNparser{
+		gnparser.New(gnparser.NewConfig()),
+		gnparser.New(gnparser.NewConfig(gnparser.OptCode(nomcode.Cultivars))),
+		gnparser.New(gnparser.NewConfig(gnparser.OptWithCapitaliation(true))),
+		gnparser.New(gnparser.NewConfig(gnparser.OptWithGenderAgreement(true))),
+	}
+	for _, v := range parsed.WarningInfos() {
+		for _, name := range v.Examples {
+			var found bool
+			for _, gnp := range gnps {
+				for _, qw := range gnp.ParseName(name).QualityWarnings {
+					if qw.Code == v.Code {
+						found = true
+					}
+				}
+			}
+			assert.True(t, found, v.Code+": "+name)
+		}
+	}
+}
+
 func TestBacterialCode(t *testing.T) {
 	assert := assert.New(t)
 	tests := []struct {
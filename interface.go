@@ -19,6 +19,11 @@ type GNparser interface {
 	// Debug parses a string and outputs raw AST tree from PEG engine.
 	Debug(s string) []byte
 
+	// DebugTree parses a string and returns complete and output syntax
+	// trees as a structure that can be converted to JSON or Graphviz DOT.
+	// It is safe for concurrent use.
+	DebugTree(s string) parsed.SyntaxTree
+
 	// WithFlatOutput returns whether flatten output is enabled.
 	WithFlatOutput() bool
 
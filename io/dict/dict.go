@@ -5,8 +5,12 @@ import (
 	"bufio"
 	"embed"
 	"fmt"
+	"io"
 	"log/slog"
 	"os"
+	"strings"
+
+	"github.com/gnames/gnparser/ent/gender"
 )
 
 //go:embed data
@@ -25,13 +29,17 @@ type Dictionary struct {
 	// This list is used to detect ICN name-strings so we can parse a word in
 	// parenthesis after genus word as an author instead of subgenus.
 	AuthorICN map[string]struct{}
+	// GenusGender contains grammatical genders of genera. It is used to
+	// check if adjectival epithets agree in gender with their genus.
+	GenusGender map[string]gender.Gender
 }
 
 // LoadDictionary creates dictionary from text files.
 func LoadDictionary() *Dictionary {
 	d := Dictionary{
-		Bacteria:  readBacterialData(),
-		AuthorICN: readAuthorICNData(),
+		Bacteria:    readBacterialData(),
+		AuthorICN:   readAuthorICNData(),
+		GenusGender: readGenusGenderData(),
 	}
 	return &d
 }
@@ -73,3 +81,65 @@ func scanBacterialFile(path string, isHomonym bool, m map[string]bool) {
 		m[sc.Text()] = isHomonym
 	}
 }
+
+func readGenusGenderData() map[string]gender.Gender {
+	path := "data/genera_gender.txt"
+	f, err := data.Open(path)
+	if err != nil {
+		slog.Error("Cannot open genders file", "error", err, "path", path)
+		os.Exit(1)
+	}
+	defer f.Close()
+	res, err := ReadGenusGenders(f)
+	if err != nil {
+		slog.Error("Cannot read genders file", "error", err, "path", path)
+		os.Exit(1)
+	}
+	return res
+}
+
+// ReadGenusGenders reads genders of genera. Every line contains a genus and
+// its gender ('m', 'f' or 'n') separated by a tab, or by spaces. Empty
+// lines and lines that start with '#' are ignored.
+func ReadGenusGenders(r io.Reader) (map[string]gender.Gender, error) {
+	res := make(map[string]gender.Gender)
+	sc := bufio.NewScanner(r)
+	var n int
+	for sc.Scan() {
+		n++
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf(
+				"line %d: expected genus and gender, got '%s'", n, line,
+			)
+		}
+		g, err := gender.New(fields[1])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", n, err)
+		}
+		res[fields[0]] = g
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// LoadGenusGenders reads genders of genera from a file in the format of
+// ReadGenusGenders.
+func LoadGenusGenders(path string) (map[string]gender.Gender, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	res, err := ReadGenusGenders(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return res, nil
+}
@@ -1,8 +1,10 @@
 package dict_test
 
 import (
+	"strings"
 	"testing"
 
+	"github.com/gnames/gnparser/ent/gender"
 	"github.com/gnames/gnparser/io/dict"
 	"github.com/stretchr/testify/assert"
 )
@@ -35,4 +37,34 @@ func TestLoadDictionary(t *testing.T) {
 		_, ok := d.AuthorICN["Abramov"]
 		assert.True(t, ok)
 	})
+	t.Run("finds gender of genus", func(t *testing.T) {
+		assert.Equal(t, gender.Feminine, d.GenusGender["Quercus"])
+		assert.Equal(t, gender.Masculine, d.GenusGender["Passer"])
+		assert.Equal(t, gender.Neuter, d.GenusGender["Acer"])
+	})
+	t.Run("does not find gender of unknown genus", func(t *testing.T) {
+		_, ok := d.GenusGender["Aus"]
+		assert.False(t, ok)
+	})
+}
+
+func TestReadGenusGenders(t *testing.T) {
+	t.Run("reads genders", func(t *testing.T) {
+		in := "# genera\nAus\tm\n\nBus  fem\nCus n\n"
+		res, err := dict.ReadGenusGenders(strings.NewReader(in))
+		assert.Nil(t, err)
+		assert.Equal(t, map[string]gender.Gender{
+			"Aus": gender.Masculine,
+			"Bus": gender.Feminine,
+			"Cus": gender.Neuter,
+		}, res)
+	})
+	t.Run("fails on unknown gender", func(t *testing.T) {
+		_, err := dict.ReadGenusGenders(strings.NewReader("Aus\tx\n"))
+		assert.ErrorContains(t, err, "line 1")
+	})
+	t.Run("fails on missing gender", func(t *testing.T) {
+		_, err := dict.ReadGenusGenders(strings.NewReader("Aus\n"))
+		assert.NotNil(t, err)
+	})
 }
@@ -0,0 +1,312 @@
+package grpcsrv
+
+import (
+	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/ent/parsed"
+	pb "github.com/gnames/gnparser/io/grpcsrv/gnparserpb"
+)
+
+// requestOptions converts protobuf options to the options model that is
+// shared by all GNparser services.
+func requestOptions(opts *pb.Options) gnparser.RequestOptions {
+	return gnparser.RequestOptions{
+		Code:              opts.GetCode(),
+		WithCultivars:     opts.GetWithCultivars(),
+		WithDetails:       opts.GetWithDetails(),
+		Capitalize:        opts.GetCapitalize(),
+		IgnoreHTMLTags:    opts.GetIgnoreHtmlTags(),
+		PreserveDiaereses: opts.GetPreserveDiaereses(),
+		CompactAuthors:    opts.GetCompactAuthors(),
+		SpeciesGroupCut:   opts.GetSpeciesGroupCut(),
+		Unordered:         opts.GetUnordered(),
+		QualityProfile:    opts.GetQualityProfile(),
+		Language:          opts.GetLang(),
+		WithSuggestions:   opts.GetWithSuggestions(),
+		GenderAgreement:   opts.GetGenderAgreement(),
+		WithHierarchy:     opts.GetWithHierarchy(),
+		WithCodeInference: opts.GetWithCodeInference(),
+		WithRankInference: opts.GetWithRankInference(),
+	}
+}
+
+// toPB converts a parsing result to its protobuf message.
+func toPB(p parsed.Parsed) *pb.Parsed {
+	res := pb.Parsed{
+		Parsed:                   p.Parsed,
+		NomenclaturalCodeSetting: p.NomCodeSetting,
+		Quality:                  int32(p.ParseQuality),
+		Verbatim:                 p.Verbatim,
+		Normalized:               p.Normalized,
+		Cardinality:              int32(p.Cardinality),
+		Rank:                     p.Rank,
+		RankInferred:             p.RankInferred,
+		Authorship:               authorshipPB(p.Authorship),
+		Candidatus:               p.Candidatus,
+		Virus:                    p.Virus,
+		Cultivar:                 p.Cultivar,
+		DaggerChar:               p.DaggerChar,
+		Tail:                     p.Tail,
+		Details:                  detailsPB(p.Details),
+		Id:                       p.VerbatimID,
+		ParserVersion:            p.ParserVersion,
+		QualityProfile:           p.QualityProfile,
+	}
+
+	for _, v := range p.QualityWarnings {
+		res.QualityWarnings = append(res.QualityWarnings, &pb.QualityWarning{
+			Quality:    int32(v.Quality),
+			Warning:    v.Warning.String(),
+			Code:       v.Code,
+			Message:    v.Message,
+			Correction: v.Correction,
+		})
+	}
+
+	res.Canonical = canonicalPB(p.Canonical)
+
+	for _, v := range p.Suggestions {
+		res.Suggestions = append(res.Suggestions, &pb.Suggestion{
+			Canonical:  v.Canonical,
+			Verbatim:   v.Verbatim,
+			Correction: v.Correction,
+			Rule:       v.Rule,
+			Reference:  v.Reference,
+			Confidence: v.Confidence,
+		})
+	}
+
+	for _, v := range p.Hierarchy {
+		res.Hierarchy = append(res.Hierarchy, &pb.HierarchyElem{
+			Rank:       v.Rank,
+			Canonical:  canonicalPB(&v.Canonical),
+			Authorship: authorshipPB(v.Authorship),
+			IsAutonym:  v.IsAutonym,
+		})
+	}
+
+	if ci := p.NomCodeInferred; ci != nil {
+		res.NomenclaturalCodeInferred = &pb.CodeInference{
+			Code:       ci.Code,
+			Confidence: ci.Confidence,
+		}
+		for _, v := range ci.Evidence {
+			res.NomenclaturalCodeInferred.Evidence = append(
+				res.NomenclaturalCodeInferred.Evidence,
+				&pb.CodeEvidence{
+					Code:   v.Code,
+					Clue:   v.Clue,
+					Value:  v.Value,
+					Weight: v.Weight,
+				},
+			)
+		}
+	}
+
+	if p.Bacteria != nil {
+		res.Bacteria = p.Bacteria.String()
+	}
+	if p.Hybrid != nil {
+		res.Hybrid = p.Hybrid.String()
+	}
+	if p.GraftChimera != nil {
+		res.GraftChimera = p.GraftChimera.String()
+	}
+	if p.Surrogate != nil {
+		res.Surrogate = p.Surrogate.String()
+	}
+
+	for _, v := range p.Words {
+		res.Words = append(res.Words, &pb.Word{
+			Verbatim:   v.Verbatim,
+			Normalized: v.Normalized,
+			WordType:   v.Type.String(),
+			Start:      int32(v.Start),
+			End:        int32(v.End),
+			Label:      v.Label,
+		})
+	}
+	return &res
+}
+
+func canonicalPB(c *parsed.Canonical) *pb.Canonical {
+	if c == nil {
+		return nil
+	}
+	return &pb.Canonical{
+		Stemmed: c.Stemmed,
+		Simple:  c.Simple,
+		Full:    c.Full,
+	}
+}
+
+func detailsPB(d parsed.Details) *pb.Details {
+	switch d := d.(type) {
+	case parsed.DetailsUninomial:
+		u := d.Uninomial
+		return &pb.Details{Details: &pb.Details_Uninomial{
+			Uninomial: &pb.Uninomial{
+				Value:      u.Value,
+				Rank:       u.Rank,
+				Cultivar:   u.Cultivar,
+				Parent:     u.Parent,
+				Authorship: authorshipPB(u.Authorship),
+				RankInfo:   rankInfoPB(u.RankInfo),
+			},
+		}}
+	case parsed.DetailsSpecies:
+		return &pb.Details{Details: &pb.Details_Species{
+			Species: speciesPB(&d.Species),
+		}}
+	case parsed.DetailsInfraspecies:
+		isp := pb.Infraspecies{Species: speciesPB(&d.Infraspecies.Species)}
+		for i := range d.Infraspecies.Infraspecies {
+			isp.Infraspecies = append(
+				isp.Infraspecies,
+				infraspeciesPB(&d.Infraspecies.Infraspecies[i]),
+			)
+		}
+		return &pb.Details{Details: &pb.Details_Infraspecies{
+			Infraspecies: &isp,
+		}}
+	case parsed.DetailsComparison:
+		c := d.Comparison
+		return &pb.Details{Details: &pb.Details_Comparison{
+			Comparison: &pb.Comparison{
+				Genus:            c.Genus,
+				Species:          speciesPB(c.Species),
+				Infraspecies:     infraspeciesPB(c.InfraSpecies),
+				ComparisonMarker: c.CompMarker,
+			},
+		}}
+	case parsed.DetailsApproximation:
+		a := d.Approximation
+		return &pb.Details{Details: &pb.Details_Approximation{
+			Approximation: &pb.Approximation{
+				Genus:               a.Genus,
+				Species:             a.Species,
+				Cultivar:            a.Cultivar,
+				Authorship:          authorshipPB(a.SpeciesAuthorship),
+				ApproximationMarker: a.ApproxMarker,
+				Ignored:             a.Ignored,
+			},
+		}}
+	case parsed.DetailsHybridFormula:
+		return &pb.Details{Details: &pb.Details_HybridFormula{
+			HybridFormula: formulaPB(d.HybridFormula),
+		}}
+	case parsed.DetailsGraftChimeraFormula:
+		return &pb.Details{Details: &pb.Details_GraftChimeraFormula{
+			GraftChimeraFormula: formulaPB(d.GraftChimeraFormula),
+		}}
+	case parsed.DetailsUninomialICVCN:
+		return &pb.Details{Details: &pb.Details_UninomialIcvcn{
+			UninomialIcvcn: &pb.UninomialICVCN{
+				Value:    d.UninomialICVCN.Value,
+				Rank:     d.UninomialICVCN.Rank,
+				RankInfo: rankInfoPB(d.UninomialICVCN.RankInfo),
+			},
+		}}
+	case parsed.DetailsSpeciesICVCN:
+		return &pb.Details{Details: &pb.Details_SpeciesIcvcn{
+			SpeciesIcvcn: &pb.SpeciesICVCN{
+				Genus:    d.SpeciesICVCN.Genus,
+				Species:  d.SpeciesICVCN.Species,
+				Rank:     d.SpeciesICVCN.Rank,
+				RankInfo: rankInfoPB(d.SpeciesICVCN.RankInfo),
+			},
+		}}
+	}
+	return nil
+}
+
+func formulaPB(ds []parsed.Details) *pb.DetailsFormula {
+	res := pb.DetailsFormula{Elements: make([]*pb.Details, len(ds))}
+	for i := range ds {
+		res.Elements[i] = detailsPB(ds[i])
+	}
+	return &res
+}
+
+func speciesPB(sp *parsed.Species) *pb.Species {
+	if sp == nil {
+		return nil
+	}
+	return &pb.Species{
+		Genus:      sp.Genus,
+		Subgenus:   sp.Subgenus,
+		Species:    sp.Species,
+		Cultivar:   sp.Cultivar,
+		Authorship: authorshipPB(sp.Authorship),
+	}
+}
+
+func infraspeciesPB(isp *parsed.InfraspeciesElem) *pb.InfraspeciesElem {
+	if isp == nil {
+		return nil
+	}
+	return &pb.InfraspeciesElem{
+		Value:      isp.Value,
+		Rank:       isp.Rank,
+		Authorship: authorshipPB(isp.Authorship),
+		RankInfo:   rankInfoPB(isp.RankInfo),
+	}
+}
+
+func rankInfoPB(ri *parsed.RankInfo) *pb.RankInfo {
+	if ri == nil {
+		return nil
+	}
+	return &pb.RankInfo{
+		Id:    ri.ID,
+		Level: int32(ri.Level),
+		Code:  ri.Code,
+		Uri:   ri.URI,
+	}
+}
+
+func authorshipPB(au *parsed.Authorship) *pb.Authorship {
+	if au == nil {
+		return nil
+	}
+	return &pb.Authorship{
+		Verbatim:    au.Verbatim,
+		Normalized:  au.Normalized,
+		Year:        au.Year,
+		Authors:     au.Authors,
+		Original:    authGroupPB(au.Original),
+		Combination: authGroupPB(au.Combination),
+	}
+}
+
+func authGroupPB(ag *parsed.AuthGroup) *pb.AuthGroup {
+	if ag == nil {
+		return nil
+	}
+	return &pb.AuthGroup{
+		Authors:      ag.Authors,
+		Year:         yearPB(ag.Year),
+		ExAuthors:    authorsPB(ag.ExAuthors),
+		InAuthors:    authorsPB(ag.InAuthors),
+		EmendAuthors: authorsPB(ag.EmendAuthors),
+	}
+}
+
+func authorsPB(au *parsed.Authors) *pb.Authors {
+	if au == nil {
+		return nil
+	}
+	return &pb.Authors{
+		Authors: au.Authors,
+		Year:    yearPB(au.Year),
+	}
+}
+
+func yearPB(yr *parsed.Year) *pb.Year {
+	if yr == nil {
+		return nil
+	}
+	return &pb.Year{
+		Value:         yr.Value,
+		IsApproximate: yr.IsApproximate,
+	}
+}
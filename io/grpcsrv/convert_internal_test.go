@@ -0,0 +1,51 @@
+package grpcsrv
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gnames/gnparser"
+	pb "github.com/gnames/gnparser/io/grpcsrv/gnparserpb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// outputOptions are fields of RequestOptions that change the format of
+// the output. gRPC always sends protobuf messages, so they have no
+// mapping.
+var outputOptions = map[string]bool{
+	"Format":        true,
+	"CSV":           true,
+	"FlattenOutput": true,
+}
+
+// TestRequestOptionsMapping fails when a new field of RequestOptions is
+// not added to the protobuf Options, or is not copied by requestOptions.
+func TestRequestOptionsMapping(t *testing.T) {
+	assert := assert.New(t)
+	opts := &pb.Options{}
+	m := opts.ProtoReflect()
+	fields := m.Descriptor().Fields()
+	for i := range fields.Len() {
+		fd := fields.Get(i)
+		switch fd.Kind() {
+		case protoreflect.BoolKind:
+			m.Set(fd, protoreflect.ValueOfBool(true))
+		case protoreflect.StringKind:
+			m.Set(fd, protoreflect.ValueOfString("x"))
+		default:
+			t.Fatalf("no test value for Options.%s", fd.Name())
+		}
+	}
+
+	ro := reflect.ValueOf(requestOptions(opts))
+	typ := reflect.TypeFor[gnparser.RequestOptions]()
+	for i := range typ.NumField() {
+		name := typ.Field(i).Name
+		if outputOptions[name] {
+			continue
+		}
+		assert.False(ro.Field(i).IsZero(),
+			"RequestOptions.%s has no gRPC mapping", name)
+	}
+}
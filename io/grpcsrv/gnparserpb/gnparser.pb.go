@@ -0,0 +1,2734 @@
+// Protocol buffers schema of the GNparser gRPC service.
+//
+// Messages mirror the JSON output of GNparser (see ent/parsed package).
+// Enumerated values (word types, warnings, annotations) are sent as the
+// same strings that are used in the JSON output.
+//
+// To regenerate Go code run `just protoc` from the root of the project.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: gnparser.proto
+
+package gnparserpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Options modify parsing. They mirror options of the REST API.
+type Options struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Nomenclatural code ('zoological', 'botanical', 'bacterial',
+	// 'cultivar', 'viral' or their abbreviations).
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// Deprecated by code and overriden by it.
+	WithCultivars bool `protobuf:"varint,2,opt,name=with_cultivars,json=withCultivars,proto3" json:"with_cultivars,omitempty"`
+	// Adds details and words to the output.
+	WithDetails bool `protobuf:"varint,3,opt,name=with_details,json=withDetails,proto3" json:"with_details,omitempty"`
+	// Capitalizes the first letter of a name-string.
+	Capitalize bool `protobuf:"varint,4,opt,name=capitalize,proto3" json:"capitalize,omitempty"`
+	// Skips removal of HTML tags and entities from name-strings.
+	IgnoreHtmlTags bool `protobuf:"varint,5,opt,name=ignore_html_tags,json=ignoreHtmlTags,proto3" json:"ignore_html_tags,omitempty"`
+	// Keeps diaereses in canonical forms.
+	PreserveDiaereses bool `protobuf:"varint,6,opt,name=preserve_diaereses,json=preserveDiaereses,proto3" json:"preserve_diaereses,omitempty"`
+	// Removes spaces between authors' initials.
+	CompactAuthors bool `protobuf:"varint,7,opt,name=compact_authors,json=compactAuthors,proto3" json:"compact_authors,omitempty"`
+	// Truncates stemmed autonyms and species group names to species.
+	SpeciesGroupCut bool `protobuf:"varint,8,opt,name=species_group_cut,json=speciesGroupCut,proto3" json:"species_group_cut,omitempty"`
+	// Allows results to come in a different order than input.
+	Unordered bool `protobuf:"varint,9,opt,name=unordered,proto3" json:"unordered,omitempty"`
+	// Name of a built-in profile that assigns qualities to warnings
+	// ('default' or a nomenclatural code).
+	QualityProfile string `protobuf:"bytes,10,opt,name=quality_profile,json=qualityProfile,proto3" json:"quality_profile,omitempty"`
+	// Language of warning messages and labels of word types ('en', 'es',
+	// 'pt', 'fr', 'de' or a tag like 'pt-BR').
+	Lang string `protobuf:"bytes,11,opt,name=lang,proto3" json:"lang,omitempty"`
+	// Adds corrected canonical forms according to orthographic rules of
+	// nomenclatural codes.
+	WithSuggestions bool `protobuf:"varint,12,opt,name=with_suggestions,json=withSuggestions,proto3" json:"with_suggestions,omitempty"`
+	// Adds warnings for epithets that do not agree in gender with their
+	// genus.
+	GenderAgreement bool `protobuf:"varint,13,opt,name=gender_agreement,json=genderAgreement,proto3" json:"gender_agreement,omitempty"`
+	// Adds names implied by a name (genus, species, autonyms).
+	WithHierarchy bool `protobuf:"varint,14,opt,name=with_hierarchy,json=withHierarchy,proto3" json:"with_hierarchy,omitempty"`
+	// Adds a nomenclatural code the name probably belongs to, with the
+	// evidence for it.
+	WithCodeInference bool `protobuf:"varint,15,opt,name=with_code_inference,json=withCodeInference,proto3" json:"with_code_inference,omitempty"`
+	// Adds ranks to uninomials with standard endings of ranks.
+	WithRankInference bool `protobuf:"varint,16,opt,name=with_rank_inference,json=withRankInference,proto3" json:"with_rank_inference,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Options) Reset() {
+	*x = Options{}
+	mi := &file_gnparser_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Options) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Options) ProtoMessage() {}
+
+func (x *Options) ProtoReflect() protoreflect.Message {
+	mi := &file_gnparser_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Options.ProtoReflect.Descriptor instead.
+func (*Options) Descriptor() ([]byte, []int) {
+	return file_gnparser_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Options) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Options) GetWithCultivars() bool {
+	if x != nil {
+		return x.WithCultivars
+	}
+	return false
+}
+
+func (x *Options) GetWithDetails() bool {
+	if x != nil {
+		return x.WithDetails
+	}
+	return false
+}
+
+func (x *Options) GetCapitalize() bool {
+	if x != nil {
+		return x.Capitalize
+	}
+	return false
+}
+
+func (x *Options) GetIgnoreHtmlTags() bool {
+	if x != nil {
+		return x.IgnoreHtmlTags
+	}
+	return false
+}
+
+func (x *Options) GetPreserveDiaereses() bool {
+	if x != nil {
+		return x.PreserveDiaereses
+	}
+	return false
+}
+
+func (x *Options) GetCompactAuthors() bool {
+	if x != nil {
+		return x.CompactAuthors
+	}
+	return false
+}
+
+func (x *Options) GetSpeciesGroupCut() bool {
+	if x != nil {
+		return x.SpeciesGroupCut
+	}
+	return false
+}
+
+func (x *Options) GetUnordered() bool {
+	if x != nil {
+		return x.Unordered
+	}
+	return false
+}
+
+func (x *Options) GetQualityProfile() string {
+	if x != nil {
+		return x.QualityProfile
+	}
+	return ""
+}
+
+func (x *Options) GetLang() string {
+	if x != nil {
+		return x.Lang
+	}
+	return ""
+}
+
+func (x *Options) GetWithSuggestions() bool {
+	if x != nil {
+		return x.WithSuggestions
+	}
+	return false
+}
+
+func (x *Options) GetGenderAgreement() bool {
+	if x != nil {
+		return x.GenderAgreement
+	}
+	return false
+}
+
+func (x *Options) GetWithHierarchy() bool {
+	if x != nil {
+		return x.WithHierarchy
+	}
+	return false
+}
+
+func (x *Options) GetWithCodeInference() bool {
+	if x != nil {
+		return x.WithCodeInference
+	}
+	return false
+}
+
+func (x *Options) GetWithRankInference() bool {
+	if x != nil {
+		return x.WithRankInference
+	}
+	return false
+}
+
+type VersionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VersionRequest) Reset() {
+	*x = VersionRequest{}
+	mi := &file_gnparser_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VersionRequest) ProtoMessage() {}
+
+func (x *VersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gnparser_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VersionRequest.ProtoReflect.Descriptor instead.
+func (*VersionRequest) Descriptor() ([]byte, []int) {
+	return file_gnparser_proto_rawDescGZIP(), []int{1}
+}
+
+type VersionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       string                 `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	Build         string                 `protobuf:"bytes,2,opt,name=build,proto3" json:"build,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VersionResponse) Reset() {
+	*x = VersionResponse{}
+	mi := &file_gnparser_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VersionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VersionResponse) ProtoMessage() {}
+
+func (x *VersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gnparser_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VersionResponse.ProtoReflect.Descriptor instead.
+func (*VersionResponse) Descriptor() ([]byte, []int) {
+	return file_gnparser_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *VersionResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *VersionResponse) GetBuild() string {
+	if x != nil {
+		return x.Build
+	}
+	return ""
+}
+
+type ParseNameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Options       *Options               `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParseNameRequest) Reset() {
+	*x = ParseNameRequest{}
+	mi := &file_gnparser_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParseNameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParseNameRequest) ProtoMessage() {}
+
+func (x *ParseNameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gnparser_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParseNameRequest.ProtoReflect.Descriptor instead.
+func (*ParseNameRequest) Descriptor() ([]byte, []int) {
+	return file_gnparser_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ParseNameRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ParseNameRequest) GetOptions() *Options {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type ParseNamesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Names         []string               `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+	Options       *Options               `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParseNamesRequest) Reset() {
+	*x = ParseNamesRequest{}
+	mi := &file_gnparser_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParseNamesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParseNamesRequest) ProtoMessage() {}
+
+func (x *ParseNamesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gnparser_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParseNamesRequest.ProtoReflect.Descriptor instead.
+func (*ParseNamesRequest) Descriptor() ([]byte, []int) {
+	return file_gnparser_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ParseNamesRequest) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *ParseNamesRequest) GetOptions() *Options {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type ParseNamesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*Parsed              `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParseNamesResponse) Reset() {
+	*x = ParseNamesResponse{}
+	mi := &file_gnparser_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParseNamesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParseNamesResponse) ProtoMessage() {}
+
+func (x *ParseNamesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gnparser_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParseNamesResponse.ProtoReflect.Descriptor instead.
+func (*ParseNamesResponse) Descriptor() ([]byte, []int) {
+	return file_gnparser_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ParseNamesResponse) GetResults() []*Parsed {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// Parsed is the result of a scientific name-string parsing.
+type Parsed struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	Parsed                   bool                   `protobuf:"varint,1,opt,name=parsed,proto3" json:"parsed,omitempty"`
+	NomenclaturalCodeSetting string                 `protobuf:"bytes,2,opt,name=nomenclatural_code_setting,json=nomenclaturalCodeSetting,proto3" json:"nomenclatural_code_setting,omitempty"`
+	Quality                  int32                  `protobuf:"varint,3,opt,name=quality,proto3" json:"quality,omitempty"`
+	QualityWarnings          []*QualityWarning      `protobuf:"bytes,4,rep,name=quality_warnings,json=qualityWarnings,proto3" json:"quality_warnings,omitempty"`
+	Verbatim                 string                 `protobuf:"bytes,5,opt,name=verbatim,proto3" json:"verbatim,omitempty"`
+	Normalized               string                 `protobuf:"bytes,6,opt,name=normalized,proto3" json:"normalized,omitempty"`
+	Canonical                *Canonical             `protobuf:"bytes,7,opt,name=canonical,proto3" json:"canonical,omitempty"`
+	Cardinality              int32                  `protobuf:"varint,8,opt,name=cardinality,proto3" json:"cardinality,omitempty"`
+	Rank                     string                 `protobuf:"bytes,9,opt,name=rank,proto3" json:"rank,omitempty"`
+	Authorship               *Authorship            `protobuf:"bytes,10,opt,name=authorship,proto3" json:"authorship,omitempty"`
+	// 'yes' or 'maybe', empty if the name is not bacterial.
+	Bacteria      string   `protobuf:"bytes,11,opt,name=bacteria,proto3" json:"bacteria,omitempty"`
+	Candidatus    bool     `protobuf:"varint,12,opt,name=candidatus,proto3" json:"candidatus,omitempty"`
+	Virus         bool     `protobuf:"varint,13,opt,name=virus,proto3" json:"virus,omitempty"`
+	Cultivar      bool     `protobuf:"varint,14,opt,name=cultivar,proto3" json:"cultivar,omitempty"`
+	DaggerChar    bool     `protobuf:"varint,15,opt,name=dagger_char,json=daggerChar,proto3" json:"dagger_char,omitempty"`
+	Hybrid        string   `protobuf:"bytes,16,opt,name=hybrid,proto3" json:"hybrid,omitempty"`
+	GraftChimera  string   `protobuf:"bytes,17,opt,name=graft_chimera,json=graftChimera,proto3" json:"graft_chimera,omitempty"`
+	Surrogate     string   `protobuf:"bytes,18,opt,name=surrogate,proto3" json:"surrogate,omitempty"`
+	Tail          string   `protobuf:"bytes,19,opt,name=tail,proto3" json:"tail,omitempty"`
+	Details       *Details `protobuf:"bytes,20,opt,name=details,proto3" json:"details,omitempty"`
+	Words         []*Word  `protobuf:"bytes,21,rep,name=words,proto3" json:"words,omitempty"`
+	Id            string   `protobuf:"bytes,22,opt,name=id,proto3" json:"id,omitempty"`
+	ParserVersion string   `protobuf:"bytes,23,opt,name=parser_version,json=parserVersion,proto3" json:"parser_version,omitempty"`
+	// Name of the profile that assigned qualities to warnings, empty for
+	// the default profile.
+	QualityProfile            string           `protobuf:"bytes,24,opt,name=quality_profile,json=qualityProfile,proto3" json:"quality_profile,omitempty"`
+	Suggestions               []*Suggestion    `protobuf:"bytes,25,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+	Hierarchy                 []*HierarchyElem `protobuf:"bytes,26,rep,name=hierarchy,proto3" json:"hierarchy,omitempty"`
+	NomenclaturalCodeInferred *CodeInference   `protobuf:"bytes,27,opt,name=nomenclatural_code_inferred,json=nomenclaturalCodeInferred,proto3" json:"nomenclatural_code_inferred,omitempty"`
+	// True if the rank is inferred from the ending of a uninomial.
+	RankInferred  bool `protobuf:"varint,28,opt,name=rank_inferred,json=rankInferred,proto3" json:"rank_inferred,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Parsed) Reset() {
+	*x = Parsed{}
+	mi := &file_gnparser_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Parsed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Parsed) ProtoMessage() {}
+
+func (x *Parsed) ProtoReflect() protoreflect.Message {
+	mi := &file_gnparser_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Parsed.ProtoReflect.Descriptor instead.
+func (*Parsed) Descriptor() ([]byte, []int) {
+	return file_gnparser_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Parsed) GetParsed() bool {
+	if x != nil {
+		return x.Parsed
+	}
+	return false
+}
+
+func (x *Parsed) GetNomenclaturalCodeSetting() string {
+	if x != nil {
+		return x.NomenclaturalCodeSetting
+	}
+	return ""
+}
+
+func (x *Parsed) GetQuality() int32 {
+	if x != nil {
+		return x.Quality
+	}
+	return 0
+}
+
+func (x *Parsed) GetQualityWarnings() []*QualityWarning {
+	if x != nil {
+		return x.QualityWarnings
+	}
+	return nil
+}
+
+func (x *Parsed) GetVerbatim() string {
+	if x != nil {
+		return x.Verbatim
+	}
+	return ""
+}
+
+func (x *Parsed) GetNormalized() string {
+	if x != nil {
+		return x.Normalized
+	}
+	return ""
+}
+
+func (x *Parsed) GetCanonical() *Canonical {
+	if x != nil {
+		return x.Canonical
+	}
+	return nil
+}
+
+func (x *Parsed) GetCardinality() int32 {
+	if x != nil {
+		return x.Cardinality
+	}
+	return 0
+}
+
+func (x *Parsed) GetRank() string {
+	if x != nil {
+		return x.Rank
+	}
+	return ""
+}
+
+func (x *Parsed) GetAuthorship() *Authorship {
+	if x != nil {
+		return x.Authorship
+	}
+	return nil
+}
+
+func (x *Parsed) GetBacteria() string {
+	if x != nil {
+		return x.Bacteria
+	}
+	return ""
+}
+
+func (x *Parsed) GetCandidatus() bool {
+	if x != nil {
+		return x.Candidatus
+	}
+	return false
+}
+
+func (x *Parsed) GetVirus() bool {
+	if x != nil {
+		return x.Virus
+	}
+	return false
+}
+
+func (x *Parsed) GetCultivar() bool {
+	if x != nil {
+		return x.Cultivar
+	}
+	return false
+}
+
+func (x *Parsed) GetDaggerChar() bool {
+	if x != nil {
+		return x.DaggerChar
+	}
+	return false
+}
+
+func (x *Parsed) GetHybrid() string {
+	if x != nil {
+		return x.Hybrid
+	}
+	return ""
+}
+
+func (x *Parsed) GetGraftChimera() string {
+	if x != nil {
+		return x.GraftChimera
+	}
+	return ""
+}
+
+func (x *Parsed) GetSurrogate() string {
+	if x != nil {
+		return x.Surrogate
+	}
+	return ""
+}
+
+func (x *Parsed) GetTail() string {
+	if x != nil {
+		return x.Tail
+	}
+	return ""
+}
+
+func (x *Parsed) GetDetails() *Details {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+func (x *Parsed) GetWords() []*Word {
+	if x != nil {
+		return x.Words
+	}
+	return nil
+}
+
+func (x *Parsed) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Parsed) GetParserVersion() string {
+	if x != nil {
+		return x.ParserVersion
+	}
+	return ""
+}
+
+func (x *Parsed) GetQualityProfile() string {
+	if x != nil {
+		return x.QualityProfile
+	}
+	return ""
+}
+
+func (x *Parsed) GetSuggestions() []*Suggestion {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+func (x *Parsed) GetHierarchy() []*HierarchyElem {
+	if x != nil {
+		return x.Hierarchy
+	}
+	return nil
+}
+
+func (x *Parsed) GetNomenclaturalCodeInferred() *CodeInference {
+	if x != nil {
+		return x.NomenclaturalCodeInferred
+	}
+	return nil
+}
+
+func (x *Parsed) GetRankInferred() bool {
+	if x != nil {
+		return x.RankInferred
+	}
+	return false
+}
+
+type QualityWarning struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Quality int32                  `protobuf:"varint,1,opt,name=quality,proto3" json:"quality,omitempty"`
+	Warning string                 `protobuf:"bytes,2,opt,name=warning,proto3" json:"warning,omitempty"`
+	// Stable identifier of the warning, for example 'TAIL_UNPARSED'.
+	Code string `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	// Message of the warning in the selected language, empty for English.
+	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	// Corrected word for warnings that can be fixed automatically.
+	Correction    string `protobuf:"bytes,5,opt,name=correction,proto3" json:"correction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QualityWarning) Reset() {
+	*x = QualityWarning{}
+	mi := &file_gnparser_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QualityWarning) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QualityWarning) ProtoMessage() {}
+
+func (x *QualityWarning) ProtoReflect() protoreflect.Message {
+	mi := &file_gnparser_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QualityWarning.ProtoReflect.Descriptor instead.
+func (*QualityWarning) Descriptor() ([]byte, []int) {
+	return file_gnparser_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *QualityWarning) GetQuality() int32 {
+	if x != nil {
+		return x.Quality
+	}
+	return 0
+}
+
+func (x *QualityWarning) GetWarning() string {
+	if x != nil {
+		return x.Warning
+	}
+	return ""
+}
+
+func (x *QualityWarning) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *QualityWarning) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *QualityWarning) GetCorrection() string {
+	if x != nil {
+		return x.Correction
+	}
+	return ""
+}
+
+// Suggestion is a corrected form of a name according to an orthographic
+// rule of a nomenclatural code.
+type Suggestion struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Canonical  string                 `protobuf:"bytes,1,opt,name=canonical,proto3" json:"canonical,omitempty"`
+	Verbatim   string                 `protobuf:"bytes,2,opt,name=verbatim,proto3" json:"verbatim,omitempty"`
+	Correction string                 `protobuf:"bytes,3,opt,name=correction,proto3" json:"correction,omitempty"`
+	// Stable identifier of the rule, for example 'CONNECTING_VOWEL'.
+	Rule string `protobuf:"bytes,4,opt,name=rule,proto3" json:"rule,omitempty"`
+	// Article of a nomenclatural code, for example 'ICN Art. 60.10'.
+	Reference     string  `protobuf:"bytes,5,opt,name=reference,proto3" json:"reference,omitempty"`
+	Confidence    float64 `protobuf:"fixed64,6,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Suggestion) Reset() {
+	*x = Suggestion{}
+	mi := &file_gnparser_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Suggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Suggestion) ProtoMessage() {}
+
+func (x *Suggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_gnparser_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Suggestion.ProtoReflect.Descriptor instead.
+func (*Suggestion) Descriptor() ([]byte, []int) {
+	return file_gnparser_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Suggestion) GetCanonical() string {
+	if x != nil {
+		return x.Canonical
+	}
+	return ""
+}
+
+func (x *Suggestion) GetVerbatim() string {
+	if x != nil {
+		return x.Verbatim
+	}
+	return ""
+}
+
+func (x *Suggestion) GetCorrection() string {
+	if x != nil {
+		return x.Correction
+	}
+	return ""
+}
+
+func (x *Suggestion) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *Suggestion) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *Suggestion) GetConfidence() float64 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+// HierarchyElem is a name implied by a parsed name.
+type HierarchyElem struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Rank       string                 `protobuf:"bytes,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	Canonical  *Canonical             `protobuf:"bytes,2,opt,name=canonical,proto3" json:"canonical,omitempty"`
+	Authorship *Authorship            `protobuf:"bytes,3,opt,name=authorship,proto3" json:"authorship,omitempty"`
+	// True for ICN autonyms and ICZN nominotypical subspecies.
+	IsAutonym     bool `protobuf:"varint,4,opt,name=is_autonym,json=isAutonym,proto3" json:"is_autonym,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HierarchyElem) Reset() {
+	*x = HierarchyElem{}
+	mi := &file_gnparser_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HierarchyElem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HierarchyElem) ProtoMessage() {}
+
+func (x *HierarchyElem) ProtoReflect() protoreflect.Message {
+	mi := &file_gnparser_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HierarchyElem.ProtoReflect.Descriptor instead.
+func (*HierarchyElem) Descriptor() ([]byte, []int) {
+	return file_gnparser_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *HierarchyElem) GetRank() string {
+	if x != nil {
+		return x.Rank
+	}
+	return ""
+}
+
+func (x *HierarchyElem) GetCanonical() *Canonical {
+	if x != nil {
+		return x.Canonical
+	}
+	return nil
+}
+
+func (x *HierarchyElem) GetAuthorship() *Authorship {
+	if x != nil {
+		return x.Authorship
+	}
+	return nil
+}
+
+func (x *HierarchyElem) GetIsAutonym() bool {
+	if x != nil {
+		return x.IsAutonym
+	}
+	return false
+}
+
+// CodeInference is a nomenclatural code a name probably belongs to.
+type CodeInference struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 'ICZN', 'ICN', 'ICNP', 'ICVCN' or 'ICNCP'.
+	Code          string          `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Confidence    float64         `protobuf:"fixed64,2,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	Evidence      []*CodeEvidence `protobuf:"bytes,3,rep,name=evidence,proto3" json:"evidence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CodeInference) Reset() {
+	*x = CodeInference{}
+	mi := &file_gnparser_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CodeInference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CodeInference) ProtoMessage() {}
+
+func (x *CodeInference) ProtoReflect() protoreflect.Message {
+	mi := &file_gnparser_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CodeInference.ProtoReflect.Descriptor instead.
+func (*CodeInference) Descriptor() ([]byte, []int) {
+	return file_gnparser_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CodeInference) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CodeInference) GetConfidence() float64 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+func (x *CodeInference) GetEvidence() []*CodeEvidence {
+	if x != nil {
+		return x.Evidence
+	}
+	return nil
+}
+
+// CodeEvidence is a feature of a name-string that points to a code.
+type CodeEvidence struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Code  string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// Identifier of the feature, for example 'YEAR_COMMA'.
+	Clue          string  `protobuf:"bytes,2,opt,name=clue,proto3" json:"clue,omitempty"`
+	Value         string  `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Weight        float64 `protobuf:"fixed64,4,opt,name=weight,proto3" json:"weight,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CodeEvidence) Reset() {
+	*x = CodeEvidence{}
+	mi := &file_gnparser_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CodeEvidence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CodeEvidence) ProtoMessage() {}
+
+func (x *CodeEvidence) ProtoReflect() protoreflect.Message {
+	mi := &file_gnparser_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CodeEvidence.ProtoReflect.Descriptor instead.
+func (*CodeEvidence) Descriptor() ([]byte, []int) {
+	return file_gnparser_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CodeEvidence) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CodeEvidence) GetClue() string {
+	if x != nil {
+		return x.Clue
+	}
+	return ""
+}
+
+func (x *CodeEvidence) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *CodeEvidence) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+type Canonical struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stemmed       string                 `protobuf:"bytes,1,opt,name=stemmed,proto3" json:"stemmed,omitempty"`
+	Simple        string                 `protobuf:"bytes,2,opt,name=simple,proto3" json:"simple,omitempty"`
+	Full          string                 `protobuf:"bytes,3,opt,name=full,proto3" json:"full,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Canonical) Reset() {
+	*x = Canonical{}
+	mi := &file_gnparser_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Canonical) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Canonical) ProtoMessage() {}
+
+func (x *Canonical) ProtoReflect() protoreflect.Message {
+	mi := &file_gnparser_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Canonical.ProtoReflect.Descriptor instead.
+func (*Canonical) Descriptor() ([]byte, []int) {
+	return file_gnparser_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Canonical) GetStemmed() string {
+	if x != nil {
+		return x.Stemmed
+	}
+	return ""
+}
+
+func (x *Canonical) GetSimple() string {
+	if x != nil {
+		return x.Simple
+	}
+	return ""
+}
+
+func (x *Canonical) GetFull() string {
+	if x != nil {
+		return x.Full
+	}
+	return ""
+}
+
+type Authorship struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Verbatim      string                 `protobuf:"bytes,1,opt,name=verbatim,proto3" json:"verbatim,omitempty"`
+	Normalized    string                 `protobuf:"bytes,2,opt,name=normalized,proto3" json:"normalized,omitempty"`
+	Year          string                 `protobuf:"bytes,3,opt,name=year,proto3" json:"year,omitempty"`
+	Authors       []string               `protobuf:"bytes,4,rep,name=authors,proto3" json:"authors,omitempty"`
+	Original      *AuthGroup             `protobuf:"bytes,5,opt,name=original,proto3" json:"original,omitempty"`
+	Combination   *AuthGroup             `protobuf:"bytes,6,opt,name=combination,proto3" json:"combination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Authorship) Reset() {
+	*x = Authorship{}
+	mi := &file_gnparser_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Authorship) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Authorship) ProtoMessage() {}
+
+func (x *Authorship) ProtoReflect() protoreflect.Message {
+	mi := &file_gnparser_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Authorship.ProtoReflect.Descriptor instead.
+func (*Authorship) Descriptor() ([]byte, []int) {
+	return file_gnparser_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Authorship) GetVerbatim() string {
+	if x != nil {
+		return x.Verbatim
+	}
+	return ""
+}
+
+func (x *Authorship) GetNormalized() string {
+	if x != nil {
+		return x.Normalized
+	}
+	return ""
+}
+
+func (x *Authorship) GetYear() string {
+	if x != nil {
+		return x.Year
+	}
+	return ""
+}
+
+func (x *Authorship) GetAuthors() []string {
+	if x != nil {
+		return x.Authors
+	}
+	return nil
+}
+
+func (x *Authorship) GetOriginal() *AuthGroup {
+	if x != nil {
+		return x.Original
+	}
+	return nil
+}
+
+func (x *Authorship) GetCombination() *AuthGroup {
+	if x != nil {
+		return x.Combination
+	}
+	return nil
+}
+
+type AuthGroup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Authors       []string               `protobuf:"bytes,1,rep,name=authors,proto3" json:"authors,omitempty"`
+	Year          *Year                  `protobuf:"bytes,2,opt,name=year,proto3" json:"year,omitempty"`
+	ExAuthors     *Authors               `protobuf:"bytes,3,opt,name=ex_authors,json=exAuthors,proto3" json:"ex_authors,omitempty"`
+	InAuthors     *Authors               `protobuf:"bytes,4,opt,name=in_authors,json=inAuthors,proto3" json:"in_authors,omitempty"`
+	EmendAuthors  *Authors               `protobuf:"bytes,5,opt,name=emend_authors,json=emendAuthors,proto3" json:"emend_authors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthGroup) Reset() {
+	*x = AuthGroup{}
+	mi := &file_gnparser_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthGroup) ProtoMessage() {}
+
+func (x *AuthGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_gnparser_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthGroup.ProtoReflect.Descriptor instead.
+func (*AuthGroup) Descriptor() ([]byte, []int) {
+	return file_gnparser_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *AuthGroup) GetAuthors() []string {
+	if x != nil {
+		return x.Authors
+	}
+	return nil
+}
+
+func (x *AuthGroup) GetYear() *Year {
+	if x != nil {
+		return x.Year
+	}
+	return nil
+}
+
+func (x *AuthGroup) GetExAuthors() *Authors {
+	if x != nil {
+		return x.ExAuthors
+	}
+	return nil
+}
+
+func (x *AuthGroup) GetInAuthors() *Authors {
+	if x != nil {
+		return x.InAuthors
+	}
+	return nil
+}
+
+func (x *AuthGroup) GetEmendAuthors() *Authors {
+	if x != nil {
+		return x.EmendAuthors
+	}
+	return nil
+}
+
+type Authors struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Authors       []string               `protobuf:"bytes,1,rep,name=authors,proto3" json:"authors,omitempty"`
+	Year          *Year                  `protobuf:"bytes,2,opt,name=year,proto3" json:"year,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Authors) Reset() {
+	*x = Authors{}
+	mi := &file_gnparser_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Authors) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Authors) ProtoMessage() {}
+
+func (x *Authors) ProtoReflect() protoreflect.Message {
+	mi := &file_gnparser_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Authors.ProtoReflect.Descriptor instead.
+func (*Authors) Descriptor() ([]byte, []int) {
+	return file_gnparser_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Authors) GetAuthors() []string {
+	if x != nil {
+		return x.Authors
+	}
+	return nil
+}
+
+func (x *Authors) GetYear() *Year {
+	if x != nil {
+		return x.Year
+	}
+	return nil
+}
+
+type Year struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	IsApproximate bool                   `protobuf:"varint,2,opt,name=is_approximate,json=isApproximate,proto3" json:"is_approximate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Year) Reset() {
+	*x = Year{}
+	mi := &file_gnparser_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Year) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Year) ProtoMessage() {}
+
+func (x *Year) ProtoReflect() protoreflect.Message {
+	mi := &file_gnparser_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Year.ProtoReflect.Descriptor instead.
+func (*Year) Descriptor() ([]byte, []int) {
+	return file_gnparser_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Year) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *Year) GetIsApproximate() bool {
+	if x != nil {
+		return x.IsApproximate
+	}
+	return false
+}
+
+type Word struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Verbatim   string                 `protobuf:"bytes,1,opt,name=verbatim,proto3" json:"verbatim,omitempty"`
+	Normalized string                 `protobuf:"bytes,2,opt,name=normalized,proto3" json:"normalized,omitempty"`
+	WordType   string                 `protobuf:"bytes,3,opt,name=word_type,json=wordType,proto3" json:"word_type,omitempty"`
+	Start      int32                  `protobuf:"varint,4,opt,name=start,proto3" json:"start,omitempty"`
+	End        int32                  `protobuf:"varint,5,opt,name=end,proto3" json:"end,omitempty"`
+	// Name of the word type in the selected language, empty for English.
+	Label         string `protobuf:"bytes,6,opt,name=label,proto3" json:"label,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Word) Reset() {
+	*x = Word{}
+	mi := &file_gnparser_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Word) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Word) ProtoMessage() {}
+
+func (x *Word) ProtoReflect() protoreflect.Message {
+	mi := &file_gnparser_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Word.ProtoReflect.Descriptor instead.
+func (*Word) Descriptor() ([]byte, []int) {
+	return file_gnparser_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Word) GetVerbatim() string {
+	if x != nil {
+		return x.Verbatim
+	}
+	return ""
+}
+
+func (x *Word) GetNormalized() string {
+	if x != nil {
+		return x.Normalized
+	}
+	return ""
+}
+
+func (x *Word) GetWordType() string {
+	if x != nil {
+		return x.WordType
+	}
+	return ""
+}
+
+func (x *Word) GetStart() int32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *Word) GetEnd() int32 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *Word) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+// Details contain more fine-grained information about parsed name.
+type Details struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Details:
+	//
+	//	*Details_Uninomial
+	//	*Details_Species
+	//	*Details_Infraspecies
+	//	*Details_Comparison
+	//	*Details_Approximation
+	//	*Details_HybridFormula
+	//	*Details_GraftChimeraFormula
+	//	*Details_UninomialIcvcn
+	//	*Details_SpeciesIcvcn
+	Details       isDetails_Details `protobuf_oneof:"details"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Details) Reset() {
+	*x = Details{}
+	mi := &file_gnparser_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Details) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Details) ProtoMessage() {}
+
+func (x *Details) ProtoReflect() protoreflect.Message {
+	mi := &file_gnparser_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Details.ProtoReflect.Descriptor instead.
+func (*Details) Descriptor() ([]byte, []int) {
+	return file_gnparser_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Details) GetDetails() isDetails_Details {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+func (x *Details) GetUninomial() *Uninomial {
+	if x != nil {
+		if x, ok := x.Details.(*Details_Uninomial); ok {
+			return x.Uninomial
+		}
+	}
+	return nil
+}
+
+func (x *Details) GetSpecies() *Species {
+	if x != nil {
+		if x, ok := x.Details.(*Details_Species); ok {
+			return x.Species
+		}
+	}
+	return nil
+}
+
+func (x *Details) GetInfraspecies() *Infraspecies {
+	if x != nil {
+		if x, ok := x.Details.(*Details_Infraspecies); ok {
+			return x.Infraspecies
+		}
+	}
+	return nil
+}
+
+func (x *Details) GetComparison() *Comparison {
+	if x != nil {
+		if x, ok := x.Details.(*Details_Comparison); ok {
+			return x.Comparison
+		}
+	}
+	return nil
+}
+
+func (x *Details) GetApproximation() *Approximation {
+	if x != nil {
+		if x, ok := x.Details.(*Details_Approximation); ok {
+			return x.Approximation
+		}
+	}
+	return nil
+}
+
+func (x *Details) GetHybridFormula() *DetailsFormula {
+	if x != nil {
+		if x, ok := x.Details.(*Details_HybridFormula); ok {
+			return x.HybridFormula
+		}
+	}
+	return nil
+}
+
+func (x *Details) GetGraftChimeraFormula() *DetailsFormula {
+	if x != nil {
+		if x, ok := x.Details.(*Details_GraftChimeraFormula); ok {
+			return x.GraftChimeraFormula
+		}
+	}
+	return nil
+}
+
+func (x *Details) GetUninomialIcvcn() *UninomialICVCN {
+	if x != nil {
+		if x, ok := x.Details.(*Details_UninomialIcvcn); ok {
+			return x.UninomialIcvcn
+		}
+	}
+	return nil
+}
+
+func (x *Details) GetSpeciesIcvcn() *SpeciesICVCN {
+	if x != nil {
+		if x, ok := x.Details.(*Details_SpeciesIcvcn); ok {
+			return x.SpeciesIcvcn
+		}
+	}
+	return nil
+}
+
+type isDetails_Details interface {
+	isDetails_Details()
+}
+
+type Details_Uninomial struct {
+	Uninomial *Uninomial `protobuf:"bytes,1,opt,name=uninomial,proto3,oneof"`
+}
+
+type Details_Species struct {
+	Species *Species `protobuf:"bytes,2,opt,name=species,proto3,oneof"`
+}
+
+type Details_Infraspecies struct {
+	Infraspecies *Infraspecies `protobuf:"bytes,3,opt,name=infraspecies,proto3,oneof"`
+}
+
+type Details_Comparison struct {
+	Comparison *Comparison `protobuf:"bytes,4,opt,name=comparison,proto3,oneof"`
+}
+
+type Details_Approximation struct {
+	Approximation *Approximation `protobuf:"bytes,5,opt,name=approximation,proto3,oneof"`
+}
+
+type Details_HybridFormula struct {
+	HybridFormula *DetailsFormula `protobuf:"bytes,6,opt,name=hybrid_formula,json=hybridFormula,proto3,oneof"`
+}
+
+type Details_GraftChimeraFormula struct {
+	GraftChimeraFormula *DetailsFormula `protobuf:"bytes,7,opt,name=graft_chimera_formula,json=graftChimeraFormula,proto3,oneof"`
+}
+
+type Details_UninomialIcvcn struct {
+	UninomialIcvcn *UninomialICVCN `protobuf:"bytes,8,opt,name=uninomial_icvcn,json=uninomialIcvcn,proto3,oneof"`
+}
+
+type Details_SpeciesIcvcn struct {
+	SpeciesIcvcn *SpeciesICVCN `protobuf:"bytes,9,opt,name=species_icvcn,json=speciesIcvcn,proto3,oneof"`
+}
+
+func (*Details_Uninomial) isDetails_Details() {}
+
+func (*Details_Species) isDetails_Details() {}
+
+func (*Details_Infraspecies) isDetails_Details() {}
+
+func (*Details_Comparison) isDetails_Details() {}
+
+func (*Details_Approximation) isDetails_Details() {}
+
+func (*Details_HybridFormula) isDetails_Details() {}
+
+func (*Details_GraftChimeraFormula) isDetails_Details() {}
+
+func (*Details_UninomialIcvcn) isDetails_Details() {}
+
+func (*Details_SpeciesIcvcn) isDetails_Details() {}
+
+type DetailsFormula struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Elements      []*Details             `protobuf:"bytes,1,rep,name=elements,proto3" json:"elements,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetailsFormula) Reset() {
+	*x = DetailsFormula{}
+	mi := &file_gnparser_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetailsFormula) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetailsFormula) ProtoMessage() {}
+
+func (x *DetailsFormula) ProtoReflect() protoreflect.Message {
+	mi := &file_gnparser_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetailsFormula.ProtoReflect.Descriptor instead.
+func (*DetailsFormula) Descriptor() ([]byte, []int) {
+	return file_gnparser_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DetailsFormula) GetElements() []*Details {
+	if x != nil {
+		return x.Elements
+	}
+	return nil
+}
+
+type Uninomial struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Rank          string                 `protobuf:"bytes,2,opt,name=rank,proto3" json:"rank,omitempty"`
+	Cultivar      string                 `protobuf:"bytes,3,opt,name=cultivar,proto3" json:"cultivar,omitempty"`
+	Parent        string                 `protobuf:"bytes,4,opt,name=parent,proto3" json:"parent,omitempty"`
+	Authorship    *Authorship            `protobuf:"bytes,5,opt,name=authorship,proto3" json:"authorship,omitempty"`
+	RankInfo      *RankInfo              `protobuf:"bytes,6,opt,name=rank_info,json=rankInfo,proto3" json:"rank_info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Uninomial) Reset() {
+	*x = Uninomial{}
+	mi := &file_gnparser_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Uninomial) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Uninomial) ProtoMessage() {}
+
+func (x *Uninomial) ProtoReflect() protoreflect.Message {
+	mi := &file_gnparser_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Uninomial.ProtoReflect.Descriptor instead.
+func (*Uninomial) Descriptor() ([]byte, []int) {
+	return file_gnparser_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Uninomial) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *Uninomial) GetRank() string {
+	if x != nil {
+		return x.Rank
+	}
+	return ""
+}
+
+func (x *Uninomial) GetCultivar() string {
+	if x != nil {
+		return x.Cultivar
+	}
+	return ""
+}
+
+func (x *Uninomial) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *Uninomial) GetAuthorship() *Authorship {
+	if x != nil {
+		return x.Authorship
+	}
+	return nil
+}
+
+func (x *Uninomial) GetRankInfo() *RankInfo {
+	if x != nil {
+		return x.RankInfo
+	}
+	return nil
+}
+
+type Species struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Genus         string                 `protobuf:"bytes,1,opt,name=genus,proto3" json:"genus,omitempty"`
+	Subgenus      string                 `protobuf:"bytes,2,opt,name=subgenus,proto3" json:"subgenus,omitempty"`
+	Species       string                 `protobuf:"bytes,3,opt,name=species,proto3" json:"species,omitempty"`
+	Cultivar      string                 `protobuf:"bytes,4,opt,name=cultivar,proto3" json:"cultivar,omitempty"`
+	Authorship    *Authorship            `protobuf:"bytes,5,opt,name=authorship,proto3" json:"authorship,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Species) Reset() {
+	*x = Species{}
+	mi := &file_gnparser_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Species) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Species) ProtoMessage() {}
+
+func (x *Species) ProtoReflect() protoreflect.Message {
+	mi := &file_gnparser_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Species.ProtoReflect.Descriptor instead.
+func (*Species) Descriptor() ([]byte, []int) {
+	return file_gnparser_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *Species) GetGenus() string {
+	if x != nil {
+		return x.Genus
+	}
+	return ""
+}
+
+func (x *Species) GetSubgenus() string {
+	if x != nil {
+		return x.Subgenus
+	}
+	return ""
+}
+
+func (x *Species) GetSpecies() string {
+	if x != nil {
+		return x.Species
+	}
+	return ""
+}
+
+func (x *Species) GetCultivar() string {
+	if x != nil {
+		return x.Cultivar
+	}
+	return ""
+}
+
+func (x *Species) GetAuthorship() *Authorship {
+	if x != nil {
+		return x.Authorship
+	}
+	return nil
+}
+
+type Infraspecies struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Species       *Species               `protobuf:"bytes,1,opt,name=species,proto3" json:"species,omitempty"`
+	Infraspecies  []*InfraspeciesElem    `protobuf:"bytes,2,rep,name=infraspecies,proto3" json:"infraspecies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Infraspecies) Reset() {
+	*x = Infraspecies{}
+	mi := &file_gnparser_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Infraspecies) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Infraspecies) ProtoMessage() {}
+
+func (x *Infraspecies) ProtoReflect() protoreflect.Message {
+	mi := &file_gnparser_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Infraspecies.ProtoReflect.Descriptor instead.
+func (*Infraspecies) Descriptor() ([]byte, []int) {
+	return file_gnparser_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *Infraspecies) GetSpecies() *Species {
+	if x != nil {
+		return x.Species
+	}
+	return nil
+}
+
+func (x *Infraspecies) GetInfraspecies() []*InfraspeciesElem {
+	if x != nil {
+		return x.Infraspecies
+	}
+	return nil
+}
+
+type InfraspeciesElem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Rank          string                 `protobuf:"bytes,2,opt,name=rank,proto3" json:"rank,omitempty"`
+	Authorship    *Authorship            `protobuf:"bytes,3,opt,name=authorship,proto3" json:"authorship,omitempty"`
+	RankInfo      *RankInfo              `protobuf:"bytes,4,opt,name=rank_info,json=rankInfo,proto3" json:"rank_info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InfraspeciesElem) Reset() {
+	*x = InfraspeciesElem{}
+	mi := &file_gnparser_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InfraspeciesElem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InfraspeciesElem) ProtoMessage() {}
+
+func (x *InfraspeciesElem) ProtoReflect() protoreflect.Message {
+	mi := &file_gnparser_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InfraspeciesElem.ProtoReflect.Descriptor instead.
+func (*InfraspeciesElem) Descriptor() ([]byte, []int) {
+	return file_gnparser_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *InfraspeciesElem) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *InfraspeciesElem) GetRank() string {
+	if x != nil {
+		return x.Rank
+	}
+	return ""
+}
+
+func (x *InfraspeciesElem) GetAuthorship() *Authorship {
+	if x != nil {
+		return x.Authorship
+	}
+	return nil
+}
+
+func (x *InfraspeciesElem) GetRankInfo() *RankInfo {
+	if x != nil {
+		return x.RankInfo
+	}
+	return nil
+}
+
+type Comparison struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Genus            string                 `protobuf:"bytes,1,opt,name=genus,proto3" json:"genus,omitempty"`
+	Species          *Species               `protobuf:"bytes,2,opt,name=species,proto3" json:"species,omitempty"`
+	Infraspecies     *InfraspeciesElem      `protobuf:"bytes,3,opt,name=infraspecies,proto3" json:"infraspecies,omitempty"`
+	ComparisonMarker string                 `protobuf:"bytes,4,opt,name=comparison_marker,json=comparisonMarker,proto3" json:"comparison_marker,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Comparison) Reset() {
+	*x = Comparison{}
+	mi := &file_gnparser_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Comparison) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Comparison) ProtoMessage() {}
+
+func (x *Comparison) ProtoReflect() protoreflect.Message {
+	mi := &file_gnparser_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Comparison.ProtoReflect.Descriptor instead.
+func (*Comparison) Descriptor() ([]byte, []int) {
+	return file_gnparser_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Comparison) GetGenus() string {
+	if x != nil {
+		return x.Genus
+	}
+	return ""
+}
+
+func (x *Comparison) GetSpecies() *Species {
+	if x != nil {
+		return x.Species
+	}
+	return nil
+}
+
+func (x *Comparison) GetInfraspecies() *InfraspeciesElem {
+	if x != nil {
+		return x.Infraspecies
+	}
+	return nil
+}
+
+func (x *Comparison) GetComparisonMarker() string {
+	if x != nil {
+		return x.ComparisonMarker
+	}
+	return ""
+}
+
+type Approximation struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Genus               string                 `protobuf:"bytes,1,opt,name=genus,proto3" json:"genus,omitempty"`
+	Species             string                 `protobuf:"bytes,2,opt,name=species,proto3" json:"species,omitempty"`
+	Cultivar            string                 `protobuf:"bytes,3,opt,name=cultivar,proto3" json:"cultivar,omitempty"`
+	Authorship          *Authorship            `protobuf:"bytes,4,opt,name=authorship,proto3" json:"authorship,omitempty"`
+	ApproximationMarker string                 `protobuf:"bytes,5,opt,name=approximation_marker,json=approximationMarker,proto3" json:"approximation_marker,omitempty"`
+	Ignored             string                 `protobuf:"bytes,6,opt,name=ignored,proto3" json:"ignored,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Approximation) Reset() {
+	*x = Approximation{}
+	mi := &file_gnparser_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Approximation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Approximation) ProtoMessage() {}
+
+func (x *Approximation) ProtoReflect() protoreflect.Message {
+	mi := &file_gnparser_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Approximation.ProtoReflect.Descriptor instead.
+func (*Approximation) Descriptor() ([]byte, []int) {
+	return file_gnparser_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Approximation) GetGenus() string {
+	if x != nil {
+		return x.Genus
+	}
+	return ""
+}
+
+func (x *Approximation) GetSpecies() string {
+	if x != nil {
+		return x.Species
+	}
+	return ""
+}
+
+func (x *Approximation) GetCultivar() string {
+	if x != nil {
+		return x.Cultivar
+	}
+	return ""
+}
+
+func (x *Approximation) GetAuthorship() *Authorship {
+	if x != nil {
+		return x.Authorship
+	}
+	return nil
+}
+
+func (x *Approximation) GetApproximationMarker() string {
+	if x != nil {
+		return x.ApproximationMarker
+	}
+	return ""
+}
+
+func (x *Approximation) GetIgnored() string {
+	if x != nil {
+		return x.Ignored
+	}
+	return ""
+}
+
+type UninomialICVCN struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Rank          string                 `protobuf:"bytes,2,opt,name=rank,proto3" json:"rank,omitempty"`
+	RankInfo      *RankInfo              `protobuf:"bytes,3,opt,name=rank_info,json=rankInfo,proto3" json:"rank_info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UninomialICVCN) Reset() {
+	*x = UninomialICVCN{}
+	mi := &file_gnparser_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UninomialICVCN) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UninomialICVCN) ProtoMessage() {}
+
+func (x *UninomialICVCN) ProtoReflect() protoreflect.Message {
+	mi := &file_gnparser_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UninomialICVCN.ProtoReflect.Descriptor instead.
+func (*UninomialICVCN) Descriptor() ([]byte, []int) {
+	return file_gnparser_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *UninomialICVCN) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *UninomialICVCN) GetRank() string {
+	if x != nil {
+		return x.Rank
+	}
+	return ""
+}
+
+func (x *UninomialICVCN) GetRankInfo() *RankInfo {
+	if x != nil {
+		return x.RankInfo
+	}
+	return nil
+}
+
+type SpeciesICVCN struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Genus         string                 `protobuf:"bytes,1,opt,name=genus,proto3" json:"genus,omitempty"`
+	Species       string                 `protobuf:"bytes,2,opt,name=species,proto3" json:"species,omitempty"`
+	Rank          string                 `protobuf:"bytes,3,opt,name=rank,proto3" json:"rank,omitempty"`
+	RankInfo      *RankInfo              `protobuf:"bytes,4,opt,name=rank_info,json=rankInfo,proto3" json:"rank_info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpeciesICVCN) Reset() {
+	*x = SpeciesICVCN{}
+	mi := &file_gnparser_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpeciesICVCN) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpeciesICVCN) ProtoMessage() {}
+
+func (x *SpeciesICVCN) ProtoReflect() protoreflect.Message {
+	mi := &file_gnparser_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpeciesICVCN.ProtoReflect.Descriptor instead.
+func (*SpeciesICVCN) Descriptor() ([]byte, []int) {
+	return file_gnparser_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SpeciesICVCN) GetGenus() string {
+	if x != nil {
+		return x.Genus
+	}
+	return ""
+}
+
+func (x *SpeciesICVCN) GetSpecies() string {
+	if x != nil {
+		return x.Species
+	}
+	return ""
+}
+
+func (x *SpeciesICVCN) GetRank() string {
+	if x != nil {
+		return x.Rank
+	}
+	return ""
+}
+
+func (x *SpeciesICVCN) GetRankInfo() *RankInfo {
+	if x != nil {
+		return x.RankInfo
+	}
+	return nil
+}
+
+// RankInfo describes a rank with the standard vocabulary of ranks.
+type RankInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Identifier of the rank, for example 'subspecies'.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Number for sorting ranks from the highest to the lowest.
+	Level int32 `protobuf:"varint,2,opt,name=level,proto3" json:"level,omitempty"`
+	// Nomenclatural code that uses the rank, empty for common ranks.
+	Code string `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	// URI of the rank in the GBIF rank vocabulary.
+	Uri           string `protobuf:"bytes,4,opt,name=uri,proto3" json:"uri,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RankInfo) Reset() {
+	*x = RankInfo{}
+	mi := &file_gnparser_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RankInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RankInfo) ProtoMessage() {}
+
+func (x *RankInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_gnparser_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RankInfo.ProtoReflect.Descriptor instead.
+func (*RankInfo) Descriptor() ([]byte, []int) {
+	return file_gnparser_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RankInfo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RankInfo) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *RankInfo) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *RankInfo) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+var File_gnparser_proto protoreflect.FileDescriptor
+
+const file_gnparser_proto_rawDesc = "" +
+	"\n" +
+	"\x0egnparser.proto\x12\vgnparser.v1\"\xed\x04\n" +
+	"\aOptions\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12%\n" +
+	"\x0ewith_cultivars\x18\x02 \x01(\bR\rwithCultivars\x12!\n" +
+	"\fwith_details\x18\x03 \x01(\bR\vwithDetails\x12\x1e\n" +
+	"\n" +
+	"capitalize\x18\x04 \x01(\bR\n" +
+	"capitalize\x12(\n" +
+	"\x10ignore_html_tags\x18\x05 \x01(\bR\x0eignoreHtmlTags\x12-\n" +
+	"\x12preserve_diaereses\x18\x06 \x01(\bR\x11preserveDiaereses\x12'\n" +
+	"\x0fcompact_authors\x18\a \x01(\bR\x0ecompactAuthors\x12*\n" +
+	"\x11species_group_cut\x18\b \x01(\bR\x0fspeciesGroupCut\x12\x1c\n" +
+	"\tunordered\x18\t \x01(\bR\tunordered\x12'\n" +
+	"\x0fquality_profile\x18\n" +
+	" \x01(\tR\x0equalityProfile\x12\x12\n" +
+	"\x04lang\x18\v \x01(\tR\x04lang\x12)\n" +
+	"\x10with_suggestions\x18\f \x01(\bR\x0fwithSuggestions\x12)\n" +
+	"\x10gender_agreement\x18\r \x01(\bR\x0fgenderAgreement\x12%\n" +
+	"\x0ewith_hierarchy\x18\x0e \x01(\bR\rwithHierarchy\x12.\n" +
+	"\x13with_code_inference\x18\x0f \x01(\bR\x11withCodeInference\x12.\n" +
+	"\x13with_rank_inference\x18\x10 \x01(\bR\x11withRankInference\"\x10\n" +
+	"\x0eVersionRequest\"A\n" +
+	"\x0fVersionResponse\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\tR\aversion\x12\x14\n" +
+	"\x05build\x18\x02 \x01(\tR\x05build\"V\n" +
+	"\x10ParseNameRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12.\n" +
+	"\aoptions\x18\x02 \x01(\v2\x14.gnparser.v1.OptionsR\aoptions\"Y\n" +
+	"\x11ParseNamesRequest\x12\x14\n" +
+	"\x05names\x18\x01 \x03(\tR\x05names\x12.\n" +
+	"\aoptions\x18\x02 \x01(\v2\x14.gnparser.v1.OptionsR\aoptions\"C\n" +
+	"\x12ParseNamesResponse\x12-\n" +
+	"\aresults\x18\x01 \x03(\v2\x13.gnparser.v1.ParsedR\aresults\"\xce\b\n" +
+	"\x06Parsed\x12\x16\n" +
+	"\x06parsed\x18\x01 \x01(\bR\x06parsed\x12<\n" +
+	"\x1anomenclatural_code_setting\x18\x02 \x01(\tR\x18nomenclaturalCodeSetting\x12\x18\n" +
+	"\aquality\x18\x03 \x01(\x05R\aquality\x12F\n" +
+	"\x10quality_warnings\x18\x04 \x03(\v2\x1b.gnparser.v1.QualityWarningR\x0fqualityWarnings\x12\x1a\n" +
+	"\bverbatim\x18\x05 \x01(\tR\bverbatim\x12\x1e\n" +
+	"\n" +
+	"normalized\x18\x06 \x01(\tR\n" +
+	"normalized\x124\n" +
+	"\tcanonical\x18\a \x01(\v2\x16.gnparser.v1.CanonicalR\tcanonical\x12 \n" +
+	"\vcardinality\x18\b \x01(\x05R\vcardinality\x12\x12\n" +
+	"\x04rank\x18\t \x01(\tR\x04rank\x127\n" +
+	"\n" +
+	"authorship\x18\n" +
+	" \x01(\v2\x17.gnparser.v1.AuthorshipR\n" +
+	"authorship\x12\x1a\n" +
+	"\bbacteria\x18\v \x01(\tR\bbacteria\x12\x1e\n" +
+	"\n" +
+	"candidatus\x18\f \x01(\bR\n" +
+	"candidatus\x12\x14\n" +
+	"\x05virus\x18\r \x01(\bR\x05virus\x12\x1a\n" +
+	"\bcultivar\x18\x0e \x01(\bR\bcultivar\x12\x1f\n" +
+	"\vdagger_char\x18\x0f \x01(\bR\n" +
+	"daggerChar\x12\x16\n" +
+	"\x06hybrid\x18\x10 \x01(\tR\x06hybrid\x12#\n" +
+	"\rgraft_chimera\x18\x11 \x01(\tR\fgraftChimera\x12\x1c\n" +
+	"\tsurrogate\x18\x12 \x01(\tR\tsurrogate\x12\x12\n" +
+	"\x04tail\x18\x13 \x01(\tR\x04tail\x12.\n" +
+	"\adetails\x18\x14 \x01(\v2\x14.gnparser.v1.DetailsR\adetails\x12'\n" +
+	"\x05words\x18\x15 \x03(\v2\x11.gnparser.v1.WordR\x05words\x12\x0e\n" +
+	"\x02id\x18\x16 \x01(\tR\x02id\x12%\n" +
+	"\x0eparser_version\x18\x17 \x01(\tR\rparserVersion\x12'\n" +
+	"\x0fquality_profile\x18\x18 \x01(\tR\x0equalityProfile\x129\n" +
+	"\vsuggestions\x18\x19 \x03(\v2\x17.gnparser.v1.SuggestionR\vsuggestions\x128\n" +
+	"\thierarchy\x18\x1a \x03(\v2\x1a.gnparser.v1.HierarchyElemR\thierarchy\x12Z\n" +
+	"\x1bnomenclatural_code_inferred\x18\x1b \x01(\v2\x1a.gnparser.v1.CodeInferenceR\x19nomenclaturalCodeInferred\x12#\n" +
+	"\rrank_inferred\x18\x1c \x01(\bR\frankInferred\"\x92\x01\n" +
+	"\x0eQualityWarning\x12\x18\n" +
+	"\aquality\x18\x01 \x01(\x05R\aquality\x12\x18\n" +
+	"\awarning\x18\x02 \x01(\tR\awarning\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\x12\x1e\n" +
+	"\n" +
+	"correction\x18\x05 \x01(\tR\n" +
+	"correction\"\xb8\x01\n" +
+	"\n" +
+	"Suggestion\x12\x1c\n" +
+	"\tcanonical\x18\x01 \x01(\tR\tcanonical\x12\x1a\n" +
+	"\bverbatim\x18\x02 \x01(\tR\bverbatim\x12\x1e\n" +
+	"\n" +
+	"correction\x18\x03 \x01(\tR\n" +
+	"correction\x12\x12\n" +
+	"\x04rule\x18\x04 \x01(\tR\x04rule\x12\x1c\n" +
+	"\treference\x18\x05 \x01(\tR\treference\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\x06 \x01(\x01R\n" +
+	"confidence\"\xb1\x01\n" +
+	"\rHierarchyElem\x12\x12\n" +
+	"\x04rank\x18\x01 \x01(\tR\x04rank\x124\n" +
+	"\tcanonical\x18\x02 \x01(\v2\x16.gnparser.v1.CanonicalR\tcanonical\x127\n" +
+	"\n" +
+	"authorship\x18\x03 \x01(\v2\x17.gnparser.v1.AuthorshipR\n" +
+	"authorship\x12\x1d\n" +
+	"\n" +
+	"is_autonym\x18\x04 \x01(\bR\tisAutonym\"z\n" +
+	"\rCodeInference\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\x02 \x01(\x01R\n" +
+	"confidence\x125\n" +
+	"\bevidence\x18\x03 \x03(\v2\x19.gnparser.v1.CodeEvidenceR\bevidence\"d\n" +
+	"\fCodeEvidence\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
+	"\x04clue\x18\x02 \x01(\tR\x04clue\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\x12\x16\n" +
+	"\x06weight\x18\x04 \x01(\x01R\x06weight\"Q\n" +
+	"\tCanonical\x12\x18\n" +
+	"\astemmed\x18\x01 \x01(\tR\astemmed\x12\x16\n" +
+	"\x06simple\x18\x02 \x01(\tR\x06simple\x12\x12\n" +
+	"\x04full\x18\x03 \x01(\tR\x04full\"\xe4\x01\n" +
+	"\n" +
+	"Authorship\x12\x1a\n" +
+	"\bverbatim\x18\x01 \x01(\tR\bverbatim\x12\x1e\n" +
+	"\n" +
+	"normalized\x18\x02 \x01(\tR\n" +
+	"normalized\x12\x12\n" +
+	"\x04year\x18\x03 \x01(\tR\x04year\x12\x18\n" +
+	"\aauthors\x18\x04 \x03(\tR\aauthors\x122\n" +
+	"\boriginal\x18\x05 \x01(\v2\x16.gnparser.v1.AuthGroupR\boriginal\x128\n" +
+	"\vcombination\x18\x06 \x01(\v2\x16.gnparser.v1.AuthGroupR\vcombination\"\xf1\x01\n" +
+	"\tAuthGroup\x12\x18\n" +
+	"\aauthors\x18\x01 \x03(\tR\aauthors\x12%\n" +
+	"\x04year\x18\x02 \x01(\v2\x11.gnparser.v1.YearR\x04year\x123\n" +
+	"\n" +
+	"ex_authors\x18\x03 \x01(\v2\x14.gnparser.v1.AuthorsR\texAuthors\x123\n" +
+	"\n" +
+	"in_authors\x18\x04 \x01(\v2\x14.gnparser.v1.AuthorsR\tinAuthors\x129\n" +
+	"\remend_authors\x18\x05 \x01(\v2\x14.gnparser.v1.AuthorsR\femendAuthors\"J\n" +
+	"\aAuthors\x12\x18\n" +
+	"\aauthors\x18\x01 \x03(\tR\aauthors\x12%\n" +
+	"\x04year\x18\x02 \x01(\v2\x11.gnparser.v1.YearR\x04year\"C\n" +
+	"\x04Year\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12%\n" +
+	"\x0eis_approximate\x18\x02 \x01(\bR\risApproximate\"\x9d\x01\n" +
+	"\x04Word\x12\x1a\n" +
+	"\bverbatim\x18\x01 \x01(\tR\bverbatim\x12\x1e\n" +
+	"\n" +
+	"normalized\x18\x02 \x01(\tR\n" +
+	"normalized\x12\x1b\n" +
+	"\tword_type\x18\x03 \x01(\tR\bwordType\x12\x14\n" +
+	"\x05start\x18\x04 \x01(\x05R\x05start\x12\x10\n" +
+	"\x03end\x18\x05 \x01(\x05R\x03end\x12\x14\n" +
+	"\x05label\x18\x06 \x01(\tR\x05label\"\xe1\x04\n" +
+	"\aDetails\x126\n" +
+	"\tuninomial\x18\x01 \x01(\v2\x16.gnparser.v1.UninomialH\x00R\tuninomial\x120\n" +
+	"\aspecies\x18\x02 \x01(\v2\x14.gnparser.v1.SpeciesH\x00R\aspecies\x12?\n" +
+	"\finfraspecies\x18\x03 \x01(\v2\x19.gnparser.v1.InfraspeciesH\x00R\finfraspecies\x129\n" +
+	"\n" +
+	"comparison\x18\x04 \x01(\v2\x17.gnparser.v1.ComparisonH\x00R\n" +
+	"comparison\x12B\n" +
+	"\rapproximation\x18\x05 \x01(\v2\x1a.gnparser.v1.ApproximationH\x00R\rapproximation\x12D\n" +
+	"\x0ehybrid_formula\x18\x06 \x01(\v2\x1b.gnparser.v1.DetailsFormulaH\x00R\rhybridFormula\x12Q\n" +
+	"\x15graft_chimera_formula\x18\a \x01(\v2\x1b.gnparser.v1.DetailsFormulaH\x00R\x13graftChimeraFormula\x12F\n" +
+	"\x0funinomial_icvcn\x18\b \x01(\v2\x1b.gnparser.v1.UninomialICVCNH\x00R\x0euninomialIcvcn\x12@\n" +
+	"\rspecies_icvcn\x18\t \x01(\v2\x19.gnparser.v1.SpeciesICVCNH\x00R\fspeciesIcvcnB\t\n" +
+	"\adetails\"B\n" +
+	"\x0eDetailsFormula\x120\n" +
+	"\belements\x18\x01 \x03(\v2\x14.gnparser.v1.DetailsR\belements\"\xd6\x01\n" +
+	"\tUninomial\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x12\n" +
+	"\x04rank\x18\x02 \x01(\tR\x04rank\x12\x1a\n" +
+	"\bcultivar\x18\x03 \x01(\tR\bcultivar\x12\x16\n" +
+	"\x06parent\x18\x04 \x01(\tR\x06parent\x127\n" +
+	"\n" +
+	"authorship\x18\x05 \x01(\v2\x17.gnparser.v1.AuthorshipR\n" +
+	"authorship\x122\n" +
+	"\trank_info\x18\x06 \x01(\v2\x15.gnparser.v1.RankInfoR\brankInfo\"\xaa\x01\n" +
+	"\aSpecies\x12\x14\n" +
+	"\x05genus\x18\x01 \x01(\tR\x05genus\x12\x1a\n" +
+	"\bsubgenus\x18\x02 \x01(\tR\bsubgenus\x12\x18\n" +
+	"\aspecies\x18\x03 \x01(\tR\aspecies\x12\x1a\n" +
+	"\bcultivar\x18\x04 \x01(\tR\bcultivar\x127\n" +
+	"\n" +
+	"authorship\x18\x05 \x01(\v2\x17.gnparser.v1.AuthorshipR\n" +
+	"authorship\"\x81\x01\n" +
+	"\fInfraspecies\x12.\n" +
+	"\aspecies\x18\x01 \x01(\v2\x14.gnparser.v1.SpeciesR\aspecies\x12A\n" +
+	"\finfraspecies\x18\x02 \x03(\v2\x1d.gnparser.v1.InfraspeciesElemR\finfraspecies\"\xa9\x01\n" +
+	"\x10InfraspeciesElem\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x12\n" +
+	"\x04rank\x18\x02 \x01(\tR\x04rank\x127\n" +
+	"\n" +
+	"authorship\x18\x03 \x01(\v2\x17.gnparser.v1.AuthorshipR\n" +
+	"authorship\x122\n" +
+	"\trank_info\x18\x04 \x01(\v2\x15.gnparser.v1.RankInfoR\brankInfo\"\xc2\x01\n" +
+	"\n" +
+	"Comparison\x12\x14\n" +
+	"\x05genus\x18\x01 \x01(\tR\x05genus\x12.\n" +
+	"\aspecies\x18\x02 \x01(\v2\x14.gnparser.v1.SpeciesR\aspecies\x12A\n" +
+	"\finfraspecies\x18\x03 \x01(\v2\x1d.gnparser.v1.InfraspeciesElemR\finfraspecies\x12+\n" +
+	"\x11comparison_marker\x18\x04 \x01(\tR\x10comparisonMarker\"\xe1\x01\n" +
+	"\rApproximation\x12\x14\n" +
+	"\x05genus\x18\x01 \x01(\tR\x05genus\x12\x18\n" +
+	"\aspecies\x18\x02 \x01(\tR\aspecies\x12\x1a\n" +
+	"\bcultivar\x18\x03 \x01(\tR\bcultivar\x127\n" +
+	"\n" +
+	"authorship\x18\x04 \x01(\v2\x17.gnparser.v1.AuthorshipR\n" +
+	"authorship\x121\n" +
+	"\x14approximation_marker\x18\x05 \x01(\tR\x13approximationMarker\x12\x18\n" +
+	"\aignored\x18\x06 \x01(\tR\aignored\"n\n" +
+	"\x0eUninomialICVCN\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x12\n" +
+	"\x04rank\x18\x02 \x01(\tR\x04rank\x122\n" +
+	"\trank_info\x18\x03 \x01(\v2\x15.gnparser.v1.RankInfoR\brankInfo\"\x86\x01\n" +
+	"\fSpeciesICVCN\x12\x14\n" +
+	"\x05genus\x18\x01 \x01(\tR\x05genus\x12\x18\n" +
+	"\aspecies\x18\x02 \x01(\tR\aspecies\x12\x12\n" +
+	"\x04rank\x18\x03 \x01(\tR\x04rank\x122\n" +
+	"\trank_info\x18\x04 \x01(\v2\x15.gnparser.v1.RankInfoR\brankInfo\"V\n" +
+	"\bRankInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05level\x18\x02 \x01(\x05R\x05level\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\tR\x04code\x12\x10\n" +
+	"\x03uri\x18\x04 \x01(\tR\x03uri2\xab\x02\n" +
+	"\bGNparser\x12D\n" +
+	"\aVersion\x12\x1b.gnparser.v1.VersionRequest\x1a\x1c.gnparser.v1.VersionResponse\x12?\n" +
+	"\tParseName\x12\x1d.gnparser.v1.ParseNameRequest\x1a\x13.gnparser.v1.Parsed\x12M\n" +
+	"\n" +
+	"ParseNames\x12\x1e.gnparser.v1.ParseNamesRequest\x1a\x1f.gnparser.v1.ParseNamesResponse\x12I\n" +
+	"\x0fParseNameStream\x12\x1d.gnparser.v1.ParseNameRequest\x1a\x13.gnparser.v1.Parsed(\x010\x01B2Z0github.com/gnames/gnparser/io/grpcsrv/gnparserpbb\x06proto3"
+
+var (
+	file_gnparser_proto_rawDescOnce sync.Once
+	file_gnparser_proto_rawDescData []byte
+)
+
+func file_gnparser_proto_rawDescGZIP() []byte {
+	file_gnparser_proto_rawDescOnce.Do(func() {
+		file_gnparser_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_gnparser_proto_rawDesc), len(file_gnparser_proto_rawDesc)))
+	})
+	return file_gnparser_proto_rawDescData
+}
+
+var file_gnparser_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
+var file_gnparser_proto_goTypes = []any{
+	(*Options)(nil),            // 0: gnparser.v1.Options
+	(*VersionRequest)(nil),     // 1: gnparser.v1.VersionRequest
+	(*VersionResponse)(nil),    // 2: gnparser.v1.VersionResponse
+	(*ParseNameRequest)(nil),   // 3: gnparser.v1.ParseNameRequest
+	(*ParseNamesRequest)(nil),  // 4: gnparser.v1.ParseNamesRequest
+	(*ParseNamesResponse)(nil), // 5: gnparser.v1.ParseNamesResponse
+	(*Parsed)(nil),             // 6: gnparser.v1.Parsed
+	(*QualityWarning)(nil),     // 7: gnparser.v1.QualityWarning
+	(*Suggestion)(nil),         // 8: gnparser.v1.Suggestion
+	(*HierarchyElem)(nil),      // 9: gnparser.v1.HierarchyElem
+	(*CodeInference)(nil),      // 10: gnparser.v1.CodeInference
+	(*CodeEvidence)(nil),       // 11: gnparser.v1.CodeEvidence
+	(*Canonical)(nil),          // 12: gnparser.v1.Canonical
+	(*Authorship)(nil),         // 13: gnparser.v1.Authorship
+	(*AuthGroup)(nil),          // 14: gnparser.v1.AuthGroup
+	(*Authors)(nil),            // 15: gnparser.v1.Authors
+	(*Year)(nil),               // 16: gnparser.v1.Year
+	(*Word)(nil),               // 17: gnparser.v1.Word
+	(*Details)(nil),            // 18: gnparser.v1.Details
+	(*DetailsFormula)(nil),     // 19: gnparser.v1.DetailsFormula
+	(*Uninomial)(nil),          // 20: gnparser.v1.Uninomial
+	(*Species)(nil),            // 21: gnparser.v1.Species
+	(*Infraspecies)(nil),       // 22: gnparser.v1.Infraspecies
+	(*InfraspeciesElem)(nil),   // 23: gnparser.v1.InfraspeciesElem
+	(*Comparison)(nil),         // 24: gnparser.v1.Comparison
+	(*Approximation)(nil),      // 25: gnparser.v1.Approximation
+	(*UninomialICVCN)(nil),     // 26: gnparser.v1.UninomialICVCN
+	(*SpeciesICVCN)(nil),       // 27: gnparser.v1.SpeciesICVCN
+	(*RankInfo)(nil),           // 28: gnparser.v1.RankInfo
+}
+var file_gnparser_proto_depIdxs = []int32{
+	0,  // 0: gnparser.v1.ParseNameRequest.options:type_name -> gnparser.v1.Options
+	0,  // 1: gnparser.v1.ParseNamesRequest.options:type_name -> gnparser.v1.Options
+	6,  // 2: gnparser.v1.ParseNamesResponse.results:type_name -> gnparser.v1.Parsed
+	7,  // 3: gnparser.v1.Parsed.quality_warnings:type_name -> gnparser.v1.QualityWarning
+	12, // 4: gnparser.v1.Parsed.canonical:type_name -> gnparser.v1.Canonical
+	13, // 5: gnparser.v1.Parsed.authorship:type_name -> gnparser.v1.Authorship
+	18, // 6: gnparser.v1.Parsed.details:type_name -> gnparser.v1.Details
+	17, // 7: gnparser.v1.Parsed.words:type_name -> gnparser.v1.Word
+	8,  // 8: gnparser.v1.Parsed.suggestions:type_name -> gnparser.v1.Suggestion
+	9,  // 9: gnparser.v1.Parsed.hierarchy:type_name -> gnparser.v1.HierarchyElem
+	10, // 10: gnparser.v1.Parsed.nomenclatural_code_inferred:type_name -> gnparser.v1.CodeInference
+	12, // 11: gnparser.v1.HierarchyElem.canonical:type_name -> gnparser.v1.Canonical
+	13, // 12: gnparser.v1.HierarchyElem.authorship:type_name -> gnparser.v1.Authorship
+	11, // 13: gnparser.v1.CodeInference.evidence:type_name -> gnparser.v1.CodeEvidence
+	14, // 14: gnparser.v1.Authorship.original:type_name -> gnparser.v1.AuthGroup
+	14, // 15: gnparser.v1.Authorship.combination:type_name -> gnparser.v1.AuthGroup
+	16, // 16: gnparser.v1.AuthGroup.year:type_name -> gnparser.v1.Year
+	15, // 17: gnparser.v1.AuthGroup.ex_authors:type_name -> gnparser.v1.Authors
+	15, // 18: gnparser.v1.AuthGroup.in_authors:type_name -> gnparser.v1.Authors
+	15, // 19: gnparser.v1.AuthGroup.emend_authors:type_name -> gnparser.v1.Authors
+	16, // 20: gnparser.v1.Authors.year:type_name -> gnparser.v1.Year
+	20, // 21: gnparser.v1.Details.uninomial:type_name -> gnparser.v1.Uninomial
+	21, // 22: gnparser.v1.Details.species:type_name -> gnparser.v1.Species
+	22, // 23: gnparser.v1.Details.infraspecies:type_name -> gnparser.v1.Infraspecies
+	24, // 24: gnparser.v1.Details.comparison:type_name -> gnparser.v1.Comparison
+	25, // 25: gnparser.v1.Details.approximation:type_name -> gnparser.v1.Approximation
+	19, // 26: gnparser.v1.Details.hybrid_formula:type_name -> gnparser.v1.DetailsFormula
+	19, // 27: gnparser.v1.Details.graft_chimera_formula:type_name -> gnparser.v1.DetailsFormula
+	26, // 28: gnparser.v1.Details.uninomial_icvcn:type_name -> gnparser.v1.UninomialICVCN
+	27, // 29: gnparser.v1.Details.species_icvcn:type_name -> gnparser.v1.SpeciesICVCN
+	18, // 30: gnparser.v1.DetailsFormula.elements:type_name -> gnparser.v1.Details
+	13, // 31: gnparser.v1.Uninomial.authorship:type_name -> gnparser.v1.Authorship
+	28, // 32: gnparser.v1.Uninomial.rank_info:type_name -> gnparser.v1.RankInfo
+	13, // 33: gnparser.v1.Species.authorship:type_name -> gnparser.v1.Authorship
+	21, // 34: gnparser.v1.Infraspecies.species:type_name -> gnparser.v1.Species
+	23, // 35: gnparser.v1.Infraspecies.infraspecies:type_name -> gnparser.v1.InfraspeciesElem
+	13, // 36: gnparser.v1.InfraspeciesElem.authorship:type_name -> gnparser.v1.Authorship
+	28, // 37: gnparser.v1.InfraspeciesElem.rank_info:type_name -> gnparser.v1.RankInfo
+	21, // 38: gnparser.v1.Comparison.species:type_name -> gnparser.v1.Species
+	23, // 39: gnparser.v1.Comparison.infraspecies:type_name -> gnparser.v1.InfraspeciesElem
+	13, // 40: gnparser.v1.Approximation.authorship:type_name -> gnparser.v1.Authorship
+	28, // 41: gnparser.v1.UninomialICVCN.rank_info:type_name -> gnparser.v1.RankInfo
+	28, // 42: gnparser.v1.SpeciesICVCN.rank_info:type_name -> gnparser.v1.RankInfo
+	1,  // 43: gnparser.v1.GNparser.Version:input_type -> gnparser.v1.VersionRequest
+	3,  // 44: gnparser.v1.GNparser.ParseName:input_type -> gnparser.v1.ParseNameRequest
+	4,  // 45: gnparser.v1.GNparser.ParseNames:input_type -> gnparser.v1.ParseNamesRequest
+	3,  // 46: gnparser.v1.GNparser.ParseNameStream:input_type -> gnparser.v1.ParseNameRequest
+	2,  // 47: gnparser.v1.GNparser.Version:output_type -> gnparser.v1.VersionResponse
+	6,  // 48: gnparser.v1.GNparser.ParseName:output_type -> gnparser.v1.Parsed
+	5,  // 49: gnparser.v1.GNparser.ParseNames:output_type -> gnparser.v1.ParseNamesResponse
+	6,  // 50: gnparser.v1.GNparser.ParseNameStream:output_type -> gnparser.v1.Parsed
+	47, // [47:51] is the sub-list for method output_type
+	43, // [43:47] is the sub-list for method input_type
+	43, // [43:43] is the sub-list for extension type_name
+	43, // [43:43] is the sub-list for extension extendee
+	0,  // [0:43] is the sub-list for field type_name
+}
+
+func init() { file_gnparser_proto_init() }
+func file_gnparser_proto_init() {
+	if File_gnparser_proto != nil {
+		return
+	}
+	file_gnparser_proto_msgTypes[18].OneofWrappers = []any{
+		(*Details_Uninomial)(nil),
+		(*Details_Species)(nil),
+		(*Details_Infraspecies)(nil),
+		(*Details_Comparison)(nil),
+		(*Details_Approximation)(nil),
+		(*Details_HybridFormula)(nil),
+		(*Details_GraftChimeraFormula)(nil),
+		(*Details_UninomialIcvcn)(nil),
+		(*Details_SpeciesIcvcn)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_gnparser_proto_rawDesc), len(file_gnparser_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   29,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_gnparser_proto_goTypes,
+		DependencyIndexes: file_gnparser_proto_depIdxs,
+		MessageInfos:      file_gnparser_proto_msgTypes,
+	}.Build()
+	File_gnparser_proto = out.File
+	file_gnparser_proto_goTypes = nil
+	file_gnparser_proto_depIdxs = nil
+}
@@ -0,0 +1,256 @@
+// Protocol buffers schema of the GNparser gRPC service.
+//
+// Messages mirror the JSON output of GNparser (see ent/parsed package).
+// Enumerated values (word types, warnings, annotations) are sent as the
+// same strings that are used in the JSON output.
+//
+// To regenerate Go code run `just protoc` from the root of the project.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.1
+// - protoc             (unknown)
+// source: gnparser.proto
+
+package gnparserpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	GNparser_Version_FullMethodName         = "/gnparser.v1.GNparser/Version"
+	GNparser_ParseName_FullMethodName       = "/gnparser.v1.GNparser/ParseName"
+	GNparser_ParseNames_FullMethodName      = "/gnparser.v1.GNparser/ParseNames"
+	GNparser_ParseNameStream_FullMethodName = "/gnparser.v1.GNparser/ParseNameStream"
+)
+
+// GNparserClient is the client API for GNparser service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// GNparser parses scientific names.
+type GNparserClient interface {
+	// Version returns the version of GNparser.
+	Version(ctx context.Context, in *VersionRequest, opts ...grpc.CallOption) (*VersionResponse, error)
+	// ParseName parses one name-string.
+	ParseName(ctx context.Context, in *ParseNameRequest, opts ...grpc.CallOption) (*Parsed, error)
+	// ParseNames parses a batch of name-strings. Results come in the same
+	// order as the input, unless `unordered` option is set.
+	ParseNames(ctx context.Context, in *ParseNamesRequest, opts ...grpc.CallOption) (*ParseNamesResponse, error)
+	// ParseNameStream parses a stream of name-strings. Options are taken
+	// from the first message of the stream. Results come in the same order
+	// as the input, unless `unordered` option is set.
+	ParseNameStream(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ParseNameRequest, Parsed], error)
+}
+
+type gNparserClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGNparserClient(cc grpc.ClientConnInterface) GNparserClient {
+	return &gNparserClient{cc}
+}
+
+func (c *gNparserClient) Version(ctx context.Context, in *VersionRequest, opts ...grpc.CallOption) (*VersionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VersionResponse)
+	err := c.cc.Invoke(ctx, GNparser_Version_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gNparserClient) ParseName(ctx context.Context, in *ParseNameRequest, opts ...grpc.CallOption) (*Parsed, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Parsed)
+	err := c.cc.Invoke(ctx, GNparser_ParseName_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gNparserClient) ParseNames(ctx context.Context, in *ParseNamesRequest, opts ...grpc.CallOption) (*ParseNamesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ParseNamesResponse)
+	err := c.cc.Invoke(ctx, GNparser_ParseNames_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gNparserClient) ParseNameStream(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ParseNameRequest, Parsed], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GNparser_ServiceDesc.Streams[0], GNparser_ParseNameStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ParseNameRequest, Parsed]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GNparser_ParseNameStreamClient = grpc.BidiStreamingClient[ParseNameRequest, Parsed]
+
+// GNparserServer is the server API for GNparser service.
+// All implementations must embed UnimplementedGNparserServer
+// for forward compatibility.
+//
+// GNparser parses scientific names.
+type GNparserServer interface {
+	// Version returns the version of GNparser.
+	Version(context.Context, *VersionRequest) (*VersionResponse, error)
+	// ParseName parses one name-string.
+	ParseName(context.Context, *ParseNameRequest) (*Parsed, error)
+	// ParseNames parses a batch of name-strings. Results come in the same
+	// order as the input, unless `unordered` option is set.
+	ParseNames(context.Context, *ParseNamesRequest) (*ParseNamesResponse, error)
+	// ParseNameStream parses a stream of name-strings. Options are taken
+	// from the first message of the stream. Results come in the same order
+	// as the input, unless `unordered` option is set.
+	ParseNameStream(grpc.BidiStreamingServer[ParseNameRequest, Parsed]) error
+	mustEmbedUnimplementedGNparserServer()
+}
+
+// UnimplementedGNparserServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGNparserServer struct{}
+
+func (UnimplementedGNparserServer) Version(context.Context, *VersionRequest) (*VersionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Version not implemented")
+}
+func (UnimplementedGNparserServer) ParseName(context.Context, *ParseNameRequest) (*Parsed, error) {
+	return nil, status.Error(codes.Unimplemented, "method ParseName not implemented")
+}
+func (UnimplementedGNparserServer) ParseNames(context.Context, *ParseNamesRequest) (*ParseNamesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ParseNames not implemented")
+}
+func (UnimplementedGNparserServer) ParseNameStream(grpc.BidiStreamingServer[ParseNameRequest, Parsed]) error {
+	return status.Error(codes.Unimplemented, "method ParseNameStream not implemented")
+}
+func (UnimplementedGNparserServer) mustEmbedUnimplementedGNparserServer() {}
+func (UnimplementedGNparserServer) testEmbeddedByValue()                  {}
+
+// UnsafeGNparserServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GNparserServer will
+// result in compilation errors.
+type UnsafeGNparserServer interface {
+	mustEmbedUnimplementedGNparserServer()
+}
+
+func RegisterGNparserServer(s grpc.ServiceRegistrar, srv GNparserServer) {
+	// If the following call panics, it indicates UnimplementedGNparserServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GNparser_ServiceDesc, srv)
+}
+
+func _GNparser_Version_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GNparserServer).Version(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GNparser_Version_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GNparserServer).Version(ctx, req.(*VersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GNparser_ParseName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParseNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GNparserServer).ParseName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GNparser_ParseName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GNparserServer).ParseName(ctx, req.(*ParseNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GNparser_ParseNames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParseNamesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GNparserServer).ParseNames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GNparser_ParseNames_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GNparserServer).ParseNames(ctx, req.(*ParseNamesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GNparser_ParseNameStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(GNparserServer).ParseNameStream(&grpc.GenericServerStream[ParseNameRequest, Parsed]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GNparser_ParseNameStreamServer = grpc.BidiStreamingServer[ParseNameRequest, Parsed]
+
+// GNparser_ServiceDesc is the grpc.ServiceDesc for GNparser service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GNparser_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gnparser.v1.GNparser",
+	HandlerType: (*GNparserServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Version",
+			Handler:    _GNparser_Version_Handler,
+		},
+		{
+			MethodName: "ParseName",
+			Handler:    _GNparser_ParseName_Handler,
+		},
+		{
+			MethodName: "ParseNames",
+			Handler:    _GNparser_ParseNames_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ParseNameStream",
+			Handler:       _GNparser_ParseNameStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "gnparser.proto",
+}
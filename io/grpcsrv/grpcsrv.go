@@ -0,0 +1,230 @@
+// Package grpcsrv provides gRPC service for parsing scientific names.
+// It uses the same options model and limits as the REST API.
+package grpcsrv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"os/signal"
+	"syscall"
+
+	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/ent/nameidx"
+	"github.com/gnames/gnparser/ent/parsed"
+	pb "github.com/gnames/gnparser/io/grpcsrv/gnparserpb"
+	"github.com/gnames/gnparser/io/web"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+type server struct {
+	pb.UnimplementedGNparserServer
+	gnp gnparser.GNparser
+	cfg web.Config
+}
+
+// NewServer creates a gRPC server with registered GNparser and health
+// services. Settings of gnp are used as defaults, options that come with
+// requests override them. Requests are limited by the limits of the web
+// service.
+func NewServer(gnp gnparser.GNparser, cfg web.Config) *grpc.Server {
+	var opts []grpc.ServerOption
+	if cfg.MaxBodySize > 0 {
+		opts = append(opts, grpc.MaxRecvMsgSize(int(cfg.MaxBodySize)))
+	}
+	s := grpc.NewServer(opts...)
+	pb.RegisterGNparserServer(s, &server{gnp: gnp, cfg: cfg})
+
+	hs := health.NewServer()
+	hs.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+	healthpb.RegisterHealthServer(s, hs)
+	return s
+}
+
+// Run starts gRPC service on the given port. It stops gracefully on
+// SIGINT or SIGTERM signals.
+func Run(gnp gnparser.GNparser, port int, cfg web.Config) error {
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	if err != nil {
+		return fmt.Errorf("cannot listen on port %d: %w", port, err)
+	}
+
+	s := NewServer(gnp, cfg)
+	ctx, stop := signal.NotifyContext(
+		context.Background(), syscall.SIGINT, syscall.SIGTERM,
+	)
+	defer stop()
+	go func() {
+		<-ctx.Done()
+		slog.Info("Shutting down gRPC service")
+		s.GracefulStop()
+	}()
+
+	slog.Info("Starting gRPC service", "port", port)
+	return s.Serve(lis)
+}
+
+// Version returns the version of GNparser.
+func (s *server) Version(
+	context.Context,
+	*pb.VersionRequest,
+) (*pb.VersionResponse, error) {
+	ver := s.gnp.GetVersion()
+	return &pb.VersionResponse{Version: ver.Version, Build: ver.Build}, nil
+}
+
+// ParseName parses one name-string.
+func (s *server) ParseName(
+	_ context.Context,
+	req *pb.ParseNameRequest,
+) (*pb.Parsed, error) {
+	gnp, err := s.changeConfig(req.GetOptions())
+	if err != nil {
+		return nil, err
+	}
+	if err = checkNames(s.cfg, []string{req.GetName()}); err != nil {
+		return nil, err
+	}
+	// the parsing engine of gnp is shared by all requests, ParseNames
+	// creates a new one, so concurrent requests do not interfere.
+	gnp = gnp.ChangeConfig(gnparser.OptJobsNum(1))
+	return toPB(gnp.ParseNames([]string{req.GetName()})[0]), nil
+}
+
+// ParseNames parses a batch of name-strings.
+func (s *server) ParseNames(
+	_ context.Context,
+	req *pb.ParseNamesRequest,
+) (*pb.ParseNamesResponse, error) {
+	gnp, err := s.changeConfig(req.GetOptions())
+	if err != nil {
+		return nil, err
+	}
+	if err = checkNames(s.cfg, req.GetNames()); err != nil {
+		return nil, err
+	}
+
+	res := gnp.ParseNames(req.GetNames())
+	out := pb.ParseNamesResponse{Results: make([]*pb.Parsed, len(res))}
+	for i := range res {
+		out.Results[i] = toPB(res[i])
+	}
+	return &out, nil
+}
+
+// ParseNameStream parses a stream of name-strings using
+// GNparser.ParseNameStream. Options are taken from the first message.
+func (s *server) ParseNameStream(
+	stream grpc.BidiStreamingServer[pb.ParseNameRequest, pb.Parsed],
+) error {
+	first, err := stream.Recv()
+	if errors.Is(err, io.EOF) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	gnp, err := s.changeConfig(first.GetOptions())
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancelCause(stream.Context())
+	defer cancel(nil)
+
+	chIn := make(chan nameidx.NameIdx)
+	chOut := make(chan parsed.Parsed)
+
+	go func() {
+		defer close(chIn)
+		var err error
+		req := first
+		for i := 0; ; i++ {
+			name := req.GetName()
+			if err := checkLength(s.cfg, i, name); err != nil {
+				cancel(err)
+				return
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case chIn <- nameidx.NameIdx{Index: i, NameString: name}:
+			}
+
+			req, err = stream.Recv()
+			if errors.Is(err, io.EOF) {
+				return
+			}
+			if err != nil {
+				cancel(err)
+				return
+			}
+		}
+	}()
+
+	go gnp.ParseNameStream(ctx, chIn, chOut)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return context.Cause(ctx)
+		case p, ok := <-chOut:
+			if !ok {
+				return context.Cause(ctx)
+			}
+			if err := stream.Send(toPB(p)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// changeConfig returns a copy of GNparser with settings from the request
+// options.
+func (s *server) changeConfig(opts *pb.Options) (gnparser.GNparser, error) {
+	gnpOpts, err := requestOptions(opts).Options()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return s.gnp.ChangeConfig(gnpOpts...), nil
+}
+
+// checkNames returns an error if there are more names than allowed, or
+// if any of the names is too long.
+func checkNames(cfg web.Config, names []string) error {
+	if cfg.MaxNames > 0 && len(names) > cfg.MaxNames {
+		return status.Errorf(
+			codes.ResourceExhausted,
+			"request has %d names, the limit is %d",
+			len(names), cfg.MaxNames,
+		)
+	}
+
+	for i := range names {
+		if err := checkLength(cfg, i, names[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkLength returns an error if a name-string with the index idx is
+// longer than allowed.
+func checkLength(cfg web.Config, idx int, name string) error {
+	if cfg.MaxNameLength <= 0 || len(name) <= cfg.MaxNameLength {
+		return nil
+	}
+	return status.Errorf(
+		codes.InvalidArgument,
+		"name #%d is %d bytes long, the limit is %d bytes",
+		idx+1, len(name), cfg.MaxNameLength,
+	)
+}
@@ -0,0 +1,333 @@
+package grpcsrv_test
+
+import (
+	"context"
+	"io"
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/io/grpcsrv"
+	pb "github.com/gnames/gnparser/io/grpcsrv/gnparserpb"
+	"github.com/gnames/gnparser/io/web"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func client(
+	t *testing.T,
+	cfg web.Config,
+	opts ...gnparser.Option,
+) *grpc.ClientConn {
+	lis := bufconn.Listen(1 << 20)
+	gnp := gnparser.New(gnparser.NewConfig(opts...))
+	s := grpcsrv.NewServer(gnp, cfg)
+	go func() { _ = s.Serve(lis) }()
+
+	conn, err := grpc.NewClient(
+		"passthrough:///bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return lis.Dial()
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.Nil(t, err)
+	t.Cleanup(func() {
+		conn.Close()
+		s.Stop()
+	})
+	return conn
+}
+
+func TestParseName(t *testing.T) {
+	assert := assert.New(t)
+	gnp := pb.NewGNparserClient(client(t, web.NewConfig()))
+	ctx := context.Background()
+
+	res, err := gnp.ParseName(ctx, &pb.ParseNameRequest{
+		Name: "Pleurosigma vitrea var. kjellmanii H.Peragallo, 1891",
+	})
+	assert.Nil(err)
+	assert.True(res.Parsed)
+	assert.Equal(int32(1), res.Quality)
+	assert.Equal(int32(3), res.Cardinality)
+	assert.Equal("Pleurosigma vitrea var. kjellmanii", res.Canonical.Full)
+	assert.Equal("H. Peragallo", res.Authorship.Authors[0])
+	assert.Nil(res.Details)
+	assert.Empty(res.Words)
+
+	res, err = gnp.ParseName(ctx, &pb.ParseNameRequest{
+		Name:    "Pleurosigma vitrea var. kjellmanii H.Peragallo, 1891",
+		Options: &pb.Options{WithDetails: true},
+	})
+	assert.Nil(err)
+	isp := res.Details.GetInfraspecies()
+	assert.NotNil(isp)
+	assert.Equal("Pleurosigma", isp.Species.Genus)
+	assert.Equal("kjellmanii", isp.Infraspecies[0].Value)
+	assert.Equal("var.", isp.Infraspecies[0].Rank)
+	assert.Equal("variety", isp.Infraspecies[0].RankInfo.Id)
+	assert.NotEmpty(isp.Infraspecies[0].RankInfo.Uri)
+	assert.Equal("1891", isp.Infraspecies[0].Authorship.Original.Year.Value)
+	assert.Equal("GENUS", res.Words[0].WordType)
+
+	res, err = gnp.ParseName(ctx, &pb.ParseNameRequest{
+		Name:    "Aus bus × Cus dus",
+		Options: &pb.Options{WithDetails: true},
+	})
+	assert.Nil(err)
+	assert.Equal("HYBRID_FORMULA", res.Hybrid)
+	assert.Len(res.Details.GetHybridFormula().Elements, 2)
+
+	res, err = gnp.ParseName(ctx, &pb.ParseNameRequest{
+		Name: "Bubo bubo L. 1758 #1",
+	})
+	assert.Nil(err)
+	assert.Equal(int32(4), res.Quality)
+	assert.Equal("Unparsed tail", res.QualityWarnings[0].Warning)
+	assert.Equal("TAIL_UNPARSED", res.QualityWarnings[0].Code)
+
+	_, err = gnp.ParseName(ctx, &pb.ParseNameRequest{
+		Name:    "Bubo bubo",
+		Options: &pb.Options{Code: "unknown"},
+	})
+	assert.Equal(codes.InvalidArgument, status.Code(err))
+}
+
+// TestParseNameConcurrent sends unary requests in parallel. Run it with
+// -race flag (`just test` does it).
+func TestParseNameConcurrent(t *testing.T) {
+	assert := assert.New(t)
+	gnp := pb.NewGNparserClient(client(t, web.NewConfig()))
+	ctx := context.Background()
+
+	names := []string{
+		"Aus bus L.", "Pleurosigma vitrea var. kjellmanii H.Peragallo, 1891",
+		"Bubo bubo (Linnaeus, 1758)", "Carex scirpoidea subsp. convoluta",
+	}
+	want := make([]string, len(names))
+	for i := range names {
+		res, err := gnp.ParseName(ctx, &pb.ParseNameRequest{Name: names[i]})
+		require.Nil(t, err)
+		want[i] = res.Canonical.Full
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan string, 16)
+	for i := range 8 {
+		wg.Go(func() {
+			for j := range 20 {
+				k := (i + j) % len(names)
+				res, err := gnp.ParseName(ctx, &pb.ParseNameRequest{Name: names[k]})
+				switch {
+				case err != nil:
+					errs <- err.Error()
+				case res.Canonical.Full != want[k]:
+					errs <- res.Canonical.Full
+				}
+			}
+		})
+	}
+	wg.Wait()
+	close(errs)
+	for e := range errs {
+		assert.Fail("unexpected result", e)
+	}
+}
+
+func TestParseNames(t *testing.T) {
+	assert := assert.New(t)
+	gnp := pb.NewGNparserClient(client(t, web.NewConfig(web.OptMaxNames(3))))
+	ctx := context.Background()
+
+	names := []string{"Homo sapiens", "Bubo bubo", "Pomatomus saltatrix"}
+	res, err := gnp.ParseNames(ctx, &pb.ParseNamesRequest{Names: names})
+	assert.Nil(err)
+	assert.Len(res.Results, 3)
+	for i := range names {
+		assert.Equal(names[i], res.Results[i].Verbatim)
+	}
+
+	res, err = gnp.ParseNames(ctx, &pb.ParseNamesRequest{
+		Names:   []string{"<i>Aus bus</i>"},
+		Options: &pb.Options{Capitalize: true},
+	})
+	assert.Nil(err)
+	assert.Equal("Aus bus", res.Results[0].Canonical.Simple)
+
+	_, err = gnp.ParseNames(ctx, &pb.ParseNamesRequest{
+		Names: append(names, "Aus bus"),
+	})
+	assert.Equal(codes.ResourceExhausted, status.Code(err))
+}
+
+func TestParseNameStream(t *testing.T) {
+	assert := assert.New(t)
+	gnp := pb.NewGNparserClient(client(t, web.NewConfig(), gnparser.OptJobsNum(4)))
+
+	stream, err := gnp.ParseNameStream(context.Background())
+	assert.Nil(err)
+
+	names := make([]string, 100)
+	for i := range names {
+		names[i] = "Aus bus"
+		if i%2 == 0 {
+			names[i] = "Cus dus L."
+		}
+	}
+	go func() {
+		for i := range names {
+			req := pb.ParseNameRequest{Name: names[i]}
+			if i == 0 {
+				req.Options = &pb.Options{WithDetails: true}
+			}
+			_ = stream.Send(&req)
+		}
+		_ = stream.CloseSend()
+	}()
+
+	var res []*pb.Parsed
+	for {
+		p, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		assert.Nil(err)
+		res = append(res, p)
+	}
+	assert.Len(res, len(names))
+	for i := range names {
+		assert.Equal(names[i], res[i].Verbatim)
+		assert.NotNil(res[i].Details)
+	}
+}
+
+func TestParseNameStreamLimit(t *testing.T) {
+	assert := assert.New(t)
+	gnp := pb.NewGNparserClient(client(t, web.NewConfig(web.OptMaxNameLength(10))))
+
+	stream, err := gnp.ParseNameStream(context.Background())
+	assert.Nil(err)
+	assert.Nil(stream.Send(&pb.ParseNameRequest{Name: "Aus bus"}))
+	assert.Nil(stream.Send(&pb.ParseNameRequest{Name: "Aus bus Linnaeus"}))
+	assert.Nil(stream.CloseSend())
+
+	for {
+		_, err = stream.Recv()
+		if err != nil {
+			break
+		}
+	}
+	assert.Equal(codes.InvalidArgument, status.Code(err))
+	assert.Contains(status.Convert(err).Message(), "name #2")
+}
+
+func TestHealth(t *testing.T) {
+	assert := assert.New(t)
+	hc := healthpb.NewHealthClient(client(t, web.NewConfig()))
+	res, err := hc.Check(context.Background(), &healthpb.HealthCheckRequest{})
+	assert.Nil(err)
+	assert.Equal(healthpb.HealthCheckResponse_SERVING, res.Status)
+}
+
+func TestVersion(t *testing.T) {
+	assert := assert.New(t)
+	gnp := pb.NewGNparserClient(client(t, web.NewConfig()))
+	res, err := gnp.Version(context.Background(), &pb.VersionRequest{})
+	assert.Nil(err)
+	assert.Equal(gnparser.Version, res.Version)
+}
+
+func TestParseNameOptions(t *testing.T) {
+	assert := assert.New(t)
+	gnp := pb.NewGNparserClient(client(t, web.NewConfig()))
+	ctx := context.Background()
+
+	name := "Aus bus Smith ex Jones"
+	res, err := gnp.ParseName(ctx, &pb.ParseNameRequest{Name: name})
+	assert.Nil(err)
+	assert.Equal(int32(2), res.Quality)
+	assert.Empty(res.QualityProfile)
+
+	res, err = gnp.ParseName(ctx, &pb.ParseNameRequest{
+		Name:    name,
+		Options: &pb.Options{QualityProfile: "botanical"},
+	})
+	assert.Nil(err)
+	assert.Equal(int32(1), res.Quality)
+	assert.Equal("botanical", res.QualityProfile)
+
+	_, err = gnp.ParseName(ctx, &pb.ParseNameRequest{
+		Name:    name,
+		Options: &pb.Options{QualityProfile: "unknown"},
+	})
+	assert.Equal(codes.InvalidArgument, status.Code(err))
+
+	res, err = gnp.ParseName(ctx, &pb.ParseNameRequest{
+		Name:    "Bubo bubo L. 1758 #1",
+		Options: &pb.Options{Lang: "es", WithDetails: true},
+	})
+	assert.Nil(err)
+	assert.Equal("TAIL_UNPARSED", res.QualityWarnings[0].Code)
+	assert.NotEmpty(res.QualityWarnings[0].Message)
+	assert.NotEqual(res.QualityWarnings[0].Warning, res.QualityWarnings[0].Message)
+	assert.NotEmpty(res.Words[0].Label)
+
+	_, err = gnp.ParseName(ctx, &pb.ParseNameRequest{
+		Name:    name,
+		Options: &pb.Options{Lang: "xx"},
+	})
+	assert.Equal(codes.InvalidArgument, status.Code(err))
+
+	res, err = gnp.ParseName(ctx, &pb.ParseNameRequest{
+		Name:    "Acer tiliaefolia L.",
+		Options: &pb.Options{Code: "botanical", WithSuggestions: true},
+	})
+	assert.Nil(err)
+	assert.Len(res.Suggestions, 1)
+	assert.Equal("Acer tiliifolia", res.Suggestions[0].Canonical)
+	assert.NotEmpty(res.Suggestions[0].Rule)
+
+	res, err = gnp.ParseName(ctx, &pb.ParseNameRequest{
+		Name:    "Quercus rubrum L.",
+		Options: &pb.Options{GenderAgreement: true},
+	})
+	assert.Nil(err)
+	assert.Equal(int32(2), res.Quality)
+	assert.Equal("GENDER_DISAGREEMENT", res.QualityWarnings[0].Code)
+	assert.Equal("rubra", res.QualityWarnings[0].Correction)
+
+	res, err = gnp.ParseName(ctx, &pb.ParseNameRequest{
+		Name:    "Aus bus var. cus L.",
+		Options: &pb.Options{WithHierarchy: true},
+	})
+	assert.Nil(err)
+	assert.Len(res.Hierarchy, 4)
+	assert.Equal("gen.", res.Hierarchy[0].Rank)
+	assert.Equal("Aus bus var. bus", res.Hierarchy[2].Canonical.Full)
+	assert.True(res.Hierarchy[2].IsAutonym)
+
+	res, err = gnp.ParseName(ctx, &pb.ParseNameRequest{
+		Name:    "Aus bus var. cus (L.) Mill.",
+		Options: &pb.Options{WithCodeInference: true},
+	})
+	assert.Nil(err)
+	assert.Equal("ICN", res.NomenclaturalCodeInferred.Code)
+	assert.Equal(0.87, res.NomenclaturalCodeInferred.Confidence)
+	assert.Len(res.NomenclaturalCodeInferred.Evidence, 4)
+
+	res, err = gnp.ParseName(ctx, &pb.ParseNameRequest{
+		Name:    "Felidae",
+		Options: &pb.Options{Code: "zoological", WithRankInference: true},
+	})
+	assert.Nil(err)
+	assert.Equal("fam.", res.Rank)
+	assert.True(res.RankInferred)
+}
@@ -0,0 +1,193 @@
+// Package jsonrpc provides a JSON-RPC 2.0 service for parsing scientific
+// names. Requests and responses are line-delimited JSON objects, which
+// allows to run the service over standard input and output of a
+// long-lived gnparser process.
+package jsonrpc
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+
+	"github.com/gnames/gnparser"
+)
+
+// Version is the version of JSON-RPC protocol.
+const Version = "2.0"
+
+// Error codes defined by JSON-RPC 2.0 specification.
+const (
+	ParseErrorCode     = -32700
+	InvalidRequestCode = -32600
+	MethodNotFoundCode = -32601
+	InvalidParamsCode  = -32602
+	InternalErrorCode  = -32603
+)
+
+// Request is a JSON-RPC request. Requests without ID are notifications,
+// they do not receive a response.
+type Request struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+// Response is a JSON-RPC response. It contains either Result or Error.
+type Response struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  any             `json:"result,omitempty"`
+	Error   *Error          `json:"error,omitempty"`
+}
+
+// Error is a JSON-RPC error object.
+type Error struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// Error implements error interface.
+func (e *Error) Error() string {
+	return e.Message
+}
+
+func newError(code int, msg string, args ...any) *Error {
+	return &Error{Code: code, Message: fmt.Sprintf(msg, args...)}
+}
+
+// Server keeps a GNparser session. Settings of the session can be
+// changed by 'changeConfig' method and are used by all following
+// requests.
+type Server struct {
+	gnp     gnparser.GNparser
+	opts    gnparser.RequestOptions
+	jobsNum int
+}
+
+// New creates a JSON-RPC server from GNparser settings. They are the
+// initial settings of the session.
+func New(cfg gnparser.Config) *Server {
+	return &Server{
+		gnp:     gnparser.New(cfg),
+		opts:    requestOptions(cfg),
+		jobsNum: cfg.JobsNum,
+	}
+}
+
+// Serve reads requests from r, one per line, and writes responses to w,
+// one per line. Requests are processed in the order they come. Serve
+// returns nil when r is exhausted, or an error if reading or writing
+// failed or the context is canceled.
+func (s *Server) Serve(ctx context.Context, r io.Reader, w io.Writer) error {
+	rd := bufio.NewReader(r)
+	bw := bufio.NewWriter(w)
+	enc := json.NewEncoder(bw)
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		line, err := rd.ReadBytes('\n')
+		if len(bytes.TrimSpace(line)) > 0 {
+			if res := s.handleLine(line); res != nil {
+				if err := enc.Encode(res); err != nil {
+					return err
+				}
+				if err := bw.Flush(); err != nil {
+					return err
+				}
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// handleLine processes one line of input, that contains either a request
+// or a batch of requests. It returns nil if there is nothing to send back.
+func (s *Server) handleLine(line []byte) any {
+	line = bytes.TrimSpace(line)
+	if line[0] != '[' {
+		if res := s.handleRaw(line); res != nil {
+			return res
+		}
+		return nil
+	}
+
+	var batch []json.RawMessage
+	if err := json.Unmarshal(line, &batch); err != nil {
+		return errorResponse(nil, newError(ParseErrorCode, "%s", err))
+	}
+	if len(batch) == 0 {
+		return errorResponse(nil, newError(InvalidRequestCode, "empty batch"))
+	}
+
+	var res []*Response
+	for i := range batch {
+		if r := s.handleRaw(batch[i]); r != nil {
+			res = append(res, r)
+		}
+	}
+	if len(res) == 0 {
+		return nil
+	}
+	return res
+}
+
+func (s *Server) handleRaw(raw []byte) *Response {
+	var req Request
+	if err := json.Unmarshal(raw, &req); err != nil {
+		var synErr *json.SyntaxError
+		if errors.As(err, &synErr) {
+			return errorResponse(nil, newError(ParseErrorCode, "%s", err))
+		}
+		return errorResponse(nil, newError(InvalidRequestCode, "%s", err))
+	}
+	if req.JSONRPC != Version || req.Method == "" {
+		return errorResponse(req.ID,
+			newError(InvalidRequestCode, "request must have jsonrpc '2.0' and method"),
+		)
+	}
+
+	result, err := s.safeCall(req.Method, req.Params)
+	if req.ID == nil {
+		return nil
+	}
+	if err != nil {
+		return errorResponse(req.ID, err)
+	}
+	return &Response{JSONRPC: Version, ID: req.ID, Result: result}
+}
+
+// safeCall runs a method and converts a panic to an internal error, so
+// one bad request does not stop a long-lived process.
+func (s *Server) safeCall(
+	method string,
+	params json.RawMessage,
+) (res any, err *Error) {
+	defer func() {
+		if r := recover(); r != nil {
+			slog.Error("JSON-RPC method failed", "method", method, "panic", r)
+			res, err = nil, newError(InternalErrorCode, "internal error: %v", r)
+		}
+	}()
+	return s.call(method, params)
+}
+
+func errorResponse(id json.RawMessage, err *Error) *Response {
+	if id == nil {
+		id = json.RawMessage("null")
+	}
+	return &Response{JSONRPC: Version, ID: id, Error: err}
+}
@@ -0,0 +1,177 @@
+package jsonrpc_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/gnames/gnparser/io/jsonrpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type response struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  json.RawMessage `json:"result"`
+	Error   *jsonrpc.Error  `json:"error"`
+}
+
+// serve sends requests to a new server, one per line, and returns
+// decoded responses.
+func serve(t *testing.T, reqs ...string) []response {
+	srv := jsonrpc.New(gnparser.NewConfig(gnparser.OptIsTest(true)))
+	in := strings.NewReader(strings.Join(reqs, "\n"))
+	var out bytes.Buffer
+	require.Nil(t, srv.Serve(context.Background(), in, &out))
+
+	var res []response
+	sc := bufio.NewScanner(&out)
+	for sc.Scan() {
+		var r response
+		require.Nil(t, json.Unmarshal(sc.Bytes(), &r))
+		res = append(res, r)
+	}
+	return res
+}
+
+func TestParse(t *testing.T) {
+	assert := assert.New(t)
+	res := serve(t,
+		`{"jsonrpc":"2.0","id":1,"method":"parse","params":{"name":"Bubo bubo L."}}`,
+		`{"jsonrpc":"2.0","id":2,"method":"parse",`+
+			`"params":{"name":"Bubo bubo L.","options":{"withDetails":true}}}`,
+	)
+	require.Len(t, res, 2)
+
+	var p parsed.Parsed
+	assert.Equal("2.0", res[0].JSONRPC)
+	assert.Equal("1", string(res[0].ID))
+	assert.Nil(json.Unmarshal(res[0].Result, &p))
+	assert.Equal("Bubo bubo", p.Canonical.Simple)
+	assert.Nil(p.Words)
+
+	var det map[string]any
+	assert.Nil(json.Unmarshal(res[1].Result, &det))
+	assert.Contains(det, "details")
+	assert.Contains(det, "words")
+}
+
+func TestParseBatch(t *testing.T) {
+	assert := assert.New(t)
+	res := serve(t,
+		`{"jsonrpc":"2.0","id":"a","method":"parseBatch",`+
+			`"params":{"names":["Aus bus","Cus dus","Eus fus"]}}`,
+	)
+	require.Len(t, res, 1)
+	assert.Equal(`"a"`, string(res[0].ID))
+
+	var ps []parsed.Parsed
+	assert.Nil(json.Unmarshal(res[0].Result, &ps))
+	assert.Len(ps, 3)
+	assert.Equal("Cus dus", ps[1].Verbatim)
+}
+
+func TestChangeConfig(t *testing.T) {
+	assert := assert.New(t)
+	res := serve(t,
+		`{"jsonrpc":"2.0","id":1,"method":"changeConfig",`+
+			`"params":{"withDetails":true,"flattenOutput":true,"jobsNum":3}}`,
+		`{"jsonrpc":"2.0","id":2,"method":"changeConfig","params":{"code":"bot"}}`,
+		`{"jsonrpc":"2.0","id":3,"method":"parse","params":{"name":"Aus bus"}}`,
+		`{"jsonrpc":"2.0","id":4,"method":"parse",`+
+			`"params":{"name":"Aus bus","options":{"flattenOutput":false}}}`,
+	)
+	require.Len(t, res, 4)
+
+	// settings that are not given keep their values
+	var cfg jsonrpc.ConfigParams
+	assert.Nil(json.Unmarshal(res[1].Result, &cfg))
+	assert.True(cfg.WithDetails)
+	assert.True(cfg.FlattenOutput)
+	assert.Equal("bot", cfg.Code)
+	assert.Equal(3, cfg.JobsNum)
+
+	var flat parsed.ParsedFlat
+	assert.Nil(json.Unmarshal(res[2].Result, &flat))
+	assert.Equal("Aus bus", flat.CanonicalSimple)
+	assert.Equal("ICN", flat.NomCodeSetting)
+
+	var p map[string]any
+	assert.Nil(json.Unmarshal(res[3].Result, &p))
+	assert.Contains(p, "canonical")
+	assert.Contains(p, "words")
+}
+
+func TestVersionDebug(t *testing.T) {
+	assert := assert.New(t)
+	res := serve(t,
+		`{"jsonrpc":"2.0","id":1,"method":"version"}`,
+		`{"jsonrpc":"2.0","id":2,"method":"debug","params":{"name":"Aus bus"}}`,
+	)
+	require.Len(t, res, 2)
+	assert.Contains(string(res[0].Result), "test_version")
+
+	var dbg string
+	assert.Nil(json.Unmarshal(res[1].Result, &dbg))
+	assert.Contains(dbg, "Output Syntax Tree")
+}
+
+func TestBatchNotification(t *testing.T) {
+	assert := assert.New(t)
+	srv := jsonrpc.New(gnparser.NewConfig())
+	in := strings.NewReader(
+		`{"jsonrpc":"2.0","method":"parse","params":{"name":"Aus bus"}}` + "\n" +
+			`[{"jsonrpc":"2.0","id":1,"method":"version"},` +
+			`{"jsonrpc":"2.0","method":"version"}]` + "\n",
+	)
+	var out bytes.Buffer
+	assert.Nil(srv.Serve(context.Background(), in, &out))
+
+	// notifications do not get responses
+	var res []response
+	assert.Nil(json.Unmarshal(out.Bytes(), &res))
+	assert.Len(res, 1)
+	assert.Equal("1", string(res[0].ID))
+}
+
+func TestErrors(t *testing.T) {
+	assert := assert.New(t)
+	res := serve(t,
+		`{"jsonrpc":"2.0","id":1,"method":"parse",`,
+		`{"jsonrpc":"1.0","id":2,"method":"parse"}`,
+		`{"jsonrpc":"2.0","id":3,"method":"parseAll"}`,
+		`{"jsonrpc":"2.0","id":4,"method":"parse","params":{"nam":"Aus bus"}}`,
+		`{"jsonrpc":"2.0","id":5,"method":"parse",`+
+			`"params":{"name":"Aus bus","options":{"format":"csv"}}}`,
+		`{"jsonrpc":"2.0","id":6,"method":"changeConfig","params":{"code":"xyz"}}`,
+		`{"jsonrpc":"2.0","id":7,"method":"parse",`+
+			`"params":{"name":"Aus bus","options":{"format":"pretty"}}}`,
+		`{"jsonrpc":"2.0","id":8,"method":"changeConfig",`+
+			`"params":{"format":"pretty"}}`,
+	)
+	require.Len(t, res, 8)
+
+	codes := []int{
+		jsonrpc.ParseErrorCode,
+		jsonrpc.InvalidRequestCode,
+		jsonrpc.MethodNotFoundCode,
+		jsonrpc.InvalidParamsCode,
+		jsonrpc.InvalidParamsCode,
+		jsonrpc.InvalidParamsCode,
+		jsonrpc.InvalidParamsCode,
+		jsonrpc.InvalidParamsCode,
+	}
+	for i := range codes {
+		require.NotNil(t, res[i].Error, i)
+		assert.Equal(codes[i], res[i].Error.Code, i)
+		assert.Nil(res[i].Result, i)
+	}
+	assert.Equal("null", string(res[0].ID))
+	assert.Equal("2", string(res[1].ID))
+}
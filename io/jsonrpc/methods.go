@@ -0,0 +1,174 @@
+package jsonrpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	"github.com/gnames/gnfmt"
+	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/ent/parsed"
+)
+
+// ParseParams are parameters of 'parse' and 'debug' methods.
+type ParseParams struct {
+	// Name is a name-string to parse.
+	Name string `json:"name"`
+
+	// Options override settings of the session for this request only.
+	// Options that are not given keep their session values.
+	Options *gnparser.RequestOptions `json:"options,omitempty"`
+}
+
+// ParseBatchParams are parameters of 'parseBatch' method.
+type ParseBatchParams struct {
+	// Names is a list of name-strings to parse.
+	Names []string `json:"names"`
+
+	// Options override settings of the session for this request only.
+	// Options that are not given keep their session values.
+	Options *gnparser.RequestOptions `json:"options,omitempty"`
+}
+
+// ConfigParams are parameters and the result of 'changeConfig' method.
+// Settings that are not given keep their current values.
+type ConfigParams struct {
+	gnparser.RequestOptions
+
+	// JobsNum is the number of concurrent jobs used by 'parseBatch'.
+	JobsNum int `json:"jobsNum,omitempty"`
+}
+
+// call runs a method with given parameters.
+func (s *Server) call(method string, params json.RawMessage) (any, *Error) {
+	switch method {
+	case "parse":
+		p := ParseParams{Options: s.sessionOptions()}
+		if err := decodeParams(params, &p); err != nil {
+			return nil, err
+		}
+		gnp, err := s.parser(p.Options)
+		if err != nil {
+			return nil, err
+		}
+		return output(gnp, gnp.ParseName(p.Name)), nil
+
+	case "parseBatch":
+		p := ParseBatchParams{Options: s.sessionOptions()}
+		if err := decodeParams(params, &p); err != nil {
+			return nil, err
+		}
+		gnp, err := s.parser(p.Options)
+		if err != nil {
+			return nil, err
+		}
+		res := gnp.ParseNames(p.Names)
+		out := make([]json.RawMessage, len(res))
+		for i := range res {
+			out[i] = output(gnp, res[i])
+		}
+		return out, nil
+
+	case "changeConfig":
+		p := ConfigParams{
+			RequestOptions: *s.sessionOptions(),
+			JobsNum:        s.jobsNum,
+		}
+		if err := decodeParams(params, &p); err != nil {
+			return nil, err
+		}
+		gnp, err := s.parser(&p.RequestOptions)
+		if err != nil {
+			return nil, err
+		}
+		if p.JobsNum > 0 {
+			gnp = gnp.ChangeConfig(gnparser.OptJobsNum(p.JobsNum))
+			s.jobsNum = p.JobsNum
+		}
+		s.gnp = gnp
+		s.opts = p.RequestOptions
+		return ConfigParams{
+			RequestOptions: s.opts,
+			JobsNum:        s.jobsNum,
+		}, nil
+
+	case "version":
+		return s.gnp.GetVersion(), nil
+
+	case "debug":
+		p := ParseParams{Options: s.sessionOptions()}
+		if err := decodeParams(params, &p); err != nil {
+			return nil, err
+		}
+		gnp, err := s.parser(p.Options)
+		if err != nil {
+			return nil, err
+		}
+		return string(gnp.Debug(p.Name)), nil
+	}
+	return nil, newError(MethodNotFoundCode, "method '%s' not found", method)
+}
+
+// sessionOptions returns a copy of the session options. Parameters are
+// decoded on top of it, so options that are not given in a request keep
+// their session values.
+func (s *Server) sessionOptions() *gnparser.RequestOptions {
+	res := s.opts
+	return &res
+}
+
+// parser returns a copy of the session GNparser with settings from the
+// given options.
+func (s *Server) parser(ro *gnparser.RequestOptions) (gnparser.GNparser, *Error) {
+	if ro == nil {
+		ro = s.sessionOptions()
+	}
+	// results are embedded into JSON-RPC responses that take one line each,
+	// so they cannot be pretty.
+	switch ro.Format {
+	case "", "json", "compact":
+	default:
+		return nil, newError(InvalidParamsCode,
+			"format '%s' is not supported, results are always compact JSON",
+			ro.Format)
+	}
+	if ro.CSV {
+		return nil, newError(InvalidParamsCode,
+			"csv is not supported, results are always JSON")
+	}
+
+	opts, err := ro.Options()
+	if err != nil {
+		return nil, newError(InvalidParamsCode, "%s", err)
+	}
+	return s.gnp.ChangeConfig(opts...), nil
+}
+
+// requestOptions returns session options that correspond to the initial
+// configuration. The output format is not used, results are always
+// encoded as JSON.
+func requestOptions(cfg gnparser.Config) gnparser.RequestOptions {
+	res := gnparser.NewRequestOptionsFromConfig(cfg)
+	res.Format = ""
+	return res
+}
+
+// output encodes a parsing result as compact JSON.
+func output(gnp gnparser.GNparser, p parsed.Parsed) json.RawMessage {
+	res := p.Output(gnfmt.CompactJSON, gnp.WithFlatOutput())
+	return json.RawMessage(strings.TrimSpace(res))
+}
+
+// decodeParams decodes JSON-RPC params to a structure. Unknown fields are
+// reported as errors.
+func decodeParams(params json.RawMessage, v any) *Error {
+	if len(params) == 0 {
+		return newError(InvalidParamsCode, "params are required")
+	}
+	dec := json.NewDecoder(bytes.NewReader(params))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil {
+		return newError(InvalidParamsCode, "cannot decode params: %s", err)
+	}
+	return nil
+}
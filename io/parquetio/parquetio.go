@@ -0,0 +1,283 @@
+// Package parquetio writes parsing results in Apache Parquet format.
+// Results are flattened (see parsed.ParsedFlat), columns keep their types:
+// flags are booleans, quality and cardinality are integers. If details are
+// requested, quality warnings and words of names are added as list columns.
+// Source file and line of name-strings can be added as the first columns.
+//
+// Rows are written in row groups, so memory stays bounded for inputs of any
+// size.
+package parquetio
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/parquet-go/parquet-go"
+)
+
+// DefaultRowGroupSize is the number of rows in a row group if it is not
+// set.
+const DefaultRowGroupSize = 50_000
+
+// Row is a flattened parsing result. Empty strings are saved as nulls.
+type Row struct {
+	Parsed                    bool   `parquet:"parsed"`
+	NomCodeSetting            string `parquet:"nomenclaturalCodeSetting,optional"`
+	ParseQuality              int32  `parquet:"quality"`
+	Verbatim                  string `parquet:"verbatim"`
+	Normalized                string `parquet:"normalized,optional"`
+	CanonicalSimple           string `parquet:"canonicalSimple,optional"`
+	CanonicalFull             string `parquet:"canonicalFull,optional"`
+	CanonicalStemmed          string `parquet:"canonicalStemmed,optional"`
+	Cardinality               int32  `parquet:"cardinality"`
+	Rank                      string `parquet:"rank,optional"`
+	RankInferred              bool   `parquet:"rankInferred"`
+	RankID                    string `parquet:"rankId,optional"`
+	RankLevel                 int32  `parquet:"rankLevel,optional"`
+	RankCode                  string `parquet:"rankCode,optional"`
+	RankURI                   string `parquet:"rankUri,optional"`
+	Authorship                string `parquet:"authorship,optional"`
+	Authors                   string `parquet:"authors,optional"`
+	Candidatus                bool   `parquet:"candidatus"`
+	Virus                     bool   `parquet:"virus"`
+	Cultivar                  bool   `parquet:"cultivar"`
+	DaggerChar                bool   `parquet:"daggerChar"`
+	Hybrid                    string `parquet:"hybrid,optional"`
+	GraftChimera              string `parquet:"graftchimera,optional"`
+	Surrogate                 string `parquet:"surrogate,optional"`
+	Tail                      string `parquet:"tail,optional"`
+	Uninomial                 string `parquet:"uninomial,optional"`
+	Genus                     string `parquet:"genus,optional"`
+	Subgenus                  string `parquet:"infragenericEpithet,optional"`
+	Species                   string `parquet:"specificEpithet,optional"`
+	Infraspecies              string `parquet:"infraspecificEpithet,optional"`
+	CultivarEpithet           string `parquet:"cultivarEpithet,optional"`
+	Notho                     string `parquet:"notho,optional"`
+	CombinationAuthorship     string `parquet:"combinationAuthorship,optional"`
+	CombinationExAuthorship   string `parquet:"combinationExAuthorship,optional"`
+	CombinationAuthorshipYear string `parquet:"combinationAuthorshipYear,optional"`
+	BasionymAuthorship        string `parquet:"basionymAuthorship,optional"`
+	BasionymExAuthorship      string `parquet:"basionymExAuthorship,optional"`
+	BasionymAuthorshipYear    string `parquet:"basionymAuthorshipYear,optional"`
+	VerbatimID                string `parquet:"id"`
+	ParserVersion             string `parquet:"parserVersion"`
+}
+
+// RowDetails is a flattened parsing result with quality warnings and words
+// of a name.
+type RowDetails struct {
+	Row
+	Warnings []Warning `parquet:"qualityWarnings,list"`
+	Words    []Word    `parquet:"words,list"`
+}
+
+// Source is the location of a name-string in input data.
+type Source struct {
+	File string
+	Line int
+}
+
+// SourceRow is a Row with the location of its name-string.
+type SourceRow struct {
+	SourceFile string `parquet:"sourceFile"`
+	SourceLine int32  `parquet:"sourceLine"`
+	Row
+}
+
+// SourceRowDetails is a RowDetails with the location of its name-string.
+type SourceRowDetails struct {
+	SourceFile string `parquet:"sourceFile"`
+	SourceLine int32  `parquet:"sourceLine"`
+	RowDetails
+}
+
+// Warning is a parsing problem.
+type Warning struct {
+	Quality int32  `parquet:"quality"`
+	Warning string `parquet:"warning"`
+	Code    string `parquet:"code"`
+}
+
+// Word is a semantic element of a name-string.
+type Word struct {
+	Verbatim   string `parquet:"verbatim"`
+	Normalized string `parquet:"normalized"`
+	Type       string `parquet:"wordType"`
+	Start      int32  `parquet:"start"`
+	End        int32  `parquet:"end"`
+}
+
+// Writer saves parsing results into Parquet file.
+type Writer struct {
+	write func(res []parsed.Parsed, srcs []Source) error
+	close func() error
+}
+
+// NewWriter creates a Writer that sends Parquet data to w. If withDetails
+// is true, rows include warnings and words. Results must be parsed with
+// details for words and detailed fields to be filled. If rowGroupSize is
+// not positive, DefaultRowGroupSize is used.
+func NewWriter(w io.Writer, withDetails bool, rowGroupSize int) *Writer {
+	opts := writerOptions(rowGroupSize)
+	if withDetails {
+		return newWriter(w, opts, func(p parsed.Parsed, _ Source) RowDetails {
+			return NewRowDetails(p)
+		})
+	}
+	return newWriter(w, opts, func(p parsed.Parsed, _ Source) Row {
+		return NewRow(p.Flatten())
+	})
+}
+
+// NewWriterWithSource creates a Writer like NewWriter, but rows start with
+// sourceFile and sourceLine columns. Results have to be saved with
+// WriteWithSource.
+func NewWriterWithSource(
+	w io.Writer,
+	withDetails bool,
+	rowGroupSize int,
+) *Writer {
+	opts := writerOptions(rowGroupSize)
+	if withDetails {
+		return newWriter(w, opts, func(p parsed.Parsed, src Source) SourceRowDetails {
+			return SourceRowDetails{
+				SourceFile: src.File,
+				SourceLine: int32(src.Line),
+				RowDetails: NewRowDetails(p),
+			}
+		})
+	}
+	return newWriter(w, opts, func(p parsed.Parsed, src Source) SourceRow {
+		return SourceRow{
+			SourceFile: src.File,
+			SourceLine: int32(src.Line),
+			Row:        NewRow(p.Flatten()),
+		}
+	})
+}
+
+func writerOptions(rowGroupSize int) []parquet.WriterOption {
+	if rowGroupSize <= 0 {
+		rowGroupSize = DefaultRowGroupSize
+	}
+	return []parquet.WriterOption{
+		parquet.Compression(&parquet.Snappy),
+		parquet.MaxRowsPerRowGroup(int64(rowGroupSize)),
+		parquet.CreatedBy("gnparser", gnparser.Version, gnparser.Build),
+	}
+}
+
+func newWriter[T any](
+	w io.Writer,
+	opts []parquet.WriterOption,
+	toRow func(parsed.Parsed, Source) T,
+) *Writer {
+	pw := parquet.NewGenericWriter[T](w, opts...)
+	write := func(res []parsed.Parsed, srcs []Source) error {
+		rows := make([]T, len(res))
+		for i := range res {
+			var src Source
+			if i < len(srcs) {
+				src = srcs[i]
+			}
+			rows[i] = toRow(res[i], src)
+		}
+		_, err := pw.Write(rows)
+		return err
+	}
+	return &Writer{write: write, close: pw.Close}
+}
+
+// Write adds parsing results to the file.
+func (w *Writer) Write(res ...parsed.Parsed) error {
+	return w.WriteWithSource(res, nil)
+}
+
+// WriteWithSource adds parsing results and locations of their
+// name-strings to the file. Locations are ignored by writers created with
+// NewWriter.
+func (w *Writer) WriteWithSource(res []parsed.Parsed, srcs []Source) error {
+	if err := w.write(res, srcs); err != nil {
+		return fmt.Errorf("cannot write parquet rows: %w", err)
+	}
+	return nil
+}
+
+// Close writes remaining rows and the footer of the file. It does not
+// close the underlying writer.
+func (w *Writer) Close() error {
+	if err := w.close(); err != nil {
+		return fmt.Errorf("cannot close parquet file: %w", err)
+	}
+	return nil
+}
+
+// NewRow converts a flattened result into a Row.
+func NewRow(p parsed.ParsedFlat) Row {
+	return Row{
+		Parsed:                    p.Parsed,
+		NomCodeSetting:            p.NomCodeSetting,
+		ParseQuality:              int32(p.ParseQuality),
+		Verbatim:                  p.Verbatim,
+		Normalized:                p.Normalized,
+		CanonicalSimple:           p.CanonicalSimple,
+		CanonicalFull:             p.CanonicalFull,
+		CanonicalStemmed:          p.CanonicalStemmed,
+		Cardinality:               int32(p.Cardinality),
+		Rank:                      p.Rank,
+		RankInferred:              p.RankInferred,
+		RankID:                    p.RankID,
+		RankLevel:                 int32(p.RankLevel),
+		RankCode:                  p.RankCode,
+		RankURI:                   p.RankURI,
+		Authorship:                p.Authorship,
+		Authors:                   p.Authors,
+		Candidatus:                p.Candidatus,
+		Virus:                     p.Virus,
+		Cultivar:                  p.Cultivar,
+		DaggerChar:                p.DaggerChar,
+		Hybrid:                    p.Hybrid,
+		GraftChimera:              p.GraftChimera,
+		Surrogate:                 p.Surrogate,
+		Tail:                      p.Tail,
+		Uninomial:                 p.Uninomial,
+		Genus:                     p.Genus,
+		Subgenus:                  p.Subgenus,
+		Species:                   p.Species,
+		Infraspecies:              p.Infraspecies,
+		CultivarEpithet:           p.CultivarEpithet,
+		Notho:                     p.Notho,
+		CombinationAuthorship:     p.CombinationAuthorship,
+		CombinationExAuthorship:   p.CombinationExAuthorship,
+		CombinationAuthorshipYear: p.CombinationAuthorshipYear,
+		BasionymAuthorship:        p.BasionymAuthorship,
+		BasionymExAuthorship:      p.BasionymExAuthorship,
+		BasionymAuthorshipYear:    p.BasionymAuthorshipYear,
+		VerbatimID:                p.VerbatimID,
+		ParserVersion:             p.ParserVersion,
+	}
+}
+
+// NewRowDetails converts a parsing result into a RowDetails.
+func NewRowDetails(p parsed.Parsed) RowDetails {
+	res := RowDetails{Row: NewRow(p.Flatten())}
+	for _, v := range p.QualityWarnings {
+		res.Warnings = append(res.Warnings, Warning{
+			Quality: int32(v.Quality),
+			Warning: v.Warning.String(),
+			Code:    v.Code,
+		})
+	}
+	for _, v := range p.Words {
+		res.Words = append(res.Words, Word{
+			Verbatim:   v.Verbatim,
+			Normalized: v.Normalized,
+			Type:       v.Type.String(),
+			Start:      int32(v.Start),
+			End:        int32(v.End),
+		})
+	}
+	return res
+}
@@ -0,0 +1,133 @@
+package parquetio_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/io/parquetio"
+	"github.com/parquet-go/parquet-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var names = []string{
+	"Bubo bubo (Linnaeus, 1758)",
+	"Pleurosigma vitrea var. kjellmanii H.Peragallo, 1891",
+	"Aus bus L. 1758 #1",
+	"Not a name 1234",
+}
+
+func write(t *testing.T, withDetails bool, rowGroupSize int) *bytes.Reader {
+	cfg := gnparser.NewConfig(gnparser.OptWithDetails(withDetails))
+	gnp := gnparser.New(cfg)
+
+	var buf bytes.Buffer
+	w := parquetio.NewWriter(&buf, withDetails, rowGroupSize)
+	for _, n := range names {
+		require.Nil(t, w.Write(gnp.ParseName(n)))
+	}
+	require.Nil(t, w.Close())
+	return bytes.NewReader(buf.Bytes())
+}
+
+func TestWrite(t *testing.T) {
+	assert := assert.New(t)
+	r := write(t, false, 0)
+
+	rows, err := parquet.Read[parquetio.Row](r, r.Size())
+	require.Nil(t, err)
+	require.Len(t, rows, len(names))
+
+	assert.True(rows[0].Parsed)
+	assert.Equal("Bubo bubo", rows[0].CanonicalSimple)
+	assert.Equal(int32(2), rows[0].Cardinality)
+	assert.Equal(int32(1), rows[0].ParseQuality)
+	assert.Equal("Linnaeus", rows[0].Authors)
+	assert.Equal(int32(3), rows[1].Cardinality)
+	assert.Equal(int32(4), rows[2].ParseQuality)
+	assert.False(rows[3].Parsed)
+
+	f, err := parquet.OpenFile(r, r.Size())
+	require.Nil(t, err)
+	schema := f.Schema()
+	for _, v := range []struct {
+		col  string
+		kind parquet.Kind
+	}{
+		{"parsed", parquet.Boolean},
+		{"quality", parquet.Int32},
+		{"cardinality", parquet.Int32},
+		{"canonicalSimple", parquet.ByteArray},
+	} {
+		col, ok := schema.Lookup(v.col)
+		require.True(t, ok, v.col)
+		assert.Equal(v.kind, col.Node.Type().Kind(), v.col)
+	}
+	_, ok := schema.Lookup("words", "list", "element", "verbatim")
+	assert.False(ok)
+
+	// empty strings are nulls
+	col, _ := schema.Lookup("canonicalSimple")
+	assert.True(col.Node.Optional())
+}
+
+func TestWriteDetails(t *testing.T) {
+	assert := assert.New(t)
+	r := write(t, true, 0)
+
+	rows, err := parquet.Read[parquetio.RowDetails](r, r.Size())
+	require.Nil(t, err)
+	require.Len(t, rows, len(names))
+
+	assert.Equal("Bubo", rows[0].Genus)
+	assert.Equal("bubo", rows[0].Species)
+	assert.Equal("Linnaeus", rows[0].BasionymAuthorship)
+	assert.Len(rows[0].Words, 4)
+	assert.Equal("GENUS", rows[0].Words[0].Type)
+	assert.Equal(int32(5), rows[0].Words[1].Start)
+	assert.Len(rows[0].Warnings, 0)
+
+	assert.Equal("var.", rows[1].Rank)
+	assert.False(rows[1].RankInferred)
+	assert.Equal("variety", rows[1].RankID)
+	assert.Equal(int32(85), rows[1].RankLevel)
+	assert.Equal("ICN", rows[1].RankCode)
+	assert.Equal("kjellmanii", rows[1].Infraspecies)
+
+	require.Len(t, rows[2].Warnings, 1)
+	assert.Equal(int32(4), rows[2].Warnings[0].Quality)
+	assert.Equal("Unparsed tail", rows[2].Warnings[0].Warning)
+}
+
+func TestRowGroups(t *testing.T) {
+	r := write(t, true, 2)
+	f, err := parquet.OpenFile(r, r.Size())
+	require.Nil(t, err)
+	assert.Len(t, f.RowGroups(), 2)
+	assert.Equal(t, int64(len(names)), f.NumRows())
+}
+
+func TestWriteWithSource(t *testing.T) {
+	assert := assert.New(t)
+	gnp := gnparser.New(gnparser.NewConfig())
+
+	var buf bytes.Buffer
+	w := parquetio.NewWriterWithSource(&buf, false, 0)
+	res := gnp.ParseNames(names[:2])
+	srcs := []parquetio.Source{{File: "a.txt", Line: 1}, {File: "b.txt", Line: 7}}
+	require.Nil(t, w.WriteWithSource(res, srcs))
+	require.Nil(t, w.Close())
+
+	r := bytes.NewReader(buf.Bytes())
+	rows, err := parquet.Read[parquetio.SourceRow](r, r.Size())
+	require.Nil(t, err)
+	require.Len(t, rows, 2)
+	assert.Equal("b.txt", rows[1].SourceFile)
+	assert.Equal(int32(7), rows[1].SourceLine)
+	assert.Equal("Pleurosigma vitrea kjellmanii", rows[1].CanonicalSimple)
+
+	f, err := parquet.OpenFile(r, r.Size())
+	require.Nil(t, err)
+	assert.Equal("sourceFile", f.Schema().Fields()[0].Name())
+}
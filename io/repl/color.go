@@ -0,0 +1,64 @@
+package repl
+
+import "github.com/gnames/gnparser/ent/parsed"
+
+// ANSI escape sequences of colors.
+const (
+	colorReset   = "\x1B[0m"
+	colorBold    = "\x1B[1m"
+	colorRed     = "\x1B[31m"
+	colorGreen   = "\x1B[32m"
+	colorYellow  = "\x1B[33m"
+	colorBlue    = "\x1B[34m"
+	colorMagenta = "\x1B[35m"
+	colorCyan    = "\x1B[36m"
+	colorGray    = "\x1B[90m"
+	colorError   = colorBold + colorRed
+)
+
+// paint colors a string if colors are on.
+func (r *REPL) paint(color, s string) string {
+	if !r.color || color == "" {
+		return s
+	}
+	return color + s + colorReset
+}
+
+// wordColor returns the color of a word type.
+func wordColor(wt parsed.WordType) string {
+	switch wt {
+	case parsed.GenusType, parsed.UninomialType, parsed.SubgenusType,
+		parsed.GenusIcvcnType:
+		return colorBold + colorBlue
+	case parsed.SpEpithetType, parsed.InfraspEpithetType,
+		parsed.SpeciesIcvcnType:
+		return colorGreen
+	case parsed.RankType:
+		return colorMagenta
+	case parsed.AuthorWordType, parsed.AuthorWordFiliusType:
+		return colorCyan
+	case parsed.YearType, parsed.YearApproximateType:
+		return colorYellow
+	case parsed.HybridCharType, parsed.GraftChimeraCharType,
+		parsed.ComparisonMarkerType, parsed.ApproxMarkerType,
+		parsed.CandidatusType, parsed.CultivarType:
+		return colorBold + colorMagenta
+	default:
+		return colorGray
+	}
+}
+
+// qualityColor returns the color of a parsing quality. Quality 1 is the
+// best, 4 is the worst, 0 means the name-string is not parsed.
+func qualityColor(q int) string {
+	switch q {
+	case 1:
+		return colorGreen
+	case 2:
+		return colorYellow
+	case 3:
+		return colorRed
+	default:
+		return colorError
+	}
+}
@@ -0,0 +1,436 @@
+// Package repl provides an interactive session for parsing scientific
+// names. Every line of input is either a name-string, that is parsed and
+// shown with colorized words and highlighted warnings, or a command that
+// starts with ':' and changes settings of the session.
+package repl
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/gnames/gnfmt"
+	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/ent/parsed"
+)
+
+// Prompt is shown before every line of input.
+const Prompt = "gnparser> "
+
+// LineReader reads lines of user input. It is implemented by terminals
+// with line editing (for example golang.org/x/term.Terminal) and by
+// NewLineReader for other inputs.
+type LineReader interface {
+	// ReadLine returns the next line without the line end, or io.EOF when
+	// the input is finished.
+	ReadLine() (string, error)
+}
+
+// NewLineReader creates a LineReader for input without line editing,
+// such as a pipe or a file.
+func NewLineReader(r io.Reader) LineReader {
+	return &scanReader{sc: bufio.NewScanner(r)}
+}
+
+type scanReader struct {
+	sc *bufio.Scanner
+}
+
+// ReadLine implements LineReader interface.
+func (s *scanReader) ReadLine() (string, error) {
+	if s.sc.Scan() {
+		return s.sc.Text(), nil
+	}
+	if err := s.sc.Err(); err != nil {
+		return "", err
+	}
+	return "", io.EOF
+}
+
+// REPL keeps settings of an interactive session. Commands change the
+// settings through GNparser.ChangeConfig, they are used for all following
+// names.
+type REPL struct {
+	gnp  gnparser.GNparser
+	cfg  gnparser.Config
+	opts gnparser.RequestOptions
+
+	// format is one of formats.
+	format string
+
+	// color is true if output uses ANSI colors.
+	color bool
+
+	// last is the last parsed name-string, it is used by ':debug'
+	// without arguments.
+	last string
+}
+
+// New creates a REPL session from GNparser settings. They are the initial
+// settings of the session. If color is true, output uses ANSI colors.
+func New(cfg gnparser.Config, color bool) *REPL {
+	opts := gnparser.NewRequestOptionsFromConfig(cfg)
+	format := "pretty"
+	switch {
+	case opts.FlattenOutput:
+		format = "flat"
+	case opts.Format == "compact":
+		format = "compact"
+	}
+	// the format of results is kept by the session.
+	opts.Format = ""
+	opts.FlattenOutput = false
+	return &REPL{
+		gnp:    gnparser.New(cfg),
+		cfg:    cfg,
+		opts:   opts,
+		format: format,
+		color:  color,
+	}
+}
+
+// errQuit stops the session.
+var errQuit = errors.New("quit")
+
+// Run reads lines from lr and writes results to w until the input is
+// finished or ':quit' command is given.
+func (r *REPL) Run(lr LineReader, w io.Writer) error {
+	for {
+		line, err := lr.ReadLine()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		err = r.Eval(line, w)
+		if errors.Is(err, errQuit) {
+			return nil
+		}
+		if err != nil {
+			fmt.Fprintln(w, r.paint(colorError, "Error: "+err.Error()))
+		}
+	}
+}
+
+// Eval processes one line of input: it runs a command, or parses
+// a name-string.
+func (r *REPL) Eval(line string, w io.Writer) error {
+	line = strings.TrimSpace(line)
+	switch {
+	case line == "":
+		return nil
+	case strings.HasPrefix(line, ":"):
+		cmd, arg, _ := strings.Cut(line[1:], " ")
+		return r.command(cmd, strings.TrimSpace(arg), w)
+	default:
+		r.last = line
+		r.show(line, w)
+		return nil
+	}
+}
+
+// aliases are short names of request parameters.
+var aliases = map[string]string{
+	"details":  "with_details",
+	"tags":     "ignore_tags",
+	"authors":  "compact_authors",
+	"spgr":     "species_group_cut",
+	"profile":  "quality_profile",
+	"language": "lang",
+	"gender":   "gender_agreement",
+	"infer":    "infer_code",
+	"ranks":    "infer_rank",
+}
+
+const help = `Type a name-string to parse it, or a command:
+
+  :code CODE         nomenclatural code: bact, bot, cult, vir, zoo or any
+  :details on|off    show details and words
+  :profile NAME      quality profile: default, bacterial, botanical,
+                     cultivars or zoological
+  :lang LANG         language of warnings: en, es, pt, fr or de
+  :format FORMAT     output: pretty, flat, compact, csv, tsv or none
+  :capitalize on|off capitalize the first letter of names
+  :diaereses on|off  preserve diaereses in canonical forms
+  :authors on|off    remove spaces between initials of authors
+  :tags on|off       do not remove HTML tags and entities
+  :spgr on|off       cut species group names to species in stemmed form
+  :suggestions on|off
+                     suggest orthographic corrections of names
+  :gender on|off     check gender agreement of epithets with genus
+  :hierarchy on|off  show genus, species and autonyms implied by names
+  :infer on|off      infer nomenclatural codes of names
+  :ranks on|off      infer ranks of uninomials from their endings
+  :color on|off      use colors
+  :debug [NAME]      show syntax trees of the name or of the last name
+  :settings          show current settings
+  :help              show this help
+  :quit              finish the session`
+
+func (r *REPL) command(cmd, arg string, w io.Writer) error {
+	switch cmd {
+	case "q", "quit", "exit":
+		return errQuit
+	case "h", "help", "?":
+		fmt.Fprintln(w, help)
+		return nil
+	case "settings":
+		r.showSettings(w)
+		return nil
+	case "debug":
+		name := arg
+		if name == "" {
+			name = r.last
+		}
+		if name == "" {
+			return errors.New("no name to debug")
+		}
+		r.debug(name, w)
+		return nil
+	case "color":
+		b, err := onOff(arg)
+		if err != nil {
+			return err
+		}
+		r.color = b
+		return nil
+	case "format":
+		return r.setFormat(arg)
+	}
+
+	key := cmd
+	if v, ok := aliases[key]; ok {
+		key = v
+	}
+	if key == "code" && arg == "" {
+		arg = "any"
+	}
+	_, err := gnparser.NewRequestOptions(url.Values{key: nil})
+	if err != nil || key == "format" || key == "csv" || key == "flatten" {
+		return fmt.Errorf("unknown command ':%s', type ':help' for help", cmd)
+	}
+
+	vals := r.opts.Values()
+	vals.Set(key, arg)
+	ro, err := gnparser.NewRequestOptions(vals)
+	if err != nil {
+		return err
+	}
+	opts, err := ro.Options()
+	if err != nil {
+		return err
+	}
+	if key != "quality_profile" {
+		// keeps a profile loaded from a file, it has no name in ro.
+		opts = append(opts, gnparser.OptQualityProfile(r.cfg.QualityProfile))
+	}
+	for _, opt := range opts {
+		opt(&r.cfg)
+	}
+	r.gnp = r.gnp.ChangeConfig(opts...)
+	r.opts = ro
+	return nil
+}
+
+// formats are output formats of the session. 'flat' is pretty JSON with
+// flattened results, 'none' shows only the colorized summary.
+var formats = []string{"pretty", "flat", "compact", "csv", "tsv", "none"}
+
+func (r *REPL) setFormat(f string) error {
+	if !slices.Contains(formats, f) {
+		return fmt.Errorf(
+			"unknown format '%s', use %s", f, strings.Join(formats, ", "),
+		)
+	}
+	r.format = f
+	return nil
+}
+
+func (r *REPL) showSettings(w io.Writer) {
+	ro := r.opts
+	code := ro.Code
+	if code == "" {
+		code = "any"
+	}
+	profile := r.cfg.QualityProfile.Name
+	if profile == "" {
+		profile = parsed.DefaultQualityProfile
+	}
+	lang := r.cfg.Language
+	if lang == "" {
+		lang = parsed.DefaultLanguage
+	}
+	settings := []struct {
+		name  string
+		value any
+	}{
+		{"code", code},
+		{"profile", profile},
+		{"lang", lang},
+		{"format", r.format},
+		{"details", ro.WithDetails},
+		{"capitalize", ro.Capitalize},
+		{"diaereses", ro.PreserveDiaereses},
+		{"authors", ro.CompactAuthors},
+		{"tags", ro.IgnoreHTMLTags},
+		{"spgr", ro.SpeciesGroupCut},
+		{"suggestions", ro.WithSuggestions},
+		{"gender", ro.GenderAgreement},
+		{"hierarchy", ro.WithHierarchy},
+		{"infer", ro.WithCodeInference},
+		{"ranks", ro.WithRankInference},
+		{"color", r.color},
+	}
+	for _, s := range settings {
+		fmt.Fprintf(w, "  %-11s %v\n", s.name, s.value)
+	}
+}
+
+// show parses a name-string and writes its summary and results in the
+// format of the session.
+func (r *REPL) show(name string, w io.Writer) {
+	// words are needed for colors even if details are not shown.
+	p := r.gnp.ChangeConfig(gnparser.OptWithDetails(true)).ParseName(name)
+
+	fmt.Fprintln(w, r.words(p))
+	if len(p.Words) > 0 {
+		types := make([]string, len(p.Words))
+		for i, v := range p.Words {
+			label := v.Type.String()
+			if v.Label != "" {
+				label = v.Label
+			}
+			types[i] = r.paint(wordColor(v.Type), label)
+		}
+		fmt.Fprintln(w, "  "+strings.Join(types, " "))
+	}
+	lang := r.cfg.Language
+	quality := fmt.Sprintf("  quality: %d", p.ParseQuality)
+	fmt.Fprintln(w, r.paint(qualityColor(p.ParseQuality), quality))
+	for _, v := range p.QualityWarnings {
+		msg := v.Warning.Message(lang)
+		if v.Correction != "" {
+			msg += ": " + v.Correction
+		}
+		msg = fmt.Sprintf("  ! %s (%d)", msg, v.Quality)
+		fmt.Fprintln(w, r.paint(qualityColor(v.Quality), msg))
+	}
+	for _, v := range p.Suggestions {
+		msg := fmt.Sprintf("  > %s (%s, %.1f)", v.Canonical, v.Reference,
+			v.Confidence)
+		fmt.Fprintln(w, r.paint(colorCyan, msg))
+	}
+	for _, v := range p.Hierarchy {
+		msg := "  ^ " + v.Canonical.Full
+		if v.Authorship != nil {
+			msg += " " + v.Authorship.Normalized
+		}
+		if v.IsAutonym {
+			msg += " (autonym)"
+		}
+		fmt.Fprintln(w, r.paint(colorGray, msg))
+	}
+	if ci := p.NomCodeInferred; ci != nil {
+		clues := make([]string, len(ci.Evidence))
+		for i, v := range ci.Evidence {
+			clues[i] = v.Clue + ":" + v.Code
+		}
+		msg := fmt.Sprintf("  = %s (%.2f) %s", ci.Code, ci.Confidence,
+			strings.Join(clues, ", "))
+		fmt.Fprintln(w, r.paint(colorCyan, msg))
+	}
+
+	if !r.opts.WithDetails {
+		p.Details = nil
+		p.Words = nil
+	}
+	var f gnfmt.Format
+	switch r.format {
+	case "none":
+		return
+	case "csv":
+		f = gnfmt.CSV
+	case "tsv":
+		f = gnfmt.TSV
+	case "compact":
+		f = gnfmt.CompactJSON
+	default:
+		f = gnfmt.PrettyJSON
+	}
+	if f == gnfmt.CSV || f == gnfmt.TSV {
+		fmt.Fprintln(w, parsed.HeaderCSV(f, r.opts.WithDetails))
+	}
+	fmt.Fprintln(w, strings.TrimSpace(p.Output(f, r.format == "flat")))
+}
+
+// debug writes complete and output syntax trees of a name-string.
+func (r *REPL) debug(name string, w io.Writer) {
+	st := r.gnp.DebugTree(name)
+	if st.NoParse {
+		fmt.Fprintln(w, r.paint(colorError, "Preprocessing: NO PARSE"))
+		return
+	}
+	trees := []struct {
+		title string
+		node  *parsed.SyntaxNode
+	}{
+		{"Complete Syntax Tree", st.Complete},
+		{"Output Syntax Tree", st.Output},
+	}
+	for _, t := range trees {
+		if t.node == nil {
+			continue
+		}
+		fmt.Fprintln(w, r.paint(colorBold, "*** "+t.title+" ***"))
+		r.syntaxNode(*t.node, 0, w)
+	}
+}
+
+func (r *REPL) syntaxNode(n parsed.SyntaxNode, depth int, w io.Writer) {
+	fmt.Fprintf(w, "%s%s %q %s\n",
+		strings.Repeat(" ", depth),
+		r.paint(colorCyan, n.Rule),
+		n.Text,
+		r.paint(colorGray, fmt.Sprintf("[%d:%d]", n.Start, n.End)),
+	)
+	for _, c := range n.Children {
+		r.syntaxNode(c, depth+1, w)
+	}
+}
+
+// words returns the verbatim name-string with its words colored by their
+// types. Words are found in order, so parts of the name-string that
+// are not words (like HTML tags) are kept as they are.
+func (r *REPL) words(p parsed.Parsed) string {
+	if !r.color || len(p.Words) == 0 {
+		return p.Verbatim
+	}
+	var b strings.Builder
+	rest := p.Verbatim
+	for _, v := range p.Words {
+		idx := strings.Index(rest, v.Verbatim)
+		if idx < 0 {
+			continue
+		}
+		b.WriteString(rest[:idx])
+		b.WriteString(r.paint(wordColor(v.Type), v.Verbatim))
+		rest = rest[idx+len(v.Verbatim):]
+	}
+	b.WriteString(rest)
+	return b.String()
+}
+
+func onOff(s string) (bool, error) {
+	switch strings.ToLower(s) {
+	case "on", "true", "yes", "1":
+		return true, nil
+	case "off", "false", "no", "0":
+		return false, nil
+	}
+	return false, fmt.Errorf("use 'on' or 'off' instead of '%s'", s)
+}
@@ -0,0 +1,99 @@
+package repl_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/io/repl"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// run sends lines to a new session and returns its output.
+func run(t *testing.T, color bool, lines ...string) string {
+	r := repl.New(gnparser.NewConfig(gnparser.OptIsTest(true)), color)
+	var out bytes.Buffer
+	in := repl.NewLineReader(strings.NewReader(strings.Join(lines, "\n")))
+	require.Nil(t, r.Run(in, &out))
+	return out.String()
+}
+
+func TestParse(t *testing.T) {
+	assert := assert.New(t)
+	out := run(t, false, "Bubo bubo (L.) 1758")
+	assert.Contains(out, "GENUS SPECIES AUTHOR_WORD YEAR")
+	assert.Contains(out, "quality: 3")
+	assert.Contains(out, "! Misplaced basionym year (3)")
+	assert.Contains(out, `"simple": "Bubo bubo"`)
+	assert.NotContains(out, `"details"`)
+	assert.NotContains(out, "\x1B[")
+
+	out = run(t, true, "Bubo bubo <i>L.</i>")
+	assert.Contains(out, "\x1B[1m\x1B[34mBubo\x1B[0m \x1B[32mbubo\x1B[0m <i>\x1B[36mL.\x1B[0m</i>")
+}
+
+func TestCommands(t *testing.T) {
+	assert := assert.New(t)
+	tests := []struct {
+		msg    string
+		lines  []string
+		has    string
+		hasNot string
+	}{
+		{"details", []string{":details on", "Bubo bubo"}, `"details"`, ""},
+		{"format flat", []string{":format flat", "Bubo bubo"},
+			`"canonicalSimple": "Bubo bubo"`, `"canonical": {`},
+		{"format csv", []string{":format csv", "Bubo bubo"},
+			"Id,Verbatim,", ""},
+		{"format none", []string{":format none", "Bubo bubo"},
+			"quality: 1", `"parsed"`},
+		{"code", []string{":code bot", "Aus (Bus) cus"},
+			`"nomenclaturalCodeSetting": "ICN"`, ""},
+		{"code reset", []string{":code bot", ":code", "Aus (Bus) cus"},
+			"", "nomenclaturalCodeSetting"},
+		{"profile", []string{":profile zoo", "Aus bus Smith ex Jones"},
+			`"qualityProfile": "zoological"`, ""},
+		{"profile kept", []string{":profile bot", ":details on", ":settings"},
+			"profile     botanical", ""},
+		{"lang", []string{":lang es", "Aus bus Smith ex Jones"},
+			"! Los autores `ex` no son necesarios", ""},
+		{"lang settings", []string{":lang pt-BR", ":settings"},
+			"lang        pt", ""},
+		{"suggestions", []string{":suggestions on", "Acer tiliaefolia L."},
+			"> Acer tiliifolia (ICN Art. 60.10, 0.7)", ""},
+		{"hierarchy", []string{":hierarchy on", "Aus bus L. var. cus Smith"},
+			"^ Aus bus var. bus (autonym)", ""},
+		{"infer", []string{":infer on", "Bubo bubo (Linnaeus, 1758)"},
+			"= ICZN (0.60) YEAR_COMMA:ICZN", ""},
+		{"ranks", []string{":ranks on", "Pinaceae"},
+			`"rankInferred": true`, ""},
+		{"gender", []string{":gender on", "Quercus rubrum L."},
+			"! Epithet does not agree in gender with genus: rubra (2)", ""},
+		{"capitalize", []string{":capitalize on", "bubo bubo"},
+			`"simple": "Bubo bubo"`, ""},
+		{"settings", []string{":authors on", ":settings"},
+			"authors     true", ""},
+		{"debug last", []string{"Bubo bubo", ":debug"},
+			`SpeciesEpithet "bubo" [5:9]`, ""},
+		{"debug name", []string{":debug Aus bus"},
+			"*** Output Syntax Tree ***", ""},
+		{"unknown", []string{":colour on"},
+			"Error: unknown command ':colour'", ""},
+		{"bad value", []string{":details maybe"},
+			"Error: parameter 'with_details'", ""},
+		{"bad format", []string{":format xml"},
+			"Error: unknown format 'xml'", ""},
+		{"quit", []string{":quit", "Bubo bubo"}, "", "Bubo bubo"},
+	}
+	for _, v := range tests {
+		out := run(t, false, v.lines...)
+		if v.has != "" {
+			assert.Contains(out, v.has, v.msg)
+		}
+		if v.hasNot != "" {
+			assert.NotContains(out, v.hasNot, v.msg)
+		}
+	}
+}
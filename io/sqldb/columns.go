@@ -0,0 +1,90 @@
+package sqldb
+
+import (
+	"reflect"
+	"strings"
+	"unicode"
+
+	"github.com/gnames/gnparser/ent/parsed"
+)
+
+// column is a column of the target table.
+type column struct {
+	name    string
+	sqlType string
+}
+
+// columnNames are names of columns that are not the snake case of JSON
+// names of parsed.ParsedFlat fields.
+var columnNames = map[string]string{
+	"graftchimera": "graft_chimera",
+	"id":           "verbatim_id",
+}
+
+// sqlTypes are SQL types of kinds of parsed.ParsedFlat fields.
+var sqlTypes = map[reflect.Kind]string{
+	reflect.Bool:   "BOOLEAN",
+	reflect.Int:    "INTEGER",
+	reflect.String: "TEXT",
+}
+
+// columns of the target table follow fields of parsed.ParsedFlat, so new
+// fields get their columns without changes here.
+var columns = flatColumns()
+
+func flatColumns() []column {
+	t := reflect.TypeFor[parsed.ParsedFlat]()
+	res := make([]column, t.NumField())
+	for i := range res {
+		f := t.Field(i)
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if n, ok := columnNames[name]; ok {
+			name = n
+		} else {
+			name = snakeCase(name)
+		}
+		sqlType, ok := sqlTypes[f.Type.Kind()]
+		if !ok {
+			panic("no SQL type for ParsedFlat." + f.Name)
+		}
+		res[i] = column{name: name, sqlType: sqlType}
+	}
+	return res
+}
+
+// values returns fields of a flattened result in the order of columns.
+// Empty strings are saved as NULL.
+func values(p parsed.ParsedFlat) []any {
+	v := reflect.ValueOf(p)
+	res := make([]any, v.NumField())
+	for i := range res {
+		f := v.Field(i)
+		if f.Kind() == reflect.String {
+			res[i] = null(f.String())
+			continue
+		}
+		res[i] = f.Interface()
+	}
+	return res
+}
+
+func null(s string) any {
+	if s == "" {
+		return nil
+	}
+	return s
+}
+
+// snakeCase converts a camel case name to snake case ('daggerChar' to
+// 'dagger_char').
+func snakeCase(s string) string {
+	var sb strings.Builder
+	for _, r := range s {
+		if unicode.IsUpper(r) {
+			sb.WriteByte('_')
+			r = unicode.ToLower(r)
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
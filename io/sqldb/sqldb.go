@@ -0,0 +1,455 @@
+// Package sqldb parses name-strings stored in a SQL database and saves
+// flattened results back into the database. PostgreSQL and SQLite are
+// supported.
+//
+// Results are saved into a target table that uses the primary key of the
+// source table as its own primary key. Rows are read in batches ordered by
+// the key, and every batch is saved in one transaction. Because of that
+// parsing can be stopped at any moment, and the next run continues after
+// the last saved row. Saving the same row again replaces its results, so
+// running the import several times is safe.
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/ent/nameidx"
+	"github.com/gnames/gnparser/ent/parsed"
+
+	// database drivers
+	_ "github.com/lib/pq"
+	_ "modernc.org/sqlite"
+)
+
+// Supported drivers.
+const (
+	Postgres = "postgres"
+	SQLite   = "sqlite"
+)
+
+// Options describe where name-strings come from, and where parsing
+// results go.
+type Options struct {
+	// Driver is either "postgres" or "sqlite". If it is empty, the driver
+	// is detected from DSN.
+	Driver string
+
+	// DSN is a connection string of PostgreSQL database, or a path to
+	// SQLite database file.
+	DSN string
+
+	// Table is the source table with name-strings. It is ignored if Query
+	// is given.
+	Table string
+
+	// Query is an SQL query that returns rows with the IDColumn and
+	// NameColumn columns.
+	Query string
+
+	// IDColumn is the primary key of the source rows. It has to be unique,
+	// and rows are processed in its order. The default is "id".
+	IDColumn string
+
+	// NameColumn contains name-strings. The default is "name".
+	NameColumn string
+
+	// Target is the table for parsing results. It is created if it does not
+	// exist. The default is Table with "_parsed" suffix.
+	Target string
+
+	// BatchSize is the number of rows read and saved at once.
+	BatchSize int
+
+	// Reparse makes parser to process all rows instead of continuing after
+	// the last saved one.
+	Reparse bool
+
+	// Quiet disables progress logs.
+	Quiet bool
+}
+
+// DB is a connection to a database with name-strings.
+type DB struct {
+	db   *sql.DB
+	opts Options
+}
+
+// Open connects to a database and checks options.
+func Open(opts Options) (*DB, error) {
+	var err error
+	if opts, err = normalize(opts); err != nil {
+		return nil, err
+	}
+
+	dsn := opts.DSN
+	if opts.Driver == SQLite {
+		dsn = strings.TrimPrefix(dsn, "sqlite://")
+	}
+	db, err := sql.Open(opts.Driver, dsn)
+	if err != nil {
+		return nil, fmt.Errorf("cannot open database: %w", err)
+	}
+	if opts.Driver == SQLite {
+		// SQLite allows only one writer, one connection avoids
+		// 'database is locked' errors when reading and writing at once.
+		db.SetMaxOpenConns(1)
+	}
+	if err = db.Ping(); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("cannot connect to database: %w", err)
+	}
+	return &DB{db: db, opts: opts}, nil
+}
+
+// Close closes the database connection.
+func (d *DB) Close() error {
+	return d.db.Close()
+}
+
+// Parse reads name-strings from the source, parses them with
+// ParseNameStream and saves flattened results into the target table.
+// It returns the number of rows saved during this run.
+func (d *DB) Parse(ctx context.Context, gnp gnparser.GNparser) (int, error) {
+	// flattened results need details, and results must follow the order
+	// of rows.
+	gnp = gnp.ChangeConfig(
+		gnparser.OptWithDetails(true),
+		gnparser.OptWithNoOrder(false),
+	)
+
+	idType, err := d.idType(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if err = d.createTarget(ctx, idType); err != nil {
+		return 0, err
+	}
+
+	var last any
+	if !d.opts.Reparse {
+		if last, err = d.lastID(ctx); err != nil {
+			return 0, err
+		}
+		if last != nil && !d.opts.Quiet {
+			slog.Info("Continuing after the last saved row", "id", last)
+		}
+	}
+
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	chIn := make(chan nameidx.NameIdx)
+	chOut := make(chan parsed.Parsed)
+	chIDs := make(chan []any, 2)
+
+	go func() {
+		defer close(chIn)
+		defer close(chIDs)
+		if err := d.read(ctx, last, chIDs, chIn); err != nil {
+			cancel(err)
+		}
+	}()
+
+	go gnp.ParseNameStream(ctx, chIn, chOut)
+
+	count, err := d.write(ctx, chIDs, chOut)
+	if err != nil {
+		return count, err
+	}
+	return count, context.Cause(ctx)
+}
+
+// read sends IDs of every batch to chIDs, and then its name-strings to
+// chIn.
+func (d *DB) read(
+	ctx context.Context,
+	last any,
+	chIDs chan<- []any,
+	chIn chan<- nameidx.NameIdx,
+) error {
+	var idx int
+	for {
+		ids, names, err := d.batch(ctx, last)
+		if err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case chIDs <- ids:
+		}
+		for i := range names {
+			select {
+			case <-ctx.Done():
+				return nil
+			case chIn <- nameidx.NameIdx{Index: idx, NameString: names[i]}:
+			}
+			idx++
+		}
+
+		if len(ids) < d.opts.BatchSize {
+			return nil
+		}
+		last = ids[len(ids)-1]
+	}
+}
+
+// batch reads the next batch of rows after the last ID.
+func (d *DB) batch(ctx context.Context, last any) ([]any, []string, error) {
+	id, name := quote(d.opts.IDColumn), quote(d.opts.NameColumn)
+	q := fmt.Sprintf("SELECT src.%s, src.%s FROM %s AS src", id, name, d.source())
+	var args []any
+	if last != nil {
+		q += fmt.Sprintf(" WHERE src.%s > %s", id, d.placeholder(1))
+		args = append(args, last)
+	}
+	q += fmt.Sprintf(" ORDER BY src.%s LIMIT %d", id, d.opts.BatchSize)
+
+	rows, err := d.db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot read names: %w", err)
+	}
+	defer rows.Close()
+
+	ids := make([]any, 0, d.opts.BatchSize)
+	names := make([]string, 0, d.opts.BatchSize)
+	for rows.Next() {
+		var id any
+		var name sql.NullString
+		if err = rows.Scan(&id, &name); err != nil {
+			return nil, nil, fmt.Errorf("cannot read names: %w", err)
+		}
+		if id == nil {
+			return nil, nil, fmt.Errorf("column %s contains NULL", d.opts.IDColumn)
+		}
+		ids = append(ids, keyValue(id))
+		names = append(names, name.String)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, nil, fmt.Errorf("cannot read names: %w", err)
+	}
+	return ids, names, nil
+}
+
+// write saves parsing results of every batch in one transaction.
+func (d *DB) write(
+	ctx context.Context,
+	chIDs <-chan []any,
+	chOut <-chan parsed.Parsed,
+) (int, error) {
+	var count int
+	start := time.Now()
+	for ids := range chIDs {
+		res := make([]parsed.ParsedFlat, 0, len(ids))
+		for range ids {
+			select {
+			case <-ctx.Done():
+				return count, context.Cause(ctx)
+			case p := <-chOut:
+				res = append(res, p.Flatten())
+			}
+		}
+		if err := d.save(ctx, ids, res); err != nil {
+			return count, err
+		}
+		count += len(ids)
+		if !d.opts.Quiet {
+			progressLog(start, count)
+		}
+	}
+	return count, nil
+}
+
+func (d *DB) save(ctx context.Context, ids []any, res []parsed.ParsedFlat) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("cannot save results: %w", err)
+	}
+	defer tx.Rollback()
+
+	stmt, err := tx.PrepareContext(ctx, d.upsert())
+	if err != nil {
+		return fmt.Errorf("cannot save results: %w", err)
+	}
+	defer stmt.Close()
+
+	for i := range ids {
+		args := append([]any{ids[i]}, values(res[i])...)
+		if _, err = stmt.ExecContext(ctx, args...); err != nil {
+			return fmt.Errorf("cannot save results for %v: %w", ids[i], err)
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("cannot save results: %w", err)
+	}
+	return nil
+}
+
+// idType returns the type of the source key, so the target table can use
+// the same type.
+func (d *DB) idType(ctx context.Context) (string, error) {
+	q := fmt.Sprintf(
+		"SELECT src.%s FROM %s AS src LIMIT 0",
+		quote(d.opts.IDColumn), d.source(),
+	)
+	rows, err := d.db.QueryContext(ctx, q)
+	if err != nil {
+		return "", fmt.Errorf("cannot read source: %w", err)
+	}
+	defer rows.Close()
+
+	cols, err := rows.ColumnTypes()
+	if err != nil {
+		return "", fmt.Errorf("cannot read source: %w", err)
+	}
+	res := cols[0].DatabaseTypeName()
+	// SQLite does not always know the type, its untyped columns keep values
+	// as they are.
+	if res == "" && d.opts.Driver == Postgres {
+		res = "TEXT"
+	}
+	return res, nil
+}
+
+func (d *DB) createTarget(ctx context.Context, idType string) error {
+	cols := make([]string, len(columns)+1)
+	cols[0] = fmt.Sprintf("%s %s PRIMARY KEY", quote(d.opts.IDColumn), idType)
+	for i, c := range columns {
+		cols[i+1] = c.name + " " + c.sqlType
+	}
+	q := fmt.Sprintf(
+		"CREATE TABLE IF NOT EXISTS %s (\n  %s\n)",
+		quote(d.opts.Target), strings.Join(cols, ",\n  "),
+	)
+	if _, err := d.db.ExecContext(ctx, q); err != nil {
+		return fmt.Errorf("cannot create table %s: %w", d.opts.Target, err)
+	}
+	return nil
+}
+
+func (d *DB) lastID(ctx context.Context) (any, error) {
+	q := fmt.Sprintf(
+		"SELECT MAX(%s) FROM %s", quote(d.opts.IDColumn), quote(d.opts.Target),
+	)
+	var res any
+	if err := d.db.QueryRowContext(ctx, q).Scan(&res); err != nil {
+		return nil, fmt.Errorf("cannot find last saved row: %w", err)
+	}
+	return keyValue(res), nil
+}
+
+// upsert returns a statement that inserts a result, or replaces it if the
+// row was parsed before.
+func (d *DB) upsert() string {
+	names := make([]string, len(columns)+1)
+	params := make([]string, len(columns)+1)
+	updates := make([]string, len(columns))
+	names[0], params[0] = quote(d.opts.IDColumn), d.placeholder(1)
+	for i, c := range columns {
+		names[i+1] = c.name
+		params[i+1] = d.placeholder(i + 2)
+		updates[i] = c.name + " = excluded." + c.name
+	}
+	return fmt.Sprintf(
+		"INSERT INTO %s (%s) VALUES (%s) ON CONFLICT (%s) DO UPDATE SET %s",
+		quote(d.opts.Target),
+		strings.Join(names, ", "),
+		strings.Join(params, ", "),
+		quote(d.opts.IDColumn),
+		strings.Join(updates, ", "),
+	)
+}
+
+func (d *DB) source() string {
+	if d.opts.Query != "" {
+		return "(" + d.opts.Query + ")"
+	}
+	return quote(d.opts.Table)
+}
+
+func (d *DB) placeholder(i int) string {
+	if d.opts.Driver == Postgres {
+		return fmt.Sprintf("$%d", i)
+	}
+	return "?"
+}
+
+func normalize(opts Options) (Options, error) {
+	if opts.DSN == "" {
+		return opts, errors.New("database connection string is required")
+	}
+	switch strings.ToLower(opts.Driver) {
+	case "":
+		opts.Driver = SQLite
+		if strings.HasPrefix(opts.DSN, "postgres://") ||
+			strings.HasPrefix(opts.DSN, "postgresql://") {
+			opts.Driver = Postgres
+		}
+	case "postgres", "postgresql", "pg":
+		opts.Driver = Postgres
+	case "sqlite", "sqlite3":
+		opts.Driver = SQLite
+	default:
+		return opts, fmt.Errorf("unknown database driver '%s'", opts.Driver)
+	}
+
+	if opts.Table == "" && opts.Query == "" {
+		return opts, errors.New("source table or query is required")
+	}
+	if opts.Target == "" {
+		if opts.Query != "" {
+			return opts, errors.New("target table is required for a query")
+		}
+		opts.Target = opts.Table + "_parsed"
+	}
+	if opts.IDColumn == "" {
+		opts.IDColumn = "id"
+	}
+	if opts.NameColumn == "" {
+		opts.NameColumn = "name"
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = 50_000
+	}
+	return opts, nil
+}
+
+// quote quotes an identifier, parts of qualified names are quoted
+// separately.
+func quote(ident string) string {
+	parts := strings.Split(ident, ".")
+	for i := range parts {
+		parts[i] = `"` + strings.ReplaceAll(parts[i], `"`, `""`) + `"`
+	}
+	return strings.Join(parts, ".")
+}
+
+// keyValue converts bytes returned by drivers into a string, so they can
+// be used as query parameters.
+func keyValue(v any) any {
+	if b, ok := v.([]byte); ok {
+		return string(b)
+	}
+	return v
+}
+
+func progressLog(start time.Time, count int) {
+	dur := float64(time.Since(start)) / float64(time.Second)
+	rate := float64(count) / dur
+	slog.Info("Database parsing",
+		"names/sec", humanize.Comma(int64(rate)),
+		"count", humanize.Comma(int64(count)),
+	)
+}
@@ -0,0 +1,254 @@
+package sqldb_test
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/gnames/gnparser/io/sqldb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var names = []string{
+	"Bubo bubo (Linnaeus, 1758)",
+	"Pleurosigma vitrea var. kjellmanii H.Peragallo, 1891",
+	"Asteraceae",
+	"Aus bus L. 1758 #1",
+	"Not a name 1234",
+}
+
+// sqliteDB creates SQLite database with a names table.
+func sqliteDB(t *testing.T) (string, *sql.DB) {
+	path := filepath.Join(t.TempDir(), "names.sqlite")
+	db, err := sql.Open("sqlite", path)
+	require.Nil(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	_, err = db.Exec(`CREATE TABLE names (id INTEGER PRIMARY KEY, name TEXT)`)
+	require.Nil(t, err)
+	for i, n := range names {
+		_, err = db.Exec(`INSERT INTO names (id, name) VALUES (?, ?)`, i+1, n)
+		require.Nil(t, err)
+	}
+	return path, db
+}
+
+func parseDB(t *testing.T, opts sqldb.Options) int {
+	opts.Quiet = true
+	d, err := sqldb.Open(opts)
+	require.Nil(t, err)
+	defer d.Close()
+
+	gnp := gnparser.New(gnparser.NewConfig(gnparser.OptJobsNum(2)))
+	count, err := d.Parse(context.Background(), gnp)
+	require.Nil(t, err)
+	return count
+}
+
+func TestParseSQLite(t *testing.T) {
+	assert := assert.New(t)
+	path, db := sqliteDB(t)
+
+	count := parseDB(t, sqldb.Options{DSN: path, Table: "names", BatchSize: 2})
+	assert.Equal(len(names), count)
+
+	var rowsNum int
+	err := db.QueryRow(`SELECT count(*) FROM names_parsed`).Scan(&rowsNum)
+	assert.Nil(err)
+	assert.Equal(len(names), rowsNum)
+
+	tests := []struct {
+		id                 int
+		verbatim, can, rnk string
+		quality, card      int
+		authors, genus     sql.NullString
+	}{
+		{1, names[0], "Bubo bubo", "sp.", 1, 2,
+			sql.NullString{String: "Linnaeus", Valid: true},
+			sql.NullString{String: "Bubo", Valid: true}},
+		{2, names[1], "Pleurosigma vitrea kjellmanii", "var.", 1, 3,
+			sql.NullString{String: "H. Peragallo", Valid: true},
+			sql.NullString{String: "Pleurosigma", Valid: true}},
+		{3, names[2], "Asteraceae", "", 1, 1,
+			sql.NullString{}, sql.NullString{}},
+		{4, names[3], "Aus bus", "sp.", 4, 2,
+			sql.NullString{String: "L.", Valid: true},
+			sql.NullString{String: "Aus", Valid: true}},
+	}
+	for _, v := range tests {
+		var verbatim, can string
+		var rnk, authors, genus sql.NullString
+		var quality, card int
+		var isParsed bool
+		err = db.QueryRow(`
+SELECT parsed, verbatim, canonical_simple, rank, quality, cardinality,
+  authors, genus
+FROM names_parsed WHERE id = ?`, v.id).Scan(
+			&isParsed, &verbatim, &can, &rnk, &quality, &card, &authors, &genus,
+		)
+		assert.Nil(err)
+		assert.True(isParsed, v.verbatim)
+		assert.Equal(v.verbatim, verbatim)
+		assert.Equal(v.can, can, v.verbatim)
+		assert.Equal(v.rnk, rnk.String, v.verbatim)
+		assert.Equal(v.quality, quality, v.verbatim)
+		assert.Equal(v.card, card, v.verbatim)
+		assert.Equal(v.authors, authors, v.verbatim)
+		assert.Equal(v.genus, genus, v.verbatim)
+	}
+
+	var isParsed bool
+	var can sql.NullString
+	err = db.QueryRow(
+		`SELECT parsed, canonical_simple FROM names_parsed WHERE id = 5`,
+	).Scan(&isParsed, &can)
+	assert.Nil(err)
+	assert.False(isParsed)
+	assert.False(can.Valid)
+}
+
+// TestColumnsSQLite checks that every field of ParsedFlat has a column.
+func TestColumnsSQLite(t *testing.T) {
+	assert := assert.New(t)
+	path, db := sqliteDB(t)
+	parseDB(t, sqldb.Options{DSN: path, Table: "names"})
+
+	rows, err := db.Query(`SELECT * FROM names_parsed LIMIT 0`)
+	assert.Nil(err)
+	defer rows.Close()
+	cols, err := rows.Columns()
+	assert.Nil(err)
+	// the first column is the key of a source row.
+	fieldsNum := reflect.TypeFor[parsed.ParsedFlat]().NumField()
+	assert.Equal(fieldsNum+1, len(cols))
+	assert.Contains(cols, "quality_profile")
+	assert.Contains(cols, "graft_chimera")
+	assert.Contains(cols, "verbatim_id")
+}
+
+func TestResumeSQLite(t *testing.T) {
+	assert := assert.New(t)
+	path, db := sqliteDB(t)
+	opts := sqldb.Options{DSN: path, Table: "names", BatchSize: 2}
+
+	count := parseDB(t, opts)
+	assert.Equal(len(names), count)
+
+	// nothing to do, all rows are saved already
+	count = parseDB(t, opts)
+	assert.Equal(0, count)
+
+	// only new rows are parsed
+	for i := range 3 {
+		_, err := db.Exec(
+			`INSERT INTO names (id, name) VALUES (?, ?)`,
+			len(names)+i+1, fmt.Sprintf("Aus bus%d", i),
+		)
+		assert.Nil(err)
+	}
+	count = parseDB(t, opts)
+	assert.Equal(3, count)
+
+	// reparse replaces existing results
+	opts.Reparse = true
+	count = parseDB(t, opts)
+	assert.Equal(len(names)+3, count)
+
+	var rowsNum int
+	err := db.QueryRow(`SELECT count(*) FROM names_parsed`).Scan(&rowsNum)
+	assert.Nil(err)
+	assert.Equal(len(names)+3, rowsNum)
+}
+
+func TestQuerySQLite(t *testing.T) {
+	assert := assert.New(t)
+	path, db := sqliteDB(t)
+	opts := sqldb.Options{
+		DSN:        "sqlite://" + path,
+		Query:      `SELECT id AS name_id, name AS name_string FROM names WHERE id > 3`,
+		IDColumn:   "name_id",
+		NameColumn: "name_string",
+		Target:     "parsed",
+	}
+
+	count := parseDB(t, opts)
+	assert.Equal(2, count)
+
+	var id int
+	var verbatim string
+	err := db.QueryRow(
+		`SELECT name_id, verbatim FROM parsed ORDER BY name_id LIMIT 1`,
+	).Scan(&id, &verbatim)
+	assert.Nil(err)
+	assert.Equal(4, id)
+	assert.Equal(names[3], verbatim)
+}
+
+func TestOptionsErrors(t *testing.T) {
+	assert := assert.New(t)
+	tests := []struct {
+		msg  string
+		opts sqldb.Options
+		err  string
+	}{
+		{"no dsn", sqldb.Options{Table: "names"}, "connection string"},
+		{"driver", sqldb.Options{DSN: "x", Driver: "oracle", Table: "t"},
+			"unknown database driver"},
+		{"no source", sqldb.Options{DSN: "x"}, "source table or query"},
+		{"no target", sqldb.Options{DSN: "x", Query: "SELECT 1"},
+			"target table is required"},
+	}
+	for _, v := range tests {
+		_, err := sqldb.Open(v.opts)
+		assert.ErrorContains(err, v.err, v.msg)
+	}
+}
+
+// TestParsePostgres runs only if GNPARSER_TEST_POSTGRES contains
+// a connection string to a PostgreSQL test database.
+func TestParsePostgres(t *testing.T) {
+	dsn := os.Getenv("GNPARSER_TEST_POSTGRES")
+	if dsn == "" {
+		t.Skip("GNPARSER_TEST_POSTGRES is not set")
+	}
+	assert := assert.New(t)
+	db, err := sql.Open("postgres", dsn)
+	require.Nil(t, err)
+	defer db.Close()
+
+	_, err = db.Exec(`
+DROP TABLE IF EXISTS gnparser_test_names_parsed;
+DROP TABLE IF EXISTS gnparser_test_names;
+CREATE TABLE gnparser_test_names (id BIGINT PRIMARY KEY, name TEXT)`)
+	require.Nil(t, err)
+	defer db.Exec(`
+DROP TABLE IF EXISTS gnparser_test_names_parsed;
+DROP TABLE IF EXISTS gnparser_test_names`)
+
+	for i, n := range names {
+		_, err = db.Exec(
+			`INSERT INTO gnparser_test_names (id, name) VALUES ($1, $2)`, i+1, n,
+		)
+		require.Nil(t, err)
+	}
+
+	opts := sqldb.Options{
+		Driver: "postgres", DSN: dsn, Table: "gnparser_test_names", BatchSize: 2,
+	}
+	assert.Equal(len(names), parseDB(t, opts))
+	assert.Equal(0, parseDB(t, opts))
+
+	var can string
+	err = db.QueryRow(
+		`SELECT canonical_simple FROM gnparser_test_names_parsed WHERE id = 1`,
+	).Scan(&can)
+	assert.Nil(err)
+	assert.Equal("Bubo bubo", can)
+}
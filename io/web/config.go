@@ -0,0 +1,124 @@
+package web
+
+import (
+	"log/slog"
+
+	"github.com/gnames/gnparser"
+)
+
+// Config contains limits of the web service. gRPC service applies the same
+// limits to its requests. Parsing settings are not here, they come from
+// GNparser and from options of requests.
+type Config struct {
+	// MaxBodySize is the maximum size of a request body in bytes. For gRPC
+	// service it limits the size of a received message.
+	MaxBodySize int64
+
+	// MaxNameLength is the maximum length of a name-string in bytes.
+	MaxNameLength int
+
+	// MaxNames is the maximum number of name-strings in one request.
+	MaxNames int
+
+	// RateBurst is the number of requests a client can send at once before
+	// RateLimit applies. If it is not set, it is equal to RateLimit rounded
+	// up.
+	RateBurst int
+
+	// RateLimit is the number of requests per second a client (identified
+	// by the IP address of its connection, X-Forwarded-For is ignored) can
+	// send. Zero value turns the rate limiting off.
+	RateLimit float64
+}
+
+// Option is a type of all options for Config.
+type Option func(*Config)
+
+// OptMaxBodySize sets the maximum size of a request body in bytes.
+func OptMaxBodySize(i int64) Option {
+	return func(cfg *Config) {
+		if i <= 0 {
+			slog.Warn("Maximum body size should be a positive number")
+			return
+		}
+		cfg.MaxBodySize = i
+	}
+}
+
+// OptMaxNameLength sets the maximum length of a name-string in bytes.
+func OptMaxNameLength(i int) Option {
+	return func(cfg *Config) {
+		if i <= 0 {
+			slog.Warn("Maximum name length should be a positive number")
+			return
+		}
+		cfg.MaxNameLength = i
+	}
+}
+
+// OptMaxNames sets the maximum number of names in a request.
+func OptMaxNames(i int) Option {
+	return func(cfg *Config) {
+		if i <= 0 {
+			slog.Warn("Maximum number of names should be a positive number")
+			return
+		}
+		cfg.MaxNames = i
+	}
+}
+
+// OptRateBurst sets the number of requests a client can send at once.
+func OptRateBurst(i int) Option {
+	return func(cfg *Config) {
+		cfg.RateBurst = i
+	}
+}
+
+// OptRateLimit sets the number of requests per second a client can
+// send. Zero turns the rate limiting off.
+func OptRateLimit(f float64) Option {
+	return func(cfg *Config) {
+		if f < 0 {
+			slog.Warn("Rate limit cannot be negative")
+			return
+		}
+		cfg.RateLimit = f
+	}
+}
+
+// NewConfig creates limits of the web service. Without options the size
+// of a body, the length and the number of names are limited, the rate of
+// requests is not.
+func NewConfig(opts ...Option) Config {
+	cfg := Config{
+		MaxBodySize:   10 << 20,
+		MaxNameLength: 1_000,
+		MaxNames:      10_000,
+	}
+	for i := range opts {
+		opts[i](&cfg)
+	}
+	return cfg
+}
+
+// SettingsOptions converts limits from gnparser settings (a config file or
+// environment variables) to options of Config.
+func SettingsOptions(s gnparser.Settings) []Option {
+	var res []Option
+	if s.WebMaxBodySize != nil {
+		res = append(res, OptMaxBodySize(*s.WebMaxBodySize))
+	}
+	if s.WebMaxNameLength != nil {
+		res = append(res, OptMaxNameLength(*s.WebMaxNameLength))
+	}
+	if s.WebMaxNames != nil {
+		res = append(res, OptMaxNames(*s.WebMaxNames))
+	}
+	if s.WebRateBurst != nil {
+		res = append(res, OptRateBurst(*s.WebRateBurst))
+	}
+	if s.WebRateLimit != nil {
+		res = append(res, OptRateLimit(*s.WebRateLimit))
+	}
+	return res
+}
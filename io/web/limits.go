@@ -0,0 +1,188 @@
+package web
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"golang.org/x/time/rate"
+)
+
+// ErrorType is a machine-readable type of an error returned by the
+// web-service.
+type ErrorType string
+
+const (
+	// BadRequestErr means that request options or body cannot be used.
+	BadRequestErr ErrorType = "BAD_REQUEST"
+
+	// NotFoundErr means that the requested resource does not exist.
+	NotFoundErr ErrorType = "NOT_FOUND"
+
+	// BodyTooLargeErr means that the request body is larger than allowed.
+	BodyTooLargeErr ErrorType = "BODY_TOO_LARGE"
+
+	// TooManyNamesErr means that the request has more names than allowed.
+	TooManyNamesErr ErrorType = "TOO_MANY_NAMES"
+
+	// NameTooLongErr means that one of the names is longer than allowed.
+	NameTooLongErr ErrorType = "NAME_TOO_LONG"
+
+	// RateLimitErr means that the client sent too many requests.
+	RateLimitErr ErrorType = "RATE_LIMIT_EXCEEDED"
+)
+
+// APIError is the JSON body of error responses of the web-service.
+type APIError struct {
+	// Status is the HTTP status code of the response.
+	Status int `json:"status"`
+
+	// Type is a machine-readable type of the error.
+	Type ErrorType `json:"error"`
+
+	// Message is a human-readable description of the error.
+	Message string `json:"message"`
+
+	// Limit is the value of the limit that was exceeded, if any.
+	Limit int64 `json:"limit,omitempty"`
+}
+
+// newAPIError creates an echo.HTTPError that is rendered as APIError
+// JSON object.
+func newAPIError(
+	status int,
+	errType ErrorType,
+	limit int64,
+	msg string,
+	args ...any,
+) *echo.HTTPError {
+	apiErr := APIError{
+		Status:  status,
+		Type:    errType,
+		Message: fmt.Sprintf(msg, args...),
+		Limit:   limit,
+	}
+	return echo.NewHTTPError(status, apiErr)
+}
+
+// badRequest creates an APIError for invalid requests.
+func badRequest(err error) *echo.HTTPError {
+	return newAPIError(http.StatusBadRequest, BadRequestErr, 0, "%s", err)
+}
+
+// bodyLimit returns a middleware that rejects requests with bodies larger
+// than the limit. If the size of a body is not known in advance, reading
+// of the body stops at the limit.
+func bodyLimit(limit int64) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			if req.ContentLength > limit {
+				return bodyTooLarge(limit)
+			}
+			req.Body = http.MaxBytesReader(c.Response(), req.Body, limit)
+			return next(c)
+		}
+	}
+}
+
+func bodyTooLarge(limit int64) *echo.HTTPError {
+	return newAPIError(
+		http.StatusRequestEntityTooLarge, BodyTooLargeErr, limit,
+		"request body is larger than %d bytes", limit,
+	)
+}
+
+// readError converts errors of reading a request body to APIError.
+func readError(err error, msg string) *echo.HTTPError {
+	var maxErr *http.MaxBytesError
+	if errors.As(err, &maxErr) {
+		return bodyTooLarge(maxErr.Limit)
+	}
+	return badRequest(fmt.Errorf("%s: %w", msg, err))
+}
+
+// checkNames returns an error if there are more names than allowed, or
+// if any of the names is too long.
+func checkNames(cfg Config, names []string) error {
+	if cfg.MaxNames > 0 && len(names) > cfg.MaxNames {
+		return newAPIError(
+			http.StatusRequestEntityTooLarge, TooManyNamesErr,
+			int64(cfg.MaxNames),
+			"request has %d names, the limit is %d",
+			len(names), cfg.MaxNames,
+		)
+	}
+
+	if cfg.MaxNameLength <= 0 {
+		return nil
+	}
+	for i := range names {
+		if len(names[i]) > cfg.MaxNameLength {
+			return newAPIError(
+				http.StatusUnprocessableEntity, NameTooLongErr,
+				int64(cfg.MaxNameLength),
+				"name #%d is %d bytes long, the limit is %d bytes",
+				i+1, len(names[i]), cfg.MaxNameLength,
+			)
+		}
+	}
+	return nil
+}
+
+// rateLimiter returns a middleware that limits the number of requests
+// per second for every client IP address. It returns nil if rate limiting
+// is turned off.
+func rateLimiter(cfg Config) echo.MiddlewareFunc {
+	if cfg.RateLimit <= 0 {
+		return nil
+	}
+	burst := cfg.RateBurst
+	if burst <= 0 {
+		burst = int(math.Ceil(cfg.RateLimit))
+	}
+	store := middleware.NewRateLimiterMemoryStoreWithConfig(
+		middleware.RateLimiterMemoryStoreConfig{
+			Rate:      rate.Limit(cfg.RateLimit),
+			Burst:     burst,
+			ExpiresIn: 3 * time.Minute,
+		},
+	)
+
+	retryAfter := strconv.Itoa(int(math.Ceil(1 / cfg.RateLimit)))
+	return middleware.RateLimiterWithConfig(middleware.RateLimiterConfig{
+		Skipper: skipService,
+		Store:   store,
+		// X-Forwarded-For and X-Real-IP are set by clients, a client could
+		// bypass the limit by changing them, so only the address of the
+		// connection identifies the client.
+		IdentifierExtractor: func(c echo.Context) (string, error) {
+			return echo.ExtractIPDirect()(c.Request()), nil
+		},
+		DenyHandler: func(c echo.Context, _ string, _ error) error {
+			c.Response().Header().Set("Retry-After", retryAfter)
+			return newAPIError(
+				http.StatusTooManyRequests, RateLimitErr, int64(burst),
+				"rate limit of %g requests per second is exceeded",
+				cfg.RateLimit,
+			)
+		},
+		ErrorHandler: func(c echo.Context, err error) error {
+			return badRequest(fmt.Errorf("cannot identify client: %w", err))
+		},
+	})
+}
+
+// skipService skips limits for monitoring endpoints and static files.
+func skipService(c echo.Context) bool {
+	switch c.Path() {
+	case "/metrics", "/healthz", "/readyz", "/static/*":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,48 @@
+package web
+
+import (
+	"html/template"
+
+	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/labstack/echo/v4"
+)
+
+// uiCatalogs contain translations of texts of web pages keyed by language
+// codes. Texts are keyed by their English versions, that are used as they
+// are for parsed.DefaultLanguage and for missing translations.
+var uiCatalogs = map[string]map[string]string{
+	"de": uiDe,
+	"es": uiEs,
+	"fr": uiFr,
+	"pt": uiPt,
+}
+
+// translate returns a text of a web page in a given language.
+func translate(lang, s string) string {
+	if res, ok := uiCatalogs[lang][s]; ok {
+		return res
+	}
+	return s
+}
+
+// translateHTML returns a text of a web page that contains HTML markup.
+// Texts come from templates and catalogs, so they are trusted.
+func translateHTML(lang, s string) template.HTML {
+	return template.HTML(translate(lang, s))
+}
+
+// requestLanguage finds the language of a response. The 'lang' parameter
+// takes precedence over the Accept-Language header. The language is
+// recorded in the request options and in the headers of the response.
+func requestLanguage(c echo.Context, ro *gnparser.RequestOptions) string {
+	lang, _ := parsed.NewLanguage(ro.Language)
+	if ro.Language == "" {
+		lang = parsed.MatchLanguage(c.Request().Header.Get("Accept-Language"))
+		ro.Language = lang
+	}
+	h := c.Response().Header()
+	h.Set("Content-Language", lang)
+	h.Add(echo.HeaderVary, "Accept-Language")
+	return lang
+}
@@ -0,0 +1,72 @@
+package web
+
+// uiDe contains texts of web pages in German.
+var uiDe = map[string]string{
+	"Output format":                "Ausgabeformat",
+	"Include more parsing details": "Mehr Details der Analyse einschließen",
+	"Show details":                 "Details anzeigen",
+	"Nomenclatural Code":           "Nomenklaturcode",
+	"Any":                          "Beliebig",
+	"Bacterial":                    "Bakteriologisch",
+	"Botanical":                    "Botanisch",
+	"Cultivar":                     "Kulturpflanzen",
+	"Viral":                        "Virologisch",
+	"Zoological":                   "Zoologisch",
+	"Qualities of warnings according to a nomenclatural code": "Qualität der Warnungen nach einem Nomenklaturcode",
+	"Quality profile": "Qualitätsprofil",
+	"Default":         "Standard",
+	"Cultivars":       "Kulturpflanzen",
+	"Language of warnings and labels of words": "Sprache der Warnungen und Wortbezeichnungen",
+	"Language": "Sprache",
+	"Keep diaeresis marks (e.g., ë, ï) in canonical form": "Trema (z. B. ë, ï) im kanonischen Namen beibehalten",
+	"Preserve diaereses": "Trema beibehalten",
+	"Remove spaces between author initials (e.g., 'L. R. Smith' becomes 'L.R.Smith')": "Leerzeichen zwischen Initialen der Autoren entfernen (z. B. wird 'L. R. Smith' zu 'L.R.Smith')",
+	"Compact authors' initials":                         "Kompakte Autoreninitialen",
+	"Flatten nested JSON structure into a single level": "Verschachtelte JSON-Struktur in eine Ebene umwandeln",
+	"Flatten output":                                    "Flache Ausgabe",
+	"Capitalize the first letter of name-strings":       "Ersten Buchstaben der Namen großschreiben",
+	"Capitalize": "Großschreibung",
+	"Do not clean HTML tags and entities from name-strings (faster)": "HTML-Tags und -Entitäten nicht aus Namen entfernen (schneller)",
+	"Ignore HTML tags": "HTML-Tags ignorieren",
+	"Truncate stemmed autonyms and species group names to species (e.g., 'Aus bus bus' becomes 'Aus bus')": "Autonyme und Namen der Artgruppe in der Stammform auf die Art kürzen (z. B. wird 'Aus bus bus' zu 'Aus bus')",
+	"Species group cut": "Artgruppe kürzen",
+	"Suggest corrected canonical forms according to orthographic rules of nomenclatural codes": "Korrigierte kanonische Formen nach den Schreibregeln der Nomenklaturcodes vorschlagen",
+	"Suggest corrections": "Korrekturen vorschlagen",
+	"Warn about epithets that do not agree in gender with their genus": "Vor Epitheta warnen, die im Geschlecht nicht mit ihrer Gattung übereinstimmen",
+	"Check gender agreement":                                       "Geschlechtskongruenz",
+	"Add genus, species and autonyms implied by names":             "Gattung, Art und Autonyme hinzufügen, die sich aus den Namen ergeben",
+	"Name hierarchy":                                               "Namenshierarchie",
+	"Infer a nomenclatural code of names with the evidence for it": "Einen Nomenklaturcode der Namen mit den Belegen dafür ermitteln",
+	"Infer nomenclatural code":                                     "Nomenklaturcode ermitteln",
+	"Add ranks to uninomials with standard endings of ranks":       "Uninomina mit Standardendungen von Rängen Ränge hinzufügen",
+	"Infer ranks": "Ränge ermitteln",
+	"Suggestions": "Vorschläge",
+	"With the <code>suggestions=true</code> parameter results contain corrected canonical forms proposed according to orthographic rules of nomenclatural codes. Every suggestion has a rule, a reference to an article of a code and a confidence from 0 to 1.": "Mit dem Parameter <code>suggestions=true</code> enthalten die Ergebnisse korrigierte kanonische Formen, die nach den Schreibregeln der Nomenklaturcodes vorgeschlagen werden. Jeder Vorschlag hat eine Regel, einen Verweis auf einen Artikel eines Codes und eine Konfidenz von 0 bis 1.",
+	"Show syntax trees of the first 20 names (HTML format only)": "Syntaxbäume der ersten 20 Namen anzeigen (nur HTML-Format)",
+	"Show syntax trees":                  "Syntaxbäume anzeigen",
+	"Add up to 5000 names, one per line": "Bis zu 5000 Namen eingeben, einen pro Zeile",
+	"Parse":                              "Analysieren",
+	"Results:":                           "Ergebnisse:",
+	"Syntax trees:":                      "Syntaxbäume:",
+	"Preprocessing: NO PARSE":            "Vorverarbeitung: NICHT ANALYSIERT",
+	"Output Syntax Tree":                 "Ausgabe-Syntaxbaum",
+	"Complete Syntax Tree":               "Vollständiger Syntaxbaum",
+	"Parser":                             "Parser",
+	"Doc on":                             "Dokumentation auf",
+	"Projects":                           "Projekte",
+	"Issues":                             "Issues",
+	"Scientific Names in Detail":         "Wissenschaftliche Namen im Detail",
+	"Version":                            "Version",
+	"Application Programming Interface (API)": "Programmierschnittstelle (API)",
+	"Web-based parser service includes a RESTful interface to parsing functionality. Both GET and POST methods are supported.":                                   "Der Webdienst des Parsers enthält eine RESTful-Schnittstelle zum Analysieren von Namen. Die Methoden GET und POST werden unterstützt.",
+	"Append a vertical line separated array of strings to your domain url. Make sure that '&' in the names are escaped as '%26', and spaces are escaped as '+'.": "Hängen Sie eine durch senkrechte Striche getrennte Liste von Namen an die URL Ihrer Domain an. Achten Sie darauf, dass '&' in den Namen als '%26' und Leerzeichen als '+' kodiert sind.",
+	"with request body of JSON array of strings": "mit einem Anfragekörper aus einem JSON-Array von Zeichenketten",
+	"Syntax trees": "Syntaxbäume",
+	"returns complete and output syntax trees of a name-string as JSON (rule name, span and text of every node). Add <code>?format=dot</code> to get a Graphviz DOT graph. Parsing options, like <code>code</code>, are the same as for GET requests.": "gibt den vollständigen und den Ausgabe-Syntaxbaum eines Namens als JSON zurück (Name der Regel, Position und Text jedes Knotens). Mit <code>?format=dot</code> erhalten Sie einen Graphviz-DOT-Graphen. Optionen der Analyse, wie <code>code</code>, sind dieselben wie bei GET-Anfragen.",
+	"Warnings": "Warnungen",
+	"returns descriptions of all quality warnings: their stable codes, messages, default qualities, explanations, examples, articles of nomenclatural codes and suggested fixes. A description of one warning is returned by its code, for example <code>/api/v1/warnings/AUTH_EX_NOT_REQUIRED</code>.": "gibt Beschreibungen aller Qualitätswarnungen zurück: ihre stabilen Codes, Meldungen, Standardqualitäten, Erklärungen, Beispiele, Artikel der Nomenklaturcodes und Korrekturvorschläge. Die Beschreibung einer Warnung wird über ihren Code abgerufen, zum Beispiel <code>/api/v1/warnings/AUTH_EX_NOT_REQUIRED</code>.",
+	"Languages": "Sprachen",
+	"Messages of warnings and labels of words are translated to the language given by the <code>lang</code> parameter (<code>en</code>, <code>es</code>, <code>pt</code>, <code>fr</code> or <code>de</code>), or by the <code>Accept-Language</code> header. Translations are in the <code>message</code> field of warnings and the <code>label</code> field of words, stable codes of warnings do not change.": "Meldungen der Warnungen und Bezeichnungen der Wörter werden in die Sprache übersetzt, die der Parameter <code>lang</code> (<code>en</code>, <code>es</code>, <code>pt</code>, <code>fr</code> oder <code>de</code>) oder der Header <code>Accept-Language</code> angibt. Übersetzungen stehen im Feld <code>message</code> der Warnungen und im Feld <code>label</code> der Wörter; stabile Codes der Warnungen ändern sich nicht.",
+	"OpenAPI Schema": "OpenAPI-Schema",
+	"Read the GNparser's <a href=\"https://apidoc.globalnames.org/gnparser\">OpenAPI documentation</a> to learn about all options and the output schema.": "Lesen Sie die <a href=\"https://apidoc.globalnames.org/gnparser\">OpenAPI-Dokumentation</a> von GNparser, um alle Optionen und das Ausgabeschema kennenzulernen.",
+}
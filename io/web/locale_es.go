@@ -0,0 +1,72 @@
+package web
+
+// uiEs contains texts of web pages in Spanish.
+var uiEs = map[string]string{
+	"Output format":                "Formato de salida",
+	"Include more parsing details": "Incluir más detalles del análisis",
+	"Show details":                 "Mostrar detalles",
+	"Nomenclatural Code":           "Código nomenclatural",
+	"Any":                          "Cualquiera",
+	"Bacterial":                    "Bacteriológico",
+	"Botanical":                    "Botánico",
+	"Cultivar":                     "Cultivares",
+	"Viral":                        "Virológico",
+	"Zoological":                   "Zoológico",
+	"Qualities of warnings according to a nomenclatural code": "Calidad de las advertencias según un código nomenclatural",
+	"Quality profile": "Perfil de calidad",
+	"Default":         "Predeterminado",
+	"Cultivars":       "Cultivares",
+	"Language of warnings and labels of words": "Idioma de las advertencias y etiquetas de palabras",
+	"Language": "Idioma",
+	"Keep diaeresis marks (e.g., ë, ï) in canonical form": "Conservar la diéresis (p. ej., ë, ï) en el nombre canónico",
+	"Preserve diaereses": "Conservar diéresis",
+	"Remove spaces between author initials (e.g., 'L. R. Smith' becomes 'L.R.Smith')": "Eliminar espacios entre las iniciales de autores (p. ej., 'L. R. Smith' se convierte en 'L.R.Smith')",
+	"Compact authors' initials":                         "Iniciales de autores compactas",
+	"Flatten nested JSON structure into a single level": "Convertir la estructura JSON anidada en un solo nivel",
+	"Flatten output":                                    "Salida plana",
+	"Capitalize the first letter of name-strings":       "Poner en mayúscula la primera letra de los nombres",
+	"Capitalize": "Mayúscula inicial",
+	"Do not clean HTML tags and entities from name-strings (faster)": "No eliminar etiquetas y entidades HTML de los nombres (más rápido)",
+	"Ignore HTML tags": "Ignorar etiquetas HTML",
+	"Truncate stemmed autonyms and species group names to species (e.g., 'Aus bus bus' becomes 'Aus bus')": "Reducir autónimos y nombres del grupo de especie a la especie en la forma lematizada (p. ej., 'Aus bus bus' se convierte en 'Aus bus')",
+	"Species group cut": "Recortar grupo de especie",
+	"Suggest corrected canonical forms according to orthographic rules of nomenclatural codes": "Sugerir formas canónicas corregidas según las reglas ortográficas de los códigos de nomenclatura",
+	"Suggest corrections": "Sugerir correcciones",
+	"Warn about epithets that do not agree in gender with their genus": "Advertir sobre epítetos que no concuerdan en género con su género",
+	"Check gender agreement":                                       "Concordancia de género",
+	"Add genus, species and autonyms implied by names":             "Agregar el género, la especie y los autónimos implícitos en los nombres",
+	"Name hierarchy":                                               "Jerarquía de nombres",
+	"Infer a nomenclatural code of names with the evidence for it": "Inferir un código nomenclatural de los nombres con la evidencia que lo respalda",
+	"Infer nomenclatural code":                                     "Inferir código nomenclatural",
+	"Add ranks to uninomials with standard endings of ranks":       "Añadir rangos a uninomiales con terminaciones estándar de rangos",
+	"Infer ranks": "Inferir rangos",
+	"Suggestions": "Sugerencias",
+	"With the <code>suggestions=true</code> parameter results contain corrected canonical forms proposed according to orthographic rules of nomenclatural codes. Every suggestion has a rule, a reference to an article of a code and a confidence from 0 to 1.": "Con el parámetro <code>suggestions=true</code> los resultados contienen formas canónicas corregidas propuestas según las reglas ortográficas de los códigos de nomenclatura. Cada sugerencia tiene una regla, una referencia a un artículo de un código y una confianza de 0 a 1.",
+	"Show syntax trees of the first 20 names (HTML format only)": "Mostrar árboles sintácticos de los primeros 20 nombres (solo formato HTML)",
+	"Show syntax trees":                  "Mostrar árboles sintácticos",
+	"Add up to 5000 names, one per line": "Agregue hasta 5000 nombres, uno por línea",
+	"Parse":                              "Analizar",
+	"Results:":                           "Resultados:",
+	"Syntax trees:":                      "Árboles sintácticos:",
+	"Preprocessing: NO PARSE":            "Preprocesamiento: NO ANALIZADO",
+	"Output Syntax Tree":                 "Árbol sintáctico de salida",
+	"Complete Syntax Tree":               "Árbol sintáctico completo",
+	"Parser":                             "Analizador",
+	"Doc on":                             "Documentación en",
+	"Projects":                           "Proyectos",
+	"Issues":                             "Incidencias",
+	"Scientific Names in Detail":         "Nombres científicos en detalle",
+	"Version":                            "Versión",
+	"Application Programming Interface (API)": "Interfaz de programación de aplicaciones (API)",
+	"Web-based parser service includes a RESTful interface to parsing functionality. Both GET and POST methods are supported.":                                   "El servicio web del analizador incluye una interfaz RESTful para analizar nombres. Se admiten los métodos GET y POST.",
+	"Append a vertical line separated array of strings to your domain url. Make sure that '&' in the names are escaped as '%26', and spaces are escaped as '+'.": "Agregue a la URL de su dominio una lista de nombres separados por barras verticales. Asegúrese de que '&' en los nombres esté codificado como '%26' y los espacios como '+'.",
+	"with request body of JSON array of strings": "con un cuerpo de solicitud que es un arreglo JSON de cadenas",
+	"Syntax trees": "Árboles sintácticos",
+	"returns complete and output syntax trees of a name-string as JSON (rule name, span and text of every node). Add <code>?format=dot</code> to get a Graphviz DOT graph. Parsing options, like <code>code</code>, are the same as for GET requests.": "devuelve los árboles sintácticos completo y de salida de un nombre en JSON (nombre de la regla, posición y texto de cada nodo). Agregue <code>?format=dot</code> para obtener un grafo de Graphviz DOT. Las opciones de análisis, como <code>code</code>, son las mismas que para las solicitudes GET.",
+	"Warnings": "Advertencias",
+	"returns descriptions of all quality warnings: their stable codes, messages, default qualities, explanations, examples, articles of nomenclatural codes and suggested fixes. A description of one warning is returned by its code, for example <code>/api/v1/warnings/AUTH_EX_NOT_REQUIRED</code>.": "devuelve descripciones de todas las advertencias de calidad: sus códigos estables, mensajes, calidades predeterminadas, explicaciones, ejemplos, artículos de los códigos nomenclaturales y correcciones sugeridas. La descripción de una advertencia se obtiene por su código, por ejemplo <code>/api/v1/warnings/AUTH_EX_NOT_REQUIRED</code>.",
+	"Languages": "Idiomas",
+	"Messages of warnings and labels of words are translated to the language given by the <code>lang</code> parameter (<code>en</code>, <code>es</code>, <code>pt</code>, <code>fr</code> or <code>de</code>), or by the <code>Accept-Language</code> header. Translations are in the <code>message</code> field of warnings and the <code>label</code> field of words, stable codes of warnings do not change.": "Los mensajes de las advertencias y las etiquetas de las palabras se traducen al idioma indicado por el parámetro <code>lang</code> (<code>en</code>, <code>es</code>, <code>pt</code>, <code>fr</code> o <code>de</code>) o por el encabezado <code>Accept-Language</code>. Las traducciones están en el campo <code>message</code> de las advertencias y en el campo <code>label</code> de las palabras; los códigos estables de las advertencias no cambian.",
+	"OpenAPI Schema": "Esquema OpenAPI",
+	"Read the GNparser's <a href=\"https://apidoc.globalnames.org/gnparser\">OpenAPI documentation</a> to learn about all options and the output schema.": "Lea la <a href=\"https://apidoc.globalnames.org/gnparser\">documentación OpenAPI</a> de GNparser para conocer todas las opciones y el esquema de la salida.",
+}
@@ -0,0 +1,72 @@
+package web
+
+// uiFr contains texts of web pages in French.
+var uiFr = map[string]string{
+	"Output format":                "Format de sortie",
+	"Include more parsing details": "Inclure plus de détails d'analyse",
+	"Show details":                 "Afficher les détails",
+	"Nomenclatural Code":           "Code de nomenclature",
+	"Any":                          "Tous",
+	"Bacterial":                    "Bactériologique",
+	"Botanical":                    "Botanique",
+	"Cultivar":                     "Cultivars",
+	"Viral":                        "Virologique",
+	"Zoological":                   "Zoologique",
+	"Qualities of warnings according to a nomenclatural code": "Qualité des avertissements selon un code de nomenclature",
+	"Quality profile": "Profil de qualité",
+	"Default":         "Par défaut",
+	"Cultivars":       "Cultivars",
+	"Language of warnings and labels of words": "Langue des avertissements et des étiquettes de mots",
+	"Language": "Langue",
+	"Keep diaeresis marks (e.g., ë, ï) in canonical form": "Conserver le tréma (p. ex. ë, ï) dans le nom canonique",
+	"Preserve diaereses": "Conserver les trémas",
+	"Remove spaces between author initials (e.g., 'L. R. Smith' becomes 'L.R.Smith')": "Supprimer les espaces entre les initiales des auteurs (p. ex. 'L. R. Smith' devient 'L.R.Smith')",
+	"Compact authors' initials":                         "Initiales d'auteurs compactes",
+	"Flatten nested JSON structure into a single level": "Aplatir la structure JSON imbriquée en un seul niveau",
+	"Flatten output":                                    "Sortie aplatie",
+	"Capitalize the first letter of name-strings":       "Mettre en majuscule la première lettre des noms",
+	"Capitalize": "Majuscule initiale",
+	"Do not clean HTML tags and entities from name-strings (faster)": "Ne pas supprimer les balises et entités HTML des noms (plus rapide)",
+	"Ignore HTML tags": "Ignorer les balises HTML",
+	"Truncate stemmed autonyms and species group names to species (e.g., 'Aus bus bus' becomes 'Aus bus')": "Réduire les autonymes et les noms du groupe de l'espèce à l'espèce dans la forme radicalisée (p. ex. 'Aus bus bus' devient 'Aus bus')",
+	"Species group cut": "Couper le groupe de l'espèce",
+	"Suggest corrected canonical forms according to orthographic rules of nomenclatural codes": "Suggérer des formes canoniques corrigées selon les règles orthographiques des codes de nomenclature",
+	"Suggest corrections": "Suggérer des corrections",
+	"Warn about epithets that do not agree in gender with their genus": "Signaler les épithètes qui ne s'accordent pas en genre avec leur genre",
+	"Check gender agreement":                                       "Accord en genre",
+	"Add genus, species and autonyms implied by names":             "Ajouter le genre, l'espèce et les autonymes impliqués par les noms",
+	"Name hierarchy":                                               "Hiérarchie des noms",
+	"Infer a nomenclatural code of names with the evidence for it": "Déduire un code de nomenclature des noms avec les indices qui le justifient",
+	"Infer nomenclatural code":                                     "Déduire le code de nomenclature",
+	"Add ranks to uninomials with standard endings of ranks":       "Ajouter les rangs aux uninominaux avec des terminaisons standard de rangs",
+	"Infer ranks": "Déduire les rangs",
+	"Suggestions": "Suggestions",
+	"With the <code>suggestions=true</code> parameter results contain corrected canonical forms proposed according to orthographic rules of nomenclatural codes. Every suggestion has a rule, a reference to an article of a code and a confidence from 0 to 1.": "Avec le paramètre <code>suggestions=true</code> les résultats contiennent des formes canoniques corrigées proposées selon les règles orthographiques des codes de nomenclature. Chaque suggestion comporte une règle, une référence à un article d'un code et une confiance de 0 à 1.",
+	"Show syntax trees of the first 20 names (HTML format only)": "Afficher les arbres syntaxiques des 20 premiers noms (format HTML seulement)",
+	"Show syntax trees":                  "Afficher les arbres syntaxiques",
+	"Add up to 5000 names, one per line": "Ajoutez jusqu'à 5000 noms, un par ligne",
+	"Parse":                              "Analyser",
+	"Results:":                           "Résultats :",
+	"Syntax trees:":                      "Arbres syntaxiques :",
+	"Preprocessing: NO PARSE":            "Prétraitement : NON ANALYSÉ",
+	"Output Syntax Tree":                 "Arbre syntaxique de sortie",
+	"Complete Syntax Tree":               "Arbre syntaxique complet",
+	"Parser":                             "Analyseur",
+	"Doc on":                             "Documentation sur",
+	"Projects":                           "Projets",
+	"Issues":                             "Tickets",
+	"Scientific Names in Detail":         "Les noms scientifiques en détail",
+	"Version":                            "Version",
+	"Application Programming Interface (API)": "Interface de programmation d'application (API)",
+	"Web-based parser service includes a RESTful interface to parsing functionality. Both GET and POST methods are supported.":                                   "Le service web de l'analyseur comprend une interface RESTful pour l'analyse des noms. Les méthodes GET et POST sont prises en charge.",
+	"Append a vertical line separated array of strings to your domain url. Make sure that '&' in the names are escaped as '%26', and spaces are escaped as '+'.": "Ajoutez à l'URL de votre domaine une liste de noms séparés par des barres verticales. Assurez-vous que '&' dans les noms est codé en '%26' et les espaces en '+'.",
+	"with request body of JSON array of strings": "avec un corps de requête contenant un tableau JSON de chaînes",
+	"Syntax trees": "Arbres syntaxiques",
+	"returns complete and output syntax trees of a name-string as JSON (rule name, span and text of every node). Add <code>?format=dot</code> to get a Graphviz DOT graph. Parsing options, like <code>code</code>, are the same as for GET requests.": "renvoie les arbres syntaxiques complet et de sortie d'un nom en JSON (nom de la règle, position et texte de chaque nœud). Ajoutez <code>?format=dot</code> pour obtenir un graphe Graphviz DOT. Les options d'analyse, comme <code>code</code>, sont les mêmes que pour les requêtes GET.",
+	"Warnings": "Avertissements",
+	"returns descriptions of all quality warnings: their stable codes, messages, default qualities, explanations, examples, articles of nomenclatural codes and suggested fixes. A description of one warning is returned by its code, for example <code>/api/v1/warnings/AUTH_EX_NOT_REQUIRED</code>.": "renvoie les descriptions de tous les avertissements de qualité : leurs codes stables, messages, qualités par défaut, explications, exemples, articles des codes de nomenclature et corrections suggérées. La description d'un avertissement s'obtient par son code, par exemple <code>/api/v1/warnings/AUTH_EX_NOT_REQUIRED</code>.",
+	"Languages": "Langues",
+	"Messages of warnings and labels of words are translated to the language given by the <code>lang</code> parameter (<code>en</code>, <code>es</code>, <code>pt</code>, <code>fr</code> or <code>de</code>), or by the <code>Accept-Language</code> header. Translations are in the <code>message</code> field of warnings and the <code>label</code> field of words, stable codes of warnings do not change.": "Les messages des avertissements et les étiquettes des mots sont traduits dans la langue indiquée par le paramètre <code>lang</code> (<code>en</code>, <code>es</code>, <code>pt</code>, <code>fr</code> ou <code>de</code>) ou par l'en-tête <code>Accept-Language</code>. Les traductions se trouvent dans le champ <code>message</code> des avertissements et le champ <code>label</code> des mots ; les codes stables des avertissements ne changent pas.",
+	"OpenAPI Schema": "Schéma OpenAPI",
+	"Read the GNparser's <a href=\"https://apidoc.globalnames.org/gnparser\">OpenAPI documentation</a> to learn about all options and the output schema.": "Lisez la <a href=\"https://apidoc.globalnames.org/gnparser\">documentation OpenAPI</a> de GNparser pour connaître toutes les options et le schéma de sortie.",
+}
@@ -0,0 +1,72 @@
+package web
+
+// uiPt contains texts of web pages in Portuguese.
+var uiPt = map[string]string{
+	"Output format":                "Formato de saída",
+	"Include more parsing details": "Incluir mais detalhes da análise",
+	"Show details":                 "Mostrar detalhes",
+	"Nomenclatural Code":           "Código nomenclatural",
+	"Any":                          "Qualquer",
+	"Bacterial":                    "Bacteriológico",
+	"Botanical":                    "Botânico",
+	"Cultivar":                     "Cultivares",
+	"Viral":                        "Virológico",
+	"Zoological":                   "Zoológico",
+	"Qualities of warnings according to a nomenclatural code": "Qualidade dos avisos de acordo com um código nomenclatural",
+	"Quality profile": "Perfil de qualidade",
+	"Default":         "Padrão",
+	"Cultivars":       "Cultivares",
+	"Language of warnings and labels of words": "Idioma dos avisos e rótulos das palavras",
+	"Language": "Idioma",
+	"Keep diaeresis marks (e.g., ë, ï) in canonical form": "Manter o trema (p. ex., ë, ï) no nome canônico",
+	"Preserve diaereses": "Manter trema",
+	"Remove spaces between author initials (e.g., 'L. R. Smith' becomes 'L.R.Smith')": "Remover espaços entre as iniciais dos autores (p. ex., 'L. R. Smith' se torna 'L.R.Smith')",
+	"Compact authors' initials":                         "Iniciais de autores compactas",
+	"Flatten nested JSON structure into a single level": "Converter a estrutura JSON aninhada em um único nível",
+	"Flatten output":                                    "Saída plana",
+	"Capitalize the first letter of name-strings":       "Colocar em maiúscula a primeira letra dos nomes",
+	"Capitalize": "Maiúscula inicial",
+	"Do not clean HTML tags and entities from name-strings (faster)": "Não remover tags e entidades HTML dos nomes (mais rápido)",
+	"Ignore HTML tags": "Ignorar tags HTML",
+	"Truncate stemmed autonyms and species group names to species (e.g., 'Aus bus bus' becomes 'Aus bus')": "Reduzir autônimos e nomes do grupo da espécie à espécie na forma radical (p. ex., 'Aus bus bus' se torna 'Aus bus')",
+	"Species group cut": "Cortar grupo da espécie",
+	"Suggest corrected canonical forms according to orthographic rules of nomenclatural codes": "Sugerir formas canônicas corrigidas segundo as regras ortográficas dos códigos de nomenclatura",
+	"Suggest corrections": "Sugerir correções",
+	"Warn about epithets that do not agree in gender with their genus": "Avisar sobre epítetos que não concordam em gênero com o seu gênero",
+	"Check gender agreement":                                       "Concordância de gênero",
+	"Add genus, species and autonyms implied by names":             "Adicionar o gênero, a espécie e os autônimos implícitos nos nomes",
+	"Name hierarchy":                                               "Hierarquia de nomes",
+	"Infer a nomenclatural code of names with the evidence for it": "Inferir um código nomenclatural dos nomes com as evidências que o sustentam",
+	"Infer nomenclatural code":                                     "Inferir código nomenclatural",
+	"Add ranks to uninomials with standard endings of ranks":       "Adicionar categorias a uninomiais com terminações padrão de categorias",
+	"Infer ranks": "Inferir categorias",
+	"Suggestions": "Sugestões",
+	"With the <code>suggestions=true</code> parameter results contain corrected canonical forms proposed according to orthographic rules of nomenclatural codes. Every suggestion has a rule, a reference to an article of a code and a confidence from 0 to 1.": "Com o parâmetro <code>suggestions=true</code> os resultados contêm formas canônicas corrigidas propostas segundo as regras ortográficas dos códigos de nomenclatura. Cada sugestão tem uma regra, uma referência a um artigo de um código e uma confiança de 0 a 1.",
+	"Show syntax trees of the first 20 names (HTML format only)": "Mostrar árvores sintáticas dos primeiros 20 nomes (somente formato HTML)",
+	"Show syntax trees":                  "Mostrar árvores sintáticas",
+	"Add up to 5000 names, one per line": "Adicione até 5000 nomes, um por linha",
+	"Parse":                              "Analisar",
+	"Results:":                           "Resultados:",
+	"Syntax trees:":                      "Árvores sintáticas:",
+	"Preprocessing: NO PARSE":            "Pré-processamento: NÃO ANALISADO",
+	"Output Syntax Tree":                 "Árvore sintática de saída",
+	"Complete Syntax Tree":               "Árvore sintática completa",
+	"Parser":                             "Analisador",
+	"Doc on":                             "Documentação no",
+	"Projects":                           "Projetos",
+	"Issues":                             "Problemas",
+	"Scientific Names in Detail":         "Nomes científicos em detalhe",
+	"Version":                            "Versão",
+	"Application Programming Interface (API)": "Interface de programação de aplicações (API)",
+	"Web-based parser service includes a RESTful interface to parsing functionality. Both GET and POST methods are supported.":                                   "O serviço web do analisador inclui uma interface RESTful para análise de nomes. Os métodos GET e POST são suportados.",
+	"Append a vertical line separated array of strings to your domain url. Make sure that '&' in the names are escaped as '%26', and spaces are escaped as '+'.": "Adicione à URL do seu domínio uma lista de nomes separados por barras verticais. Certifique-se de que '&' nos nomes esteja codificado como '%26' e os espaços como '+'.",
+	"with request body of JSON array of strings": "com um corpo de requisição que é um array JSON de strings",
+	"Syntax trees": "Árvores sintáticas",
+	"returns complete and output syntax trees of a name-string as JSON (rule name, span and text of every node). Add <code>?format=dot</code> to get a Graphviz DOT graph. Parsing options, like <code>code</code>, are the same as for GET requests.": "retorna as árvores sintáticas completa e de saída de um nome em JSON (nome da regra, posição e texto de cada nó). Adicione <code>?format=dot</code> para obter um grafo Graphviz DOT. As opções de análise, como <code>code</code>, são as mesmas das requisições GET.",
+	"Warnings": "Avisos",
+	"returns descriptions of all quality warnings: their stable codes, messages, default qualities, explanations, examples, articles of nomenclatural codes and suggested fixes. A description of one warning is returned by its code, for example <code>/api/v1/warnings/AUTH_EX_NOT_REQUIRED</code>.": "retorna descrições de todos os avisos de qualidade: seus códigos estáveis, mensagens, qualidades padrão, explicações, exemplos, artigos dos códigos nomenclaturais e correções sugeridas. A descrição de um aviso é obtida pelo seu código, por exemplo <code>/api/v1/warnings/AUTH_EX_NOT_REQUIRED</code>.",
+	"Languages": "Idiomas",
+	"Messages of warnings and labels of words are translated to the language given by the <code>lang</code> parameter (<code>en</code>, <code>es</code>, <code>pt</code>, <code>fr</code> or <code>de</code>), or by the <code>Accept-Language</code> header. Translations are in the <code>message</code> field of warnings and the <code>label</code> field of words, stable codes of warnings do not change.": "As mensagens dos avisos e os rótulos das palavras são traduzidos para o idioma indicado pelo parâmetro <code>lang</code> (<code>en</code>, <code>es</code>, <code>pt</code>, <code>fr</code> ou <code>de</code>) ou pelo cabeçalho <code>Accept-Language</code>. As traduções ficam no campo <code>message</code> dos avisos e no campo <code>label</code> das palavras; os códigos estáveis dos avisos não mudam.",
+	"OpenAPI Schema": "Esquema OpenAPI",
+	"Read the GNparser's <a href=\"https://apidoc.globalnames.org/gnparser\">OpenAPI documentation</a> to learn about all options and the output schema.": "Leia a <a href=\"https://apidoc.globalnames.org/gnparser\">documentação OpenAPI</a> do GNparser para conhecer todas as opções e o esquema da saída.",
+}
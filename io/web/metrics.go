@@ -0,0 +1,182 @@
+package web
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// metrics contains Prometheus collectors of the web service.
+type metrics struct {
+	registry *prometheus.Registry
+
+	// requests counts HTTP requests by route, method and status code.
+	requests *prometheus.CounterVec
+
+	// latency observes duration of HTTP requests by route and method.
+	latency *prometheus.HistogramVec
+
+	// names counts parsed name-strings by the interface that parsed them
+	// (REST API or web GUI).
+	names *prometheus.CounterVec
+
+	// quality observes parsing quality of every parsed name-string.
+	quality prometheus.Histogram
+
+	// warnings counts parsing warnings by their type.
+	warnings *prometheus.CounterVec
+
+	// batchSize observes the number of name-strings in a request.
+	batchSize prometheus.Histogram
+
+	// inFlight is the number of name-strings that are being parsed now.
+	inFlight prometheus.Gauge
+}
+
+// mtr keeps metrics of the web service. Collectors use their own
+// registry, so they do not interfere with metrics of other packages.
+var mtr = newMetrics()
+
+func newMetrics() *metrics {
+	res := metrics{
+		registry: prometheus.NewRegistry(),
+		requests: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: "gnparser",
+				Name:      "http_requests_total",
+				Help:      "Number of HTTP requests by route, method and status.",
+			},
+			[]string{"route", "method", "code"},
+		),
+		latency: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Namespace: "gnparser",
+				Name:      "http_request_duration_seconds",
+				Help:      "Duration of HTTP requests by route and method.",
+				Buckets:   prometheus.DefBuckets,
+			},
+			[]string{"route", "method"},
+		),
+		names: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: "gnparser",
+				Name:      "names_parsed_total",
+				Help:      "Number of parsed name-strings by interface.",
+			},
+			[]string{"parsed_by"},
+		),
+		quality: prometheus.NewHistogram(
+			prometheus.HistogramOpts{
+				Namespace: "gnparser",
+				Name:      "parse_quality",
+				Help:      "Parsing quality of name-strings (0 to 4).",
+				Buckets:   []float64{0, 1, 2, 3, 4},
+			},
+		),
+		warnings: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: "gnparser",
+				Name:      "warnings_total",
+				Help:      "Number of parsing warnings by warning code.",
+			},
+			[]string{"code"},
+		),
+		batchSize: prometheus.NewHistogram(
+			prometheus.HistogramOpts{
+				Namespace: "gnparser",
+				Name:      "batch_size",
+				Help:      "Number of name-strings in a parsing request.",
+				Buckets:   prometheus.ExponentialBuckets(1, 4, 8),
+			},
+		),
+		inFlight: prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Namespace: "gnparser",
+				Name:      "names_in_flight",
+				Help:      "Number of name-strings that are being parsed.",
+			},
+		),
+	}
+	res.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		res.requests,
+		res.latency,
+		res.names,
+		res.quality,
+		res.warnings,
+		res.batchSize,
+		res.inFlight,
+	)
+	return &res
+}
+
+// handler exposes collected metrics in Prometheus text format.
+func (m *metrics) handler() echo.HandlerFunc {
+	h := promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+	return echo.WrapHandler(h)
+}
+
+// middleware records the number and duration of HTTP requests. Routes
+// are recorded by their templates (for example '/api/v1/:names') to keep
+// the number of label values small.
+func (m *metrics) middleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			start := time.Now()
+			err := next(c)
+
+			route := c.Path()
+			if route == "" {
+				route = "unknown"
+			}
+			method := c.Request().Method
+			code := c.Response().Status
+			if err != nil {
+				code = http.StatusInternalServerError
+				if httpErr, ok := err.(*echo.HTTPError); ok {
+					code = httpErr.Code
+				}
+			}
+
+			m.requests.WithLabelValues(route, method, strconv.Itoa(code)).Inc()
+			m.latency.WithLabelValues(route, method).
+				Observe(time.Since(start).Seconds())
+			return err
+		}
+	}
+}
+
+// observe records parsing results of a batch of name-strings.
+func (m *metrics) observe(parsedBy string, res []parsed.Parsed) {
+	m.names.WithLabelValues(parsedBy).Add(float64(len(res)))
+	for i := range res {
+		m.quality.Observe(float64(res[i].ParseQuality))
+		for _, v := range res[i].QualityWarnings {
+			m.warnings.WithLabelValues(v.Code).Inc()
+		}
+	}
+}
+
+// parseNames parses a batch of name-strings and records its metrics.
+func parseNames(
+	gnp gnparser.GNparser,
+	names []string,
+	parsedBy string,
+) []parsed.Parsed {
+	l := float64(len(names))
+	mtr.batchSize.Observe(l)
+	mtr.inFlight.Add(l)
+	defer mtr.inFlight.Sub(l)
+
+	res := gnp.ParseNames(names)
+	mtr.observe(parsedBy, res)
+	return res
+}
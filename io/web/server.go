@@ -1,16 +1,23 @@
 package web
 
 import (
+	"context"
 	"embed"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
+	"net"
 	"net/http"
 	"net/url"
+	"os"
+	"os/signal"
 	"strings"
+	"sync/atomic"
+	"syscall"
 	"time"
 
 	"github.com/gnames/gnfmt"
-	"github.com/gnames/gnlib/ent/nomcode"
 	"github.com/gnames/gnparser"
 	"github.com/gnames/gnparser/ent/parsed"
 	"github.com/labstack/echo/v4"
@@ -20,45 +27,61 @@ import (
 //go:embed static
 var static embed.FS
 
+// inputREST is the body of POST requests to REST API. It contains
+// name-strings and parsing options.
 type inputREST struct {
-	Names             []string `json:"names"`
-	CSV               bool     `json:"csv"`
-	WithDetails       bool     `json:"withDetails"`
-	PreserveDiaereses bool     `json:"preserveDiaereses"`
-	CompactAuthors    bool     `json:"compactAuthors"`
-	FlattenOutput     bool     `json:"flattenOutput"`
-	Code              string   `json:"code"`
-
-	// WithCultivars is deprecated by Code and overriden by it
-	WithCultivars bool `json:"withCultivars"`
+	Names []string `json:"names"`
+	gnparser.RequestOptions
 }
 
+// shutdownTimeout is the maximum time given to in-flight requests to
+// finish after the service receives SIGINT or SIGTERM.
+const shutdownTimeout = 30 * time.Second
+
 // Run starts the GNparser web service and servies both RESTful API and
-// a website.
-func Run(gnps GNparserService) {
+// a website. Requests are limited according to cfg. The service stops
+// gracefully on SIGINT or SIGTERM: it reports that it is not ready anymore
+// and lets in-flight requests finish.
+func Run(gnps GNparserService, cfg Config) {
 	var err error
+	var ready atomic.Bool
 
 	e := echo.New()
+	e.HideBanner = true
 
 	e.Renderer, err = NewTemplate()
 	if err != nil {
 		e.Logger.Fatal(err)
 	}
 
+	e.Use(mtr.middleware())
+	if rl := rateLimiter(cfg); rl != nil {
+		e.Use(rl)
+	}
+	e.Use(bodyLimit(cfg.MaxBodySize))
 	e.Use(middleware.Gzip())
 	e.Use(middleware.CORS())
 
-	e.GET("/", homeGET(gnps))
-	e.POST("/", homePOST(gnps))
+	e.GET("/", homeGET(gnps, cfg))
+	e.POST("/", homePOST(gnps, cfg))
 	e.GET("/doc/api", docAPI())
+	e.GET("/metrics", mtr.handler())
+	e.GET("/healthz", live())
+	e.GET("/readyz", readiness(&ready))
 	e.GET("/api", info())
 	e.GET("/api/v1", info())
 	e.GET("/api/v1/ping", ping(gnps))
 	e.GET("/api/v1/version", ver(gnps))
-	e.GET("/api/v1/:names", parseNamesGET(gnps))
-	e.GET("/api/:names", parseNamesGET(gnps))
-	e.POST("/api/v1/", parseNamesPOST(gnps))
-	e.POST("/api/", parseNamesPOST(gnps))
+	e.GET("/api/v1/debug/:name", debugGET(gnps, cfg))
+	e.GET("/api/debug/:name", debugGET(gnps, cfg))
+	e.GET("/api/v1/warnings", warningsGET())
+	e.GET("/api/warnings", warningsGET())
+	e.GET("/api/v1/warnings/:code", warningGET())
+	e.GET("/api/warnings/:code", warningGET())
+	e.GET("/api/v1/:names", parseNamesGET(gnps, cfg))
+	e.GET("/api/:names", parseNamesGET(gnps, cfg))
+	e.POST("/api/v1/", parseNamesPOST(gnps, cfg))
+	e.POST("/api/", parseNamesPOST(gnps, cfg))
 
 	fs := http.FileServer(http.FS(static))
 	e.GET("/static/*", echo.WrapHandler(fs))
@@ -69,7 +92,63 @@ func Run(gnps GNparserService) {
 		ReadTimeout:  5 * time.Minute,
 		WriteTimeout: 5 * time.Minute,
 	}
-	e.Logger.Fatal(e.StartServer(s))
+
+	ctx, stop := signal.NotifyContext(
+		context.Background(), os.Interrupt, syscall.SIGTERM,
+	)
+	defer stop()
+
+	// warm up parser before the server starts, the parsing engine of gnps
+	// cannot be used by requests and the warm-up at the same time
+	gnps.ParseName("Homo sapiens Linnaeus, 1758")
+
+	// bind the port before reporting readiness, StartServer uses this
+	// listener
+	e.Listener, err = net.Listen("tcp", addr)
+	if err != nil {
+		e.Logger.Fatal(err)
+	}
+
+	go func() {
+		err := e.StartServer(s)
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			e.Logger.Fatal(err)
+		}
+	}()
+
+	ready.Store(true)
+	slog.Info("Web service is ready", "port", gnps.Port())
+
+	<-ctx.Done()
+	ready.Store(false)
+	slog.Info("Shutting down web service, draining in-flight requests")
+
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := e.Shutdown(ctx); err != nil {
+		slog.Error("Cannot shut down web service gracefully", "error", err)
+		return
+	}
+	slog.Info("Web service stopped")
+}
+
+// live is a liveness probe. It succeeds as long as the service is able to
+// respond to requests.
+func live() func(echo.Context) error {
+	return func(c echo.Context) error {
+		return c.String(http.StatusOK, "ok")
+	}
+}
+
+// readiness is a readiness probe. It fails before the service finished its
+// start and after it began to shut down.
+func readiness(ready *atomic.Bool) func(echo.Context) error {
+	return func(c echo.Context) error {
+		if !ready.Load() {
+			return c.String(http.StatusServiceUnavailable, "not ready")
+		}
+		return c.String(http.StatusOK, "ready")
+	}
 }
 
 func info() func(c echo.Context) error {
@@ -97,22 +176,24 @@ func ver(gnps GNparserService) func(echo.Context) error {
 	}
 }
 
-func parseNamesGET(gnps GNparserService) func(echo.Context) error {
+func parseNamesGET(gnps GNparserService, cfg Config) func(echo.Context) error {
 	return func(c echo.Context) error {
 		nameStr, _ := url.QueryUnescape(c.Param("names"))
-		csv := c.QueryParam("csv") == "true"
-		det := c.QueryParam("with_details") == "true"
-		cultivars := c.QueryParam("cultivars") == "true"
-		diaereses := c.QueryParam("diaereses") == "true"
-		compactAuthors := c.QueryParam("compact_authors") == "true"
-		flatten := c.QueryParam("flatten") == "true"
-		codeStr := c.QueryParam("code")
-
-		code := getCode(codeStr, cultivars)
+		ro, err := gnparser.NewRequestOptions(c.QueryParams())
+		if err != nil {
+			return badRequest(err)
+		}
+		requestLanguage(c, &ro)
 
-		gnp := gnps.ChangeConfig(opts(code, csv, det, diaereses, compactAuthors, flatten)...)
+		gnp, err := changeConfig(gnps, ro)
+		if err != nil {
+			return err
+		}
 		names := strings.Split(nameStr, "|")
-		res := gnp.ParseNames(names)
+		if err = checkNames(cfg, names); err != nil {
+			return err
+		}
+		res := parseNames(gnp, names, "REST API")
 		if l := len(names); l > 0 {
 			slog.Info("Parsed",
 				"namesNum", l, "example", names[0],
@@ -123,10 +204,15 @@ func parseNamesGET(gnps GNparserService) func(echo.Context) error {
 	}
 }
 
-func parseNamesPOST(gnps GNparserService) func(echo.Context) error {
+func parseNamesPOST(gnps GNparserService, cfg Config) func(echo.Context) error {
 	return func(c echo.Context) error {
 		var input inputREST
-		if err := c.Bind(&input); err != nil {
+		dec := json.NewDecoder(c.Request().Body)
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&input); err != nil {
+			return readError(err, "cannot decode request body")
+		}
+		if err := checkNames(cfg, input.Names); err != nil {
 			return err
 		}
 
@@ -138,31 +224,81 @@ func parseNamesPOST(gnps GNparserService) func(echo.Context) error {
 				"method", "POST",
 			)
 		}
-		code := getCode(input.Code, input.WithCultivars)
-
-		gnp := gnps.ChangeConfig(
-			opts(code,
-				input.CSV,
-				input.WithDetails,
-				input.PreserveDiaereses,
-				input.CompactAuthors,
-				input.FlattenOutput,
-			)...)
-		res := gnp.ParseNames(input.Names)
+
+		requestLanguage(c, &input.RequestOptions)
+		gnp, err := changeConfig(gnps, input.RequestOptions)
+		if err != nil {
+			return err
+		}
+		res := parseNames(gnp, input.Names, "REST API")
 		return formatNames(c, res, gnp)
 	}
 }
 
-func getCode(codeStr string, cultivars bool) nomcode.Code {
-	code := nomcode.Unknown
-	if cultivars {
-		code = nomcode.Cultivars
+// debugGET returns syntax trees of a name-string as JSON, or as
+// a Graphviz DOT graph with 'format=dot'.
+func debugGET(gnps GNparserService, cfg Config) func(echo.Context) error {
+	return func(c echo.Context) error {
+		name, _ := url.QueryUnescape(c.Param("name"))
+		format := c.QueryParam("format")
+		if format != "" && format != "json" && format != "dot" {
+			return badRequest(
+				fmt.Errorf("unknown format '%s', use 'json' or 'dot'", format),
+			)
+		}
+		ro, err := gnparser.NewRequestOptions(c.QueryParams(), "format")
+		if err != nil {
+			return badRequest(err)
+		}
+		gnp, err := changeConfig(gnps, ro)
+		if err != nil {
+			return err
+		}
+		if err = checkNames(cfg, []string{name}); err != nil {
+			return err
+		}
+
+		res := gnp.DebugTree(name)
+		if format == "dot" {
+			return c.Blob(
+				http.StatusOK, "text/vnd.graphviz; charset=utf-8", []byte(res.DOT()),
+			)
+		}
+		return c.JSON(http.StatusOK, res)
+	}
+}
+
+// warningsGET returns descriptions of all quality warnings.
+func warningsGET() func(echo.Context) error {
+	return func(c echo.Context) error {
+		return c.JSON(http.StatusOK, parsed.WarningInfos())
 	}
-	code2 := nomcode.New(codeStr)
-	if code2 == nomcode.Unknown {
-		return code
+}
+
+// warningGET returns the description of a quality warning by its code.
+func warningGET() func(echo.Context) error {
+	return func(c echo.Context) error {
+		w, err := parsed.NewWarningFromCode(c.Param("code"))
+		if err != nil {
+			return newAPIError(http.StatusNotFound, NotFoundErr, 0, "%s", err)
+		}
+		return c.JSON(http.StatusOK, w.Info())
 	}
-	return code2
+}
+
+// changeConfig creates GNparser with settings from a request. The output
+// format of REST API is compact JSON, unless the request asks for
+// another one.
+func changeConfig(
+	gnps GNparserService,
+	ro gnparser.RequestOptions,
+) (gnparser.GNparser, error) {
+	opts, err := ro.Options()
+	if err != nil {
+		return nil, badRequest(err)
+	}
+	opts = append([]gnparser.Option{gnparser.OptFormat(gnfmt.CompactJSON)}, opts...)
+	return gnps.ChangeConfig(opts...), nil
 }
 
 func formatNames(
@@ -188,21 +324,3 @@ func formatNames(
 		return c.JSONBlob(http.StatusOK, []byte(str))
 	}
 }
-
-func opts(code nomcode.Code, csv, details, diaereses,
-	compactAuthors, flatten bool) []gnparser.Option {
-	res := []gnparser.Option{
-		gnparser.OptWithDetails(details),
-		gnparser.OptCode(code),
-		gnparser.OptWithPreserveDiaereses(diaereses),
-		gnparser.OptWithCompactAuthors(compactAuthors),
-		gnparser.OptWithFlatOutput(flatten),
-	}
-	if csv {
-		res = append(res, gnparser.OptFormat(gnfmt.CSV))
-	} else {
-		res = append(res, gnparser.OptFormat(gnfmt.CompactJSON))
-	}
-
-	return res
-}
@@ -79,5 +79,8 @@ func addFuncs(tmpl *template.Template) {
 		"parsedJSON": func(p parsed.Parsed, flatten bool) string {
 			return p.Output(gnfmt.PrettyJSON, flatten)
 		},
+		"t":            translate,
+		"th":           translateHTML,
+		"languageName": parsed.LanguageName,
 	})
 }
@@ -9,7 +9,6 @@ import (
 	"strings"
 
 	"github.com/gnames/gnfmt"
-	"github.com/gnames/gnlib/ent/nomcode"
 	"github.com/gnames/gnparser"
 	"github.com/gnames/gnparser/ent/parsed"
 	"github.com/labstack/echo/v4"
@@ -17,18 +16,23 @@ import (
 
 // inputFORM is used to collect data from HTML form.
 type inputFORM struct {
-	Names             string `query:"names"           form:"names"`
-	Code              string `query:"code"            form:"code"`
-	Format            string `query:"format"          form:"format"`
-	WithDetails       string `query:"with_details"    form:"with_details"`
-	PreserveDiaereses string `query:"diaereses"       form:"diaereses"`
-	CompactAuthors    string `query:"compact_authors" form:"compact_authors"`
-	FlattenOutput     string `query:"flatten"         form:"flatten"`
-
-	// WithCultivars is deprecated and overriden by Code
-	WithCultivars string `query:"cultivars" form:"cultivars"`
+	// Names is a new-line separated list of name-strings.
+	Names string
+
+	// Format is the output format of the web-page: 'html', 'json', 'csv'
+	// or 'tsv'.
+	Format string
+
+	// AST adds syntax trees of name-strings to HTML results.
+	AST bool
+
+	gnparser.RequestOptions
 }
 
+// maxASTNames is the maximum number of name-strings that get syntax trees
+// on the web page.
+const maxASTNames = 20
+
 // Data contains information required for web-pages templates.
 type Data struct {
 	Input             string
@@ -38,9 +42,30 @@ type Data struct {
 	HomePage          bool
 	Version           string
 	WithDetails       bool
+	Capitalize        bool
+	IgnoreHTMLTags    bool
 	PreserveDiaereses bool
 	CompactAuthors    bool
 	FlattenOutput     bool
+	SpeciesGroupCut   bool
+	WithSuggestions   bool
+	GenderAgreement   bool
+	WithHierarchy     bool
+	WithCodeInference bool
+	WithRankInference bool
+	QualityProfile    string
+
+	// Lang is the language of the page and of parsing results.
+	Lang string
+
+	// Languages are languages that can be selected on the page.
+	Languages []string
+
+	// AST is true if syntax trees are shown.
+	AST bool
+
+	// Trees are syntax trees of the first name-strings.
+	Trees []parsed.SyntaxTree
 
 	// WithCultivars is deprecated by Code field
 	WithCultivars bool
@@ -48,17 +73,62 @@ type Data struct {
 
 // NewData creates new Data for web-page templates.
 func newData(isHome bool) *Data {
-	return &Data{HomePage: isHome, Format: "html", Version: gnparser.Version}
+	return &Data{
+		HomePage:  isHome,
+		Format:    "html",
+		Version:   gnparser.Version,
+		Lang:      parsed.DefaultLanguage,
+		Languages: parsed.Languages(),
+	}
+}
+
+// newInputFORM collects name-strings and parsing options from an HTML form
+// or from a query of a GET request.
+func newInputFORM(vals url.Values) (*inputFORM, error) {
+	res := inputFORM{
+		Names:  vals.Get("names"),
+		Format: vals.Get("format"),
+	}
+	switch res.Format {
+	case "", "html":
+		res.Format = "html"
+	case "json", "csv", "tsv":
+	default:
+		return nil, fmt.Errorf(
+			"unknown format '%s', use 'html', 'json', 'csv' or 'tsv'",
+			res.Format,
+		)
+	}
+
+	switch v := strings.ToLower(vals.Get("ast")); v {
+	case "", "off", "no", "false", "0":
+	case "on", "yes", "true", "1":
+		res.AST = true
+	default:
+		return nil, fmt.Errorf("parameter 'ast': cannot convert '%s' to boolean", v)
+	}
+
+	var err error
+	res.RequestOptions, err = gnparser.NewRequestOptions(
+		vals, "names", "format", "ast",
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
 }
 
-func homePOST(gnps GNparserService) func(echo.Context) error {
+func homePOST(gnps GNparserService, cfg Config) func(echo.Context) error {
 	return func(c echo.Context) error {
-		inp := new(inputFORM)
 		data := newData(true)
 
-		err := c.Bind(inp)
+		vals, err := c.FormParams()
 		if err != nil {
-			return err
+			return readError(err, "cannot read form")
+		}
+		inp, err := newInputFORM(vals)
+		if err != nil {
+			return badRequest(err)
 		}
 
 		if strings.TrimSpace(inp.Names) == "" {
@@ -69,75 +139,65 @@ func homePOST(gnps GNparserService) func(echo.Context) error {
 			return redirectToHomeGET(c, inp)
 		}
 
-		return parsingResults(c, gnps, inp, data)
+		return parsingResults(c, gnps, cfg, inp, data)
 	}
 }
 
 func redirectToHomeGET(c echo.Context, inp *inputFORM) error {
-	withDetails := inp.WithDetails == "on"
-	withCultivars := inp.WithCultivars == "on"
-	preserveDiaereses := inp.PreserveDiaereses == "on"
-	compactAuthors := inp.CompactAuthors == "on"
-	flattenOutput := inp.FlattenOutput == "on"
-	q := make(url.Values)
+	q := inp.Values()
 	q.Set("names", inp.Names)
 	q.Set("format", inp.Format)
-	if withDetails {
-		q.Set("with_details", inp.WithDetails)
-	}
-	if withCultivars {
-		q.Set("cultivars", inp.WithCultivars)
+	if inp.AST {
+		q.Set("ast", "true")
 	}
-	if preserveDiaereses {
-		q.Set("diaereses", inp.PreserveDiaereses)
-	}
-	if compactAuthors {
-		q.Set("compact_authors", inp.CompactAuthors)
-	}
-	if flattenOutput {
-		q.Set("flatten", inp.FlattenOutput)
-	}
-	q.Set("code", inp.Code)
 
 	url := fmt.Sprintf("/?%s", q.Encode())
 	return c.Redirect(http.StatusFound, url)
 }
 
-func homeGET(gnps GNparserService) func(echo.Context) error {
+func homeGET(gnps GNparserService, cfg Config) func(echo.Context) error {
 	return func(c echo.Context) error {
 		data := newData(true)
 
-		inp := new(inputFORM)
-		err := c.Bind(inp)
+		inp, err := newInputFORM(c.QueryParams())
 		if err != nil {
-			return err
+			return badRequest(err)
 		}
 
 		if strings.TrimSpace(inp.Names) == "" {
+			data.Lang = requestLanguage(c, &inp.RequestOptions)
 			return c.Render(http.StatusOK, "layout", data)
 		}
-		return parsingResults(c, gnps, inp, data)
+		return parsingResults(c, gnps, cfg, inp, data)
 	}
 }
 
 func parsingResults(
 	c echo.Context,
 	gnps GNparserService,
+	cfg Config,
 	inp *inputFORM,
 	data *Data,
 ) error {
 	var names []string
-	data.WithDetails = inp.WithDetails == "on"
-	data.WithCultivars = inp.WithCultivars == "on"
-	data.PreserveDiaereses = inp.PreserveDiaereses == "on"
-	data.CompactAuthors = inp.CompactAuthors == "on"
-	data.FlattenOutput = inp.FlattenOutput == "on"
+	data.WithDetails = inp.WithDetails
+	data.WithCultivars = inp.WithCultivars
+	data.Capitalize = inp.Capitalize
+	data.IgnoreHTMLTags = inp.IgnoreHTMLTags
+	data.PreserveDiaereses = inp.PreserveDiaereses
+	data.CompactAuthors = inp.CompactAuthors
+	data.FlattenOutput = inp.FlattenOutput
+	data.SpeciesGroupCut = inp.SpeciesGroupCut
+	data.WithSuggestions = inp.WithSuggestions
+	data.GenderAgreement = inp.GenderAgreement
+	data.WithHierarchy = inp.WithHierarchy
+	data.WithCodeInference = inp.WithCodeInference
+	data.WithRankInference = inp.WithRankInference
+	data.QualityProfile = inp.QualityProfile
 	data.Code = inp.Code
-
-	format := inp.Format
-	if format == "csv" || format == "tsv" || format == "json" {
-		data.Format = format
-	}
+	data.Format = inp.Format
+	data.AST = inp.AST
+	data.Lang = requestLanguage(c, &inp.RequestOptions)
 
 	data.Input = strings.TrimSpace(inp.Names)
 	split := strings.Split(data.Input, "\n")
@@ -149,6 +209,9 @@ func parsingResults(
 	for i := range split {
 		names[i] = strings.TrimSpace(split[i])
 	}
+	if err := checkNames(cfg, names); err != nil {
+		return err
+	}
 	if l := len(names); l > 0 {
 		slog.Info("Parsed",
 			"namesNum", l,
@@ -158,25 +221,18 @@ func parsingResults(
 	}
 	data.Input = strings.Join(names, "\n")
 
-	opts := []gnparser.Option{
-		gnparser.OptWithDetails(data.WithDetails),
-		gnparser.OptWithPreserveDiaereses(data.PreserveDiaereses),
-		gnparser.OptWithCompactAuthors(data.CompactAuthors),
-		gnparser.OptWithFlatOutput(data.FlattenOutput),
-	}
-
-	if data.WithCultivars {
-		opts = append(opts, gnparser.OptCode(nomcode.Cultivars))
-	}
-
-	code := nomcode.New(data.Code)
-	if code != nomcode.Unknown {
-		// overrides data.WithCultivars
-		opts = append(opts, gnparser.OptCode(code))
+	opts, err := inp.Options()
+	if err != nil {
+		return badRequest(err)
 	}
 
 	gnp := gnps.ChangeConfig(opts...)
-	data.Parsed = gnp.ParseNames(names)
+	data.Parsed = parseNames(gnp, names, "WEB GUI")
+	if data.AST && data.Format == "html" {
+		for _, name := range names[:min(len(names), maxASTNames)] {
+			data.Trees = append(data.Trees, gnp.DebugTree(name))
+		}
+	}
 
 	switch data.Format {
 	case "json":
@@ -206,6 +262,13 @@ func parsingResults(
 func docAPI() func(echo.Context) error {
 	return func(c echo.Context) error {
 		data := newData(false)
+		// documentation links can carry any parameters (for example
+		// 'utm_source'), only the language is used
+		ro := gnparser.RequestOptions{Language: c.QueryParam("lang")}
+		if _, err := parsed.NewLanguage(ro.Language); err != nil {
+			return badRequest(err)
+		}
+		data.Lang = requestLanguage(c, &ro)
 		return c.Render(http.StatusOK, "layout", data)
 	}
 }
@@ -2,10 +2,15 @@ package web
 
 import (
 	"bytes"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"regexp"
+	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 
 	"github.com/gnames/gnfmt"
@@ -14,6 +19,7 @@ import (
 	"github.com/gnames/gnparser/ent/parsed"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func handlerGET(path string) (echo.Context, *httptest.ResponseRecorder) {
@@ -38,7 +44,7 @@ func TestHome(t *testing.T) {
 	e.Renderer, err = NewTemplate()
 	assert.Nil(t, err)
 
-	assert.Nil(t, homePOST(gnps)(c))
+	assert.Nil(t, homePOST(gnps, NewConfig())(c))
 	assert.Equal(t, http.StatusFound, rec.Code)
 }
 
@@ -107,7 +113,7 @@ func TestParseGET(t *testing.T) {
 	c.SetParamNames("names")
 	c.SetParamValues(namesQuery)
 
-	assert.Nil(t, parseNamesGET(gnps)(c))
+	assert.Nil(t, parseNamesGET(gnps, NewConfig())(c))
 
 	enc := gnfmt.GNjson{}
 	err := enc.Decode(rec.Body.Bytes(), &response)
@@ -154,7 +160,7 @@ func TestParseParamsGET(t *testing.T) {
 		c.SetParamNames("names")
 		c.SetParamValues(name)
 
-		assert.Nil(t, parseNamesGET(gnps)(c))
+		assert.Nil(t, parseNamesGET(gnps, NewConfig())(c))
 
 		body := rec.Body.String()
 		assert.True(t, strings.HasPrefix(body, v.startsWith))
@@ -183,12 +189,14 @@ func TestParsePOST(t *testing.T) {
 		"Sarracenia flava 'Maxima'",
 	}
 	params := inputREST{
-		Names:             names,
-		CSV:               false,
-		WithDetails:       false,
-		WithCultivars:     true,
-		PreserveDiaereses: true,
-		CompactAuthors:    true,
+		Names: names,
+		RequestOptions: gnparser.RequestOptions{
+			CSV:               false,
+			WithDetails:       false,
+			WithCultivars:     true,
+			PreserveDiaereses: true,
+			CompactAuthors:    true,
+		},
 	}
 	reqBody, err := gnfmt.GNjson{}.Encode(params)
 	assert.Nil(t, err)
@@ -199,7 +207,7 @@ func TestParsePOST(t *testing.T) {
 	e := echo.New()
 	c := e.NewContext(req, rec)
 
-	assert.Nil(t, parseNamesPOST(gnps)(c))
+	assert.Nil(t, parseNamesPOST(gnps, NewConfig())(c))
 
 	enc := gnfmt.GNjson{}
 	err = enc.Decode(rec.Body.Bytes(), &response)
@@ -228,12 +236,14 @@ func TestParsePOST(t *testing.T) {
 	}
 
 	params = inputREST{
-		Names:             names,
-		CSV:               true,
-		WithDetails:       false,
-		WithCultivars:     false,
-		PreserveDiaereses: false,
-		CompactAuthors:    false,
+		Names: names,
+		RequestOptions: gnparser.RequestOptions{
+			CSV:               true,
+			WithDetails:       false,
+			WithCultivars:     false,
+			PreserveDiaereses: false,
+			CompactAuthors:    false,
+		},
 	}
 	reqBody, err = gnfmt.GNjson{}.Encode(params)
 	r = bytes.NewReader(reqBody)
@@ -242,7 +252,7 @@ func TestParsePOST(t *testing.T) {
 	rec = httptest.NewRecorder()
 	c = e.NewContext(req, rec)
 	assert.Nil(t, err)
-	assert.Nil(t, parseNamesPOST(gnps)(c))
+	assert.Nil(t, parseNamesPOST(gnps, NewConfig())(c))
 	assert.True(t, strings.HasPrefix(rec.Body.String(), "Id"))
 }
 
@@ -256,10 +266,12 @@ func TestParsePOST_FlattenOutput(t *testing.T) {
 
 	// Test with FlattenOutput = true (flattened JSON)
 	params := inputREST{
-		Names:         names,
-		CSV:           false,
-		WithDetails:   false,
-		FlattenOutput: true,
+		Names: names,
+		RequestOptions: gnparser.RequestOptions{
+			CSV:           false,
+			WithDetails:   false,
+			FlattenOutput: true,
+		},
 	}
 	reqBody, err := gnfmt.GNjson{}.Encode(params)
 	assert.Nil(t, err)
@@ -270,7 +282,7 @@ func TestParsePOST_FlattenOutput(t *testing.T) {
 	e := echo.New()
 	c := e.NewContext(req, rec)
 
-	assert.Nil(t, parseNamesPOST(gnps)(c))
+	assert.Nil(t, parseNamesPOST(gnps, NewConfig())(c))
 
 	enc := gnfmt.GNjson{}
 	err = enc.Decode(rec.Body.Bytes(), &response)
@@ -289,10 +301,12 @@ func TestParsePOST_FlattenOutput(t *testing.T) {
 
 	// Test with FlattenOutput = false (nested JSON)
 	params = inputREST{
-		Names:         names,
-		CSV:           false,
-		WithDetails:   false,
-		FlattenOutput: false,
+		Names: names,
+		RequestOptions: gnparser.RequestOptions{
+			CSV:           false,
+			WithDetails:   false,
+			FlattenOutput: false,
+		},
 	}
 	reqBody, err = gnfmt.GNjson{}.Encode(params)
 	assert.Nil(t, err)
@@ -302,7 +316,7 @@ func TestParsePOST_FlattenOutput(t *testing.T) {
 	rec = httptest.NewRecorder()
 	c = e.NewContext(req, rec)
 
-	assert.Nil(t, parseNamesPOST(gnps)(c))
+	assert.Nil(t, parseNamesPOST(gnps, NewConfig())(c))
 
 	body = rec.Body.String()
 	// Nested output should contain "canonical" object
@@ -328,7 +342,7 @@ func TestParseGET_FlattenOutput(t *testing.T) {
 	c.SetParamNames("names")
 	c.SetParamValues(name)
 
-	assert.Nil(t, parseNamesGET(gnps)(c))
+	assert.Nil(t, parseNamesGET(gnps, NewConfig())(c))
 
 	body := rec.Body.String()
 	assert.NotContains(t, body, `"canonical":`)
@@ -342,7 +356,7 @@ func TestParseGET_FlattenOutput(t *testing.T) {
 	c.SetParamNames("names")
 	c.SetParamValues(name)
 
-	assert.Nil(t, parseNamesGET(gnps)(c))
+	assert.Nil(t, parseNamesGET(gnps, NewConfig())(c))
 
 	body = rec.Body.String()
 	assert.Contains(t, body, `"canonical":`)
@@ -358,10 +372,12 @@ func TestParsePOST_CSV_WithFlatOutput(t *testing.T) {
 
 	// Test CSV output without details (simple 10 fields)
 	params := inputREST{
-		Names:         names,
-		CSV:           true,
-		WithDetails:   false,
-		FlattenOutput: true,
+		Names: names,
+		RequestOptions: gnparser.RequestOptions{
+			CSV:           true,
+			WithDetails:   false,
+			FlattenOutput: true,
+		},
 	}
 	reqBody, err := gnfmt.GNjson{}.Encode(params)
 	assert.Nil(t, err)
@@ -372,7 +388,7 @@ func TestParsePOST_CSV_WithFlatOutput(t *testing.T) {
 	e := echo.New()
 	c := e.NewContext(req, rec)
 
-	assert.Nil(t, parseNamesPOST(gnps)(c))
+	assert.Nil(t, parseNamesPOST(gnps, NewConfig())(c))
 
 	body := rec.Body.String()
 	// CSV without details should have simple header (10 fields)
@@ -386,10 +402,12 @@ func TestParsePOST_CSV_WithFlatOutput(t *testing.T) {
 
 	// Test CSV with WithDetails=true (extended fields)
 	params = inputREST{
-		Names:         names,
-		CSV:           true,
-		WithDetails:   true,
-		FlattenOutput: false, // FlattenOutput is always true for CSV
+		Names: names,
+		RequestOptions: gnparser.RequestOptions{
+			CSV:           true,
+			WithDetails:   true,
+			FlattenOutput: false, // FlattenOutput is always true for CSV
+		},
 	}
 	reqBody, err = gnfmt.GNjson{}.Encode(params)
 	assert.Nil(t, err)
@@ -399,7 +417,7 @@ func TestParsePOST_CSV_WithFlatOutput(t *testing.T) {
 	rec = httptest.NewRecorder()
 	c = e.NewContext(req, rec)
 
-	assert.Nil(t, parseNamesPOST(gnps)(c))
+	assert.Nil(t, parseNamesPOST(gnps, NewConfig())(c))
 
 	body = rec.Body.String()
 	// Should include all extended fields when WithDetails=true
@@ -410,3 +428,594 @@ func TestParsePOST_CSV_WithFlatOutput(t *testing.T) {
 	assert.Contains(t, body, ",Infraspecies")
 	assert.Contains(t, body, "CultivarEpithet")
 }
+
+func TestParseGET_AllOptions(t *testing.T) {
+	cfg := gnparser.NewConfig(gnparser.OptFormat(gnfmt.CompactJSON))
+	gnp := gnparser.New(cfg)
+	gnps := NewGNparserService(gnp, 0)
+
+	tests := []struct {
+		msg, name, query, startsWith, contains string
+	}{
+		{"tsv", "Bubo bubo", "format=tsv", "Id\tVerbatim", "Bubo bubo"},
+		{"pretty", "Bubo bubo", "format=pretty", "[", "\n  \"parsed\": true"},
+		{
+			"capitalize", "bubo bubo", "capitalize=true",
+			"[", `"simple":"Bubo bubo"`,
+		},
+		{
+			"tags", "<i>Bubo bubo</i>", "ignore_tags=false",
+			"[", `"simple":"Bubo bubo"`,
+		},
+		{
+			"ignore tags", "<i>Bubo bubo</i>", "ignore_tags=true",
+			"[", `"parsed":false`,
+		},
+		{
+			"spGrCut", "Bubo bubo bubo", "species_group_cut=true",
+			"[", `"stemmed":"Bubo bub"`,
+		},
+		{
+			"suggestions", "Acer tiliaefolia L.", "suggestions=true",
+			"[", `"reference":"ICN Art. 60.10"`,
+		},
+		{
+			"gender", "Quercus rubrum L.", "gender_agreement=true",
+			"[", `"correction":"rubra"`,
+		},
+		{
+			"hierarchy", "Aus bus var. cus L.", "hierarchy=true",
+			"[", `"full":"Aus bus var. bus"},"isAutonym":true`,
+		},
+		{
+			"infer code", "Aus bus var. cus L.", "infer_code=true",
+			"[", `"nomenclaturalCodeInferred":{"code":"ICN"`,
+		},
+		{
+			"infer rank", "Pinaceae", "infer_rank=true",
+			"[", `"rank":"fam.","rankInferred":true`,
+		},
+	}
+
+	for _, v := range tests {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/?"+v.query, nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/:names")
+		c.SetParamNames("names")
+		c.SetParamValues(url.QueryEscape(v.name))
+
+		assert.Nil(t, parseNamesGET(gnps, NewConfig())(c), v.msg)
+		body := rec.Body.String()
+		assert.True(t, strings.HasPrefix(body, v.startsWith), v.msg)
+		assert.Contains(t, body, v.contains, v.msg)
+	}
+}
+
+func TestParseGET_BadOptions(t *testing.T) {
+	cfg := gnparser.NewConfig(gnparser.OptFormat(gnfmt.CompactJSON))
+	gnp := gnparser.New(cfg)
+	gnps := NewGNparserService(gnp, 0)
+
+	tests := []struct {
+		msg, query, errMsg string
+	}{
+		{"unknown", "details=true", "unknown parameters: details"},
+		{"bool", "flatten=yep", "parameter 'flatten'"},
+		{"format", "format=xml", "unknown format 'xml'"},
+		{"code", "code=abc", "unknown nomenclatural code 'abc'"},
+	}
+
+	for _, v := range tests {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/?"+v.query, nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/:names")
+		c.SetParamNames("names")
+		c.SetParamValues(url.QueryEscape("Bubo bubo"))
+
+		err := parseNamesGET(gnps, NewConfig())(c)
+		var httpErr *echo.HTTPError
+		assert.ErrorAs(t, err, &httpErr, v.msg)
+		assert.Equal(t, http.StatusBadRequest, httpErr.Code, v.msg)
+		apiErr, ok := httpErr.Message.(APIError)
+		assert.True(t, ok, v.msg)
+		assert.Equal(t, BadRequestErr, apiErr.Type, v.msg)
+		assert.Contains(t, apiErr.Message, v.errMsg, v.msg)
+	}
+}
+
+func TestParsePOST_UnknownField(t *testing.T) {
+	cfg := gnparser.NewConfig(gnparser.OptFormat(gnfmt.CompactJSON))
+	gnp := gnparser.New(cfg)
+	gnps := NewGNparserService(gnp, 0)
+
+	body := `{"names":["Bubo bubo"],"withDetail":true}`
+	req := httptest.NewRequest(http.MethodPost, "/api/v1", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+
+	err := parseNamesPOST(gnps, NewConfig())(c)
+	var httpErr *echo.HTTPError
+	assert.ErrorAs(t, err, &httpErr)
+	assert.Equal(t, http.StatusBadRequest, httpErr.Code)
+	assert.Contains(t, httpErr.Message.(APIError).Message, "withDetail")
+
+	body = `{"names":["Bubo bubo"],"format":"tsv","speciesGroupCut":true}`
+	req = httptest.NewRequest(http.MethodPost, "/api/v1", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec = httptest.NewRecorder()
+	c = echo.New().NewContext(req, rec)
+	assert.Nil(t, parseNamesPOST(gnps, NewConfig())(c))
+	assert.True(t, strings.HasPrefix(rec.Body.String(), "Id\tVerbatim"))
+}
+
+func TestHomeGET_Options(t *testing.T) {
+	cfg := gnparser.NewConfig(gnparser.OptFormat(gnfmt.CompactJSON))
+	gnp := gnparser.New(cfg)
+	gnps := NewGNparserService(gnp, 0)
+
+	q := make(url.Values)
+	q.Set("names", "bubo bubo")
+	q.Set("format", "json")
+	q.Set("capitalize", "on")
+	c, rec := handlerGET("/?" + q.Encode())
+	assert.Nil(t, homeGET(gnps, NewConfig())(c))
+	assert.Contains(t, rec.Body.String(), `"simple":"Bubo bubo"`)
+
+	q.Set("format", "html")
+	c, rec = handlerGET("/?" + q.Encode())
+	assert.Nil(t, homeGET(gnps, NewConfig())(c))
+	assert.Contains(t, rec.Body.String(), `id="capitalize" name="capitalize"`)
+
+	q.Set("names", "Aus bus Smith ex Jones")
+	q.Set("format", "json")
+	q.Set("quality_profile", "zoological")
+	c, rec = handlerGET("/?" + q.Encode())
+	assert.Nil(t, homeGET(gnps, NewConfig())(c))
+	assert.Contains(t, rec.Body.String(), `"qualityProfile":"zoological"`)
+
+	q.Set("format", "html")
+	c, rec = handlerGET("/?" + q.Encode())
+	assert.Nil(t, homeGET(gnps, NewConfig())(c))
+	assert.Contains(t, rec.Body.String(),
+		`value="zoological" selected="selected">Zoological`)
+
+	q.Set("colour", "on")
+	c, _ = handlerGET("/?" + q.Encode())
+	err := homeGET(gnps, NewConfig())(c)
+	var httpErr *echo.HTTPError
+	assert.ErrorAs(t, err, &httpErr)
+	assert.Equal(t, http.StatusBadRequest, httpErr.Code)
+
+	checkboxes := []struct {
+		param, title string
+	}{
+		{"suggestions", "Suggest corrected canonical forms"},
+		{"gender_agreement", "Warn about epithets"},
+		{"hierarchy", "Add genus, species and autonyms"},
+		{"infer_code", "Infer a nomenclatural code"},
+		{"infer_rank", "Add ranks to uninomials"},
+	}
+	for _, v := range checkboxes {
+		c, rec = handlerGET("/?names=Bubo+bubo&" + v.param + "=on")
+		assert.Nil(t, homeGET(gnps, NewConfig())(c), v.param)
+		assert.Contains(t, rec.Body.String(),
+			`name="`+v.param+`" title="`+v.title, v.param)
+	}
+}
+
+func TestHomeGET_AST(t *testing.T) {
+	cfg := gnparser.NewConfig(gnparser.OptFormat(gnfmt.CompactJSON))
+	gnp := gnparser.New(cfg)
+	gnps := NewGNparserService(gnp, 0)
+
+	q := make(url.Values)
+	q.Set("names", "Bubo bubo Linnaeus 1758")
+	q.Set("ast", "on")
+	c, rec := handlerGET("/?" + q.Encode())
+	assert.Nil(t, homeGET(gnps, NewConfig())(c))
+	body := rec.Body.String()
+	assert.Contains(t, body, "Syntax trees:")
+	assert.Contains(t, body, "<strong>SpeciesEpithet</strong>")
+	assert.Contains(t, body, "digraph SyntaxTree")
+}
+
+func TestDebugGET(t *testing.T) {
+	cfg := gnparser.NewConfig(gnparser.OptFormat(gnfmt.CompactJSON))
+	gnp := gnparser.New(cfg)
+	gnps := NewGNparserService(gnp, 0)
+
+	name := url.PathEscape("Bubo bubo L.")
+	c, rec := handlerGET("/api/v1/debug/" + name)
+	c.SetParamNames("name")
+	c.SetParamValues(name)
+	assert.Nil(t, debugGET(gnps, NewConfig())(c))
+
+	var res parsed.SyntaxTree
+	enc := gnfmt.GNjson{}
+	assert.Nil(t, enc.Decode(rec.Body.Bytes(), &res))
+	assert.Equal(t, "Bubo bubo L.", res.Verbatim)
+	assert.Equal(t, "SciName", res.Output.Rule)
+	assert.Equal(t, 12, res.Output.End)
+
+	c, rec = handlerGET("/api/v1/debug/" + name + "?format=dot")
+	c.SetParamNames("name")
+	c.SetParamValues(name)
+	assert.Nil(t, debugGET(gnps, NewConfig())(c))
+	assert.True(t, strings.HasPrefix(rec.Body.String(), "digraph SyntaxTree {"))
+
+	c, _ = handlerGET("/api/v1/debug/" + name + "?format=svg")
+	c.SetParamNames("name")
+	c.SetParamValues(name)
+	var httpErr *echo.HTTPError
+	assert.ErrorAs(t, debugGET(gnps, NewConfig())(c), &httpErr)
+	assert.Equal(t, http.StatusBadRequest, httpErr.Code)
+}
+
+// TestDebugConcurrent requests syntax trees in parallel. Run it with -race
+// flag (`just test` does it).
+func TestDebugConcurrent(t *testing.T) {
+	gnp := gnparser.New(gnparser.NewConfig())
+	gnps := NewGNparserService(gnp, 0)
+	e := echo.New()
+	e.Renderer, _ = NewTemplate()
+	e.GET("/", homeGET(gnps, NewConfig()))
+	e.GET("/api/v1/debug/:name", debugGET(gnps, NewConfig()))
+
+	paths := []string{
+		"/api/v1/debug/Bubo+bubo+L.",
+		"/api/v1/debug/Pleurosigma+vitrea+var.+kjellmanii",
+		"/?names=Aus+bus%0ACus+dus+Smith&ast=true",
+	}
+	get := func(path string) string {
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		return rec.Body.String()
+	}
+	want := make([]string, len(paths))
+	for i := range paths {
+		want[i] = get(paths[i])
+	}
+	assert.Contains(t, want[2], "SciName")
+
+	var wg sync.WaitGroup
+	var fails atomic.Int32
+	for i := range 8 {
+		wg.Go(func() {
+			for j := range 10 {
+				k := (i + j) % len(paths)
+				if get(paths[k]) != want[k] {
+					fails.Add(1)
+				}
+			}
+		})
+	}
+	wg.Wait()
+	assert.Zero(t, fails.Load())
+}
+
+func TestWarningsGET(t *testing.T) {
+	c, rec := handlerGET("/api/v1/warnings")
+	assert.Nil(t, warningsGET()(c))
+
+	var res []parsed.WarningInfo
+	enc := gnfmt.GNjson{}
+	assert.Nil(t, enc.Decode(rec.Body.Bytes(), &res))
+	assert.Equal(t, len(parsed.WarningInfos()), len(res))
+
+	c, rec = handlerGET("/api/v1/warnings/AUTH_EX_NOT_REQUIRED")
+	c.SetParamNames("code")
+	c.SetParamValues("AUTH_EX_NOT_REQUIRED")
+	assert.Nil(t, warningGET()(c))
+
+	var info parsed.WarningInfo
+	assert.Nil(t, enc.Decode(rec.Body.Bytes(), &info))
+	assert.Equal(t, parsed.AuthExWarn.String(), info.Message)
+	assert.Equal(t, 2, info.Quality)
+
+	c, _ = handlerGET("/api/v1/warnings/NOPE")
+	c.SetParamNames("code")
+	c.SetParamValues("NOPE")
+	var httpErr *echo.HTTPError
+	assert.ErrorAs(t, warningGET()(c), &httpErr)
+	assert.Equal(t, http.StatusNotFound, httpErr.Code)
+}
+
+func TestLanguage(t *testing.T) {
+	assert := assert.New(t)
+	cfg := gnparser.NewConfig(gnparser.OptFormat(gnfmt.CompactJSON))
+	gnp := gnparser.New(cfg)
+	gnps := NewGNparserService(gnp, 0)
+	name := url.PathEscape("Aus bus Smith, 1887: 12")
+
+	c, rec := handlerGET("/api/v1/" + name + "?lang=es")
+	c.SetParamNames("names")
+	c.SetParamValues(name)
+	assert.Nil(parseNamesGET(gnps, NewConfig())(c))
+	assert.Contains(rec.Body.String(), `"message":"Año con número de página"`)
+	assert.Contains(rec.Body.String(), `"code":"YEAR_WITH_PAGE"`)
+	assert.Equal("es", rec.Header().Get("Content-Language"))
+
+	c, rec = handlerGET("/api/v1/" + name)
+	c.Request().Header.Set("Accept-Language", "fr-CA,fr;q=0.9,en;q=0.8")
+	c.SetParamNames("names")
+	c.SetParamValues(name)
+	assert.Nil(parseNamesGET(gnps, NewConfig())(c))
+	assert.Contains(rec.Body.String(), `"message":"Année avec numéro de page"`)
+	assert.Equal("fr", rec.Header().Get("Content-Language"))
+
+	c, rec = handlerGET("/api/v1/" + name)
+	c.SetParamNames("names")
+	c.SetParamValues(name)
+	assert.Nil(parseNamesGET(gnps, NewConfig())(c))
+	assert.NotContains(rec.Body.String(), `"message"`)
+	assert.Equal("en", rec.Header().Get("Content-Language"))
+
+	c, rec = handlerGET("/?names=Bubo+bubo&lang=de")
+	assert.Nil(homeGET(gnps, NewConfig())(c))
+	assert.Contains(rec.Body.String(), `<html lang="de">`)
+	assert.Contains(rec.Body.String(), "Ergebnisse:")
+
+	var httpErr *echo.HTTPError
+	c, rec = handlerGET("/doc/api")
+	c.Request().Header.Set("Accept-Language", "pt-BR")
+	assert.Nil(docAPI()(c))
+	assert.Contains(rec.Body.String(), "Interface de programação de aplicações")
+
+	c, rec = handlerGET("/doc/api?lang=de&utm_source=news")
+	assert.Nil(docAPI()(c))
+	assert.Equal("de", rec.Header().Get("Content-Language"))
+
+	c, _ = handlerGET("/doc/api?lang=ru")
+	assert.ErrorAs(docAPI()(c), &httpErr)
+	assert.Equal(http.StatusBadRequest, httpErr.Code)
+
+	c, _ = handlerGET("/?names=Bubo+bubo&lang=ru")
+	assert.ErrorAs(homeGET(gnps, NewConfig())(c), &httpErr)
+	assert.Equal(http.StatusBadRequest, httpErr.Code)
+}
+
+// TestUICatalogs checks that every text of templates is translated, and
+// that catalogs do not contain texts that templates do not use.
+func TestUICatalogs(t *testing.T) {
+	re := regexp.MustCompile(`\bth? \$?\.Lang ("(?:[^"\\]|\\.)*")`)
+	texts := make(map[string]struct{})
+	entries, err := tmpls.ReadDir("templates")
+	require.Nil(t, err)
+	for _, v := range entries {
+		bs, err := tmpls.ReadFile("templates/" + v.Name())
+		require.Nil(t, err)
+		for _, m := range re.FindAllStringSubmatch(string(bs), -1) {
+			s, err := strconv.Unquote(m[1])
+			require.Nil(t, err)
+			texts[s] = struct{}{}
+		}
+	}
+	assert.Greater(t, len(texts), 50)
+
+	for lang, cat := range uiCatalogs {
+		for s := range texts {
+			assert.Contains(t, cat, s, lang)
+		}
+		assert.Equal(t, len(texts), len(cat), lang)
+	}
+}
+
+func TestProbes(t *testing.T) {
+	var ready atomic.Bool
+	c, rec := handlerGET("/healthz")
+	assert.Nil(t, live()(c))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	c, rec = handlerGET("/readyz")
+	assert.Nil(t, readiness(&ready)(c))
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+
+	ready.Store(true)
+	c, rec = handlerGET("/readyz")
+	assert.Nil(t, readiness(&ready)(c))
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestMetrics(t *testing.T) {
+	cfg := gnparser.NewConfig(gnparser.OptFormat(gnfmt.CompactJSON))
+	gnp := gnparser.New(cfg)
+	gnps := NewGNparserService(gnp, 0)
+
+	e := echo.New()
+	e.Use(mtr.middleware())
+	e.GET("/metrics", mtr.handler())
+	e.GET("/api/v1/:names", parseNamesGET(gnps, NewConfig()))
+
+	names := url.PathEscape("Bubo bubo|Bubo bubo L. 1758 #1|Not name")
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/"+names, nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	req = httptest.NewRequest(http.MethodGet, "/metrics", nil)
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	body := rec.Body.String()
+	tests := []string{
+		`gnparser_http_requests_total{code="200",method="GET",route="/api/v1/:names"}`,
+		`gnparser_http_request_duration_seconds_count{method="GET",route="/api/v1/:names"}`,
+		`gnparser_names_parsed_total{parsed_by="REST API"}`,
+		`gnparser_parse_quality_bucket{le="0"}`,
+		`gnparser_warnings_total{code="TAIL_UNPARSED"}`,
+		`gnparser_batch_size_count`,
+		`gnparser_names_in_flight 0`,
+	}
+	for _, v := range tests {
+		assert.Contains(t, body, v)
+	}
+}
+
+func TestConfig(t *testing.T) {
+	assert := assert.New(t)
+	cfg := NewConfig()
+	assert.Equal(Config{
+		MaxBodySize:   10 << 20,
+		MaxNameLength: 1_000,
+		MaxNames:      10_000,
+	}, cfg)
+
+	cfg = NewConfig(OptMaxNames(0), OptRateLimit(-1), OptRateBurst(3))
+	assert.Equal(10_000, cfg.MaxNames)
+	assert.Zero(cfg.RateLimit)
+	assert.Equal(3, cfg.RateBurst)
+
+	s, err := gnparser.NewSettingsFromYAML(strings.NewReader(
+		"webMaxNames: 5\nwebRateLimit: 2.5\nwithDetails: true",
+	))
+	require.Nil(t, err)
+	cfg = NewConfig(SettingsOptions(s)...)
+	assert.Equal(5, cfg.MaxNames)
+	assert.Equal(2.5, cfg.RateLimit)
+	assert.Equal(1_000, cfg.MaxNameLength)
+}
+
+func TestLimits(t *testing.T) {
+	gnp := gnparser.New(gnparser.NewConfig(gnparser.OptFormat(gnfmt.CompactJSON)))
+	gnps := NewGNparserService(gnp, 0)
+	cfg := NewConfig(
+		OptMaxNames(2),
+		OptMaxNameLength(20),
+		OptMaxBodySize(100),
+	)
+
+	e := echo.New()
+	e.Use(bodyLimit(cfg.MaxBodySize))
+	e.GET("/", homeGET(gnps, cfg))
+	e.POST("/", homePOST(gnps, cfg))
+	e.GET("/api/v1/:names", parseNamesGET(gnps, cfg))
+	e.POST("/api/v1", parseNamesPOST(gnps, cfg))
+
+	form := func(names ...string) string {
+		return url.Values{
+			"names":  {strings.Join(names, "\n")},
+			"format": {"json"},
+		}.Encode()
+	}
+
+	tests := []struct {
+		msg, method, path, body string
+		status                  int
+		errType                 ErrorType
+	}{
+		{"get ok", "GET", "/api/v1/Bubo+bubo|Parus+major", "", http.StatusOK, ""},
+		{
+			"get names", "GET", "/api/v1/Bubo+bubo|Parus+major|Aus+bus", "",
+			http.StatusRequestEntityTooLarge, TooManyNamesErr,
+		},
+		{
+			"get length", "GET", "/api/v1/Bubo+bubo+Linnaeus+1758", "",
+			http.StatusUnprocessableEntity, NameTooLongErr,
+		},
+		{
+			"post ok", "POST", "/api/v1", `{"names":["Bubo bubo"]}`,
+			http.StatusOK, "",
+		},
+		{
+			"post names", "POST", "/api/v1", `{"names":["Aus","Bus","Cus"]}`,
+			http.StatusRequestEntityTooLarge, TooManyNamesErr,
+		},
+		{
+			"post body", "POST", "/api/v1",
+			`{"names":["` + strings.Repeat("Aus bus", 20) + `"]}`,
+			http.StatusRequestEntityTooLarge, BodyTooLargeErr,
+		},
+		{"form get ok", "GET", "/?" + form("Aus", "Bus"), "", http.StatusOK, ""},
+		{
+			"form get names", "GET", "/?" + form("Aus", "Bus", "Cus"), "",
+			http.StatusRequestEntityTooLarge, TooManyNamesErr,
+		},
+		{
+			"form get length", "GET", "/?" + form("Bubo bubo Linnaeus 1758"), "",
+			http.StatusUnprocessableEntity, NameTooLongErr,
+		},
+	}
+
+	for _, v := range tests {
+		var body io.Reader
+		if v.body != "" {
+			body = strings.NewReader(v.body)
+		}
+		req := httptest.NewRequest(v.method, v.path, body)
+		if v.method == "POST" {
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		}
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		assert.Equal(t, v.status, rec.Code, v.msg)
+		if v.errType == "" {
+			continue
+		}
+		var apiErr APIError
+		err := gnfmt.GNjson{}.Decode(rec.Body.Bytes(), &apiErr)
+		assert.Nil(t, err, v.msg)
+		assert.Equal(t, v.status, apiErr.Status, v.msg)
+		assert.Equal(t, v.errType, apiErr.Type, v.msg)
+		assert.Greater(t, apiErr.Limit, int64(0), v.msg)
+	}
+
+	// a form with more than 20 names is parsed by POST without a redirect
+	cfg = NewConfig(OptMaxNames(20))
+	e = echo.New()
+	e.POST("/", homePOST(gnps, cfg))
+	names := strings.TrimSuffix(strings.Repeat("Aus bus\n", 21), "\n")
+	req := httptest.NewRequest(
+		http.MethodPost, "/", strings.NewReader(form(names)),
+	)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationForm)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+}
+
+func TestRateLimit(t *testing.T) {
+	gnp := gnparser.New(gnparser.NewConfig(gnparser.OptFormat(gnfmt.CompactJSON)))
+	gnps := NewGNparserService(gnp, 0)
+	cfg := NewConfig(OptRateLimit(0.01), OptRateBurst(2))
+
+	assert.Nil(t, rateLimiter(NewConfig()))
+
+	e := echo.New()
+	e.Use(rateLimiter(cfg))
+	e.GET("/healthz", live())
+	e.GET("/api/v1/:names", parseNamesGET(gnps, cfg))
+
+	codes := make([]int, 3)
+	for i := range codes {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/Bubo+bubo", nil)
+		// a client cannot bypass the limit by changing forwarding headers
+		req.Header.Set(echo.HeaderXForwardedFor, "10.0.0."+strconv.Itoa(i))
+		req.Header.Set(echo.HeaderXRealIP, "10.0.1."+strconv.Itoa(i))
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		codes[i] = rec.Code
+		if rec.Code == http.StatusTooManyRequests {
+			assert.Equal(t, "100", rec.Header().Get("Retry-After"))
+			assert.Contains(t, rec.Body.String(), string(RateLimitErr))
+		}
+	}
+	assert.Equal(t, []int{200, 200, 429}, codes)
+
+	// another connection has its own limit
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/Bubo+bubo", nil)
+	req.RemoteAddr = "192.0.2.2:1234"
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	// monitoring endpoints are not limited
+	req = httptest.NewRequest(http.MethodGet, "/healthz", nil)
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
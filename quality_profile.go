@@ -0,0 +1,83 @@
+package gnparser
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/gnames/gnparser/ent/parsed"
+	"gopkg.in/yaml.v3"
+)
+
+// qualityProfileYAML is the structure of a quality profile file:
+//
+//	name: my-project
+//	base: zoological
+//	warnings:
+//	  Year with page info: 1
+//	  Author in upper case: 3
+type qualityProfileYAML struct {
+	Name     string         `yaml:"name"`
+	Base     string         `yaml:"base"`
+	Warnings map[string]int `yaml:"warnings"`
+}
+
+// NewQualityProfileFromYAML reads a quality profile from a YAML document.
+// The document contains the name of the profile, the built-in profile it
+// is based on, and qualities of warnings keyed by their messages.
+func NewQualityProfileFromYAML(r io.Reader) (parsed.QualityProfile, error) {
+	return readQualityProfile(r, "")
+}
+
+// NewQualityProfileFromFile reads a quality profile from a YAML file. If
+// the profile has no name, the name of the file without its extension is
+// used.
+func NewQualityProfileFromFile(path string) (parsed.QualityProfile, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return parsed.QualityProfile{}, err
+	}
+	defer f.Close()
+
+	base := filepath.Base(path)
+	name := strings.TrimSuffix(base, filepath.Ext(base))
+	res, err := readQualityProfile(f, name)
+	if err != nil {
+		return res, fmt.Errorf("%s: %w", path, err)
+	}
+	return res, nil
+}
+
+func readQualityProfile(
+	r io.Reader,
+	name string,
+) (parsed.QualityProfile, error) {
+	var qpy qualityProfileYAML
+	dec := yaml.NewDecoder(r)
+	dec.KnownFields(true)
+	if err := dec.Decode(&qpy); err != nil && !errors.Is(err, io.EOF) {
+		return parsed.QualityProfile{},
+			fmt.Errorf("cannot read quality profile: %w", err)
+	}
+	if qpy.Name == "" {
+		qpy.Name = name
+	}
+	return parsed.NewQualityProfile(qpy.Name, qpy.Base, qpy.Warnings)
+}
+
+// LoadQualityProfile returns a built-in quality profile by its name, or
+// reads a profile from a file if there is no built-in profile with such
+// name.
+func LoadQualityProfile(nameOrPath string) (parsed.QualityProfile, error) {
+	res, err := parsed.NewQualityProfileByName(nameOrPath)
+	if err == nil {
+		return res, nil
+	}
+	if _, statErr := os.Stat(nameOrPath); statErr != nil {
+		return res, err
+	}
+	return NewQualityProfileFromFile(nameOrPath)
+}
@@ -0,0 +1,339 @@
+package gnparser
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/gnames/gnfmt"
+	"github.com/gnames/gnlib/ent/nomcode"
+	"github.com/gnames/gnparser/ent/parsed"
+)
+
+// RequestOptions is a serializable set of settings that clients of
+// gnparser services (REST API, web-form etc.) can send with their names.
+// It covers all parts of Config that change parsing results or their
+// output. Settings that belong to the service itself (port, number of
+// jobs, batch size) are not included.
+type RequestOptions struct {
+	// Format is the output format: 'csv', 'tsv', 'compact' or 'pretty'.
+	// 'json' is accepted as an alias of 'compact'.
+	Format string `json:"format,omitempty"`
+
+	// CSV sets output to CSV. It is kept for backward compatibility,
+	// Format takes precedence over it.
+	CSV bool `json:"csv,omitempty"`
+
+	// Code is a nomenclatural code ('zoological', 'botanical', 'bacterial',
+	// 'cultivar', 'viral' or their abbreviations).
+	Code string `json:"code,omitempty"`
+
+	// QualityProfile is the name of a built-in profile that assigns
+	// qualities to warnings ('default' or a nomenclatural code).
+	QualityProfile string `json:"qualityProfile,omitempty"`
+
+	// Language is the language of warning messages and labels of word
+	// types ('en', 'es', 'pt', 'fr', 'de' or a tag like 'pt-BR').
+	Language string `json:"language,omitempty"`
+
+	// WithCultivars is deprecated by Code and overriden by it.
+	WithCultivars bool `json:"withCultivars,omitempty"`
+
+	// WithDetails adds details and words to the output.
+	WithDetails bool `json:"withDetails,omitempty"`
+
+	// Capitalize capitalizes the first letter of a name-string.
+	Capitalize bool `json:"capitalize,omitempty"`
+
+	// IgnoreHTMLTags skips removal of HTML tags and entities from
+	// name-strings. It makes parsing faster when there are no tags.
+	IgnoreHTMLTags bool `json:"ignoreHTMLTags,omitempty"`
+
+	// PreserveDiaereses keeps diaereses in canonical forms.
+	PreserveDiaereses bool `json:"preserveDiaereses,omitempty"`
+
+	// CompactAuthors removes spaces between authors' initials.
+	CompactAuthors bool `json:"compactAuthors,omitempty"`
+
+	// FlattenOutput converts nested JSON output to a flat one.
+	FlattenOutput bool `json:"flattenOutput,omitempty"`
+
+	// SpeciesGroupCut truncates stemmed autonyms and species group names
+	// to species.
+	SpeciesGroupCut bool `json:"speciesGroupCut,omitempty"`
+
+	// Unordered allows results to come in a different order than input.
+	Unordered bool `json:"unordered,omitempty"`
+
+	// WithSuggestions adds corrected canonical forms according to
+	// orthographic rules of nomenclatural codes.
+	WithSuggestions bool `json:"withSuggestions,omitempty"`
+
+	// GenderAgreement adds warnings for epithets that do not agree in
+	// gender with their genus.
+	GenderAgreement bool `json:"genderAgreement,omitempty"`
+
+	// WithHierarchy adds names implied by a name (genus, species,
+	// autonyms) to the output.
+	WithHierarchy bool `json:"withHierarchy,omitempty"`
+
+	// WithCodeInference adds a nomenclatural code the name probably
+	// belongs to, with the evidence for it.
+	WithCodeInference bool `json:"withCodeInference,omitempty"`
+
+	// WithRankInference adds ranks to uninomials with standard endings of
+	// ranks.
+	WithRankInference bool `json:"withRankInference,omitempty"`
+}
+
+// requestParams maps names of URL parameters to fields of RequestOptions.
+// The same names are used by GET queries of REST API and by HTML forms.
+var requestParams = map[string]func(*RequestOptions) any{
+	"format":            func(ro *RequestOptions) any { return &ro.Format },
+	"csv":               func(ro *RequestOptions) any { return &ro.CSV },
+	"code":              func(ro *RequestOptions) any { return &ro.Code },
+	"quality_profile":   func(ro *RequestOptions) any { return &ro.QualityProfile },
+	"lang":              func(ro *RequestOptions) any { return &ro.Language },
+	"cultivars":         func(ro *RequestOptions) any { return &ro.WithCultivars },
+	"with_details":      func(ro *RequestOptions) any { return &ro.WithDetails },
+	"capitalize":        func(ro *RequestOptions) any { return &ro.Capitalize },
+	"ignore_tags":       func(ro *RequestOptions) any { return &ro.IgnoreHTMLTags },
+	"diaereses":         func(ro *RequestOptions) any { return &ro.PreserveDiaereses },
+	"compact_authors":   func(ro *RequestOptions) any { return &ro.CompactAuthors },
+	"flatten":           func(ro *RequestOptions) any { return &ro.FlattenOutput },
+	"species_group_cut": func(ro *RequestOptions) any { return &ro.SpeciesGroupCut },
+	"unordered":         func(ro *RequestOptions) any { return &ro.Unordered },
+	"suggestions":       func(ro *RequestOptions) any { return &ro.WithSuggestions },
+	"gender_agreement":  func(ro *RequestOptions) any { return &ro.GenderAgreement },
+	"hierarchy":         func(ro *RequestOptions) any { return &ro.WithHierarchy },
+	"infer_code":        func(ro *RequestOptions) any { return &ro.WithCodeInference },
+	"infer_rank":        func(ro *RequestOptions) any { return &ro.WithRankInference },
+}
+
+// NewRequestOptions creates RequestOptions from URL query or HTML form
+// values. Parameters listed in skip are not treated as options (for
+// example 'names'). Unknown parameters and values that cannot be
+// converted are reported by the returned error.
+func NewRequestOptions(
+	vals url.Values,
+	skip ...string,
+) (RequestOptions, error) {
+	var res RequestOptions
+	var unknown []string
+	var errs []error
+
+	keys := make([]string, 0, len(vals))
+	for k := range vals {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	for _, k := range keys {
+		if slices.Contains(skip, k) {
+			continue
+		}
+		field, ok := requestParams[k]
+		if !ok {
+			unknown = append(unknown, k)
+			continue
+		}
+		v := strings.TrimSpace(vals.Get(k))
+		switch f := field(&res).(type) {
+		case *string:
+			*f = v
+		case *bool:
+			b, err := parseBool(v)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("parameter '%s': %w", k, err))
+				continue
+			}
+			*f = b
+		}
+	}
+
+	if len(unknown) > 0 {
+		err := fmt.Errorf("unknown parameters: %s", strings.Join(unknown, ", "))
+		errs = append([]error{err}, errs...)
+	}
+	if err := res.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+
+	return res, joinErrors(errs)
+}
+
+// NewRequestOptionsFromConfig creates RequestOptions that correspond to
+// the settings of a Config.
+func NewRequestOptionsFromConfig(cfg Config) RequestOptions {
+	res := RequestOptions{
+		Code:              cfg.Code.String(),
+		Language:          cfg.Language,
+		WithDetails:       cfg.WithDetails,
+		Capitalize:        cfg.WithCapitalization,
+		IgnoreHTMLTags:    cfg.IgnoreHTMLTags,
+		PreserveDiaereses: cfg.WithPreserveDiaereses,
+		CompactAuthors:    cfg.WithCompactAuthors,
+		FlattenOutput:     cfg.WithFlatOutput,
+		SpeciesGroupCut:   cfg.WithSpeciesGroupCut,
+		Unordered:         cfg.WithNoOrder,
+		WithSuggestions:   cfg.WithSuggestions,
+		GenderAgreement:   cfg.WithGenderAgreement,
+		WithHierarchy:     cfg.WithHierarchy,
+		WithCodeInference: cfg.WithCodeInference,
+		WithRankInference: cfg.WithRankInference,
+	}
+	// profiles from files cannot be selected by name.
+	if qp := cfg.QualityProfile; !qp.IsDefault() {
+		if _, err := parsed.NewQualityProfileByName(qp.Name); err == nil {
+			res.QualityProfile = qp.Name
+		}
+	}
+	switch cfg.Format {
+	case gnfmt.CSV:
+		res.Format = "csv"
+	case gnfmt.TSV:
+		res.Format = "tsv"
+	case gnfmt.CompactJSON:
+		res.Format = "compact"
+	case gnfmt.PrettyJSON:
+		res.Format = "pretty"
+	}
+	return res
+}
+
+// Values converts RequestOptions to URL values. Only options that differ
+// from their defaults are included.
+func (ro RequestOptions) Values() url.Values {
+	res := make(url.Values)
+	for k, field := range requestParams {
+		switch f := field(&ro).(type) {
+		case *string:
+			if *f != "" {
+				res.Set(k, *f)
+			}
+		case *bool:
+			if *f {
+				res.Set(k, "true")
+			}
+		}
+	}
+	return res
+}
+
+// Validate checks if Format, Code, QualityProfile and Language fields
+// contain values that can be used for parsing.
+func (ro RequestOptions) Validate() error {
+	var errs []error
+	if _, err := ro.format(); err != nil {
+		errs = append(errs, err)
+	}
+	if _, err := ro.code(); err != nil {
+		errs = append(errs, err)
+	}
+	if _, err := parsed.NewQualityProfileByName(ro.QualityProfile); err != nil {
+		errs = append(errs, err)
+	}
+	if _, err := parsed.NewLanguage(ro.Language); err != nil {
+		errs = append(errs, err)
+	}
+	return joinErrors(errs)
+}
+
+// Options converts RequestOptions to a slice of Option functions. All
+// settings are set explicitly, so the result does not depend on the
+// previous configuration of GNparser. The output format is set only if
+// it is given by Format or CSV fields.
+func (ro RequestOptions) Options() ([]Option, error) {
+	if err := ro.Validate(); err != nil {
+		return nil, err
+	}
+	code, _ := ro.code()
+	qp, _ := parsed.NewQualityProfileByName(ro.QualityProfile)
+	res := []Option{
+		OptCode(code),
+		OptQualityProfile(qp),
+		OptLanguage(ro.Language),
+		OptWithDetails(ro.WithDetails),
+		OptWithCapitaliation(ro.Capitalize),
+		OptIgnoreHTMLTags(ro.IgnoreHTMLTags),
+		OptWithPreserveDiaereses(ro.PreserveDiaereses),
+		OptWithCompactAuthors(ro.CompactAuthors),
+		OptWithFlatOutput(ro.FlattenOutput),
+		OptWithSpeciesGroupCut(ro.SpeciesGroupCut),
+		OptWithNoOrder(ro.Unordered),
+		OptWithSuggestions(ro.WithSuggestions),
+		OptWithGenderAgreement(ro.GenderAgreement),
+		OptWithHierarchy(ro.WithHierarchy),
+		OptWithCodeInference(ro.WithCodeInference),
+		OptWithRankInference(ro.WithRankInference),
+	}
+
+	if f, _ := ro.format(); f != gnfmt.FormatNone {
+		res = append(res, OptFormat(f))
+	}
+	return res, nil
+}
+
+func (ro RequestOptions) format() (gnfmt.Format, error) {
+	switch ro.Format {
+	case "":
+		if ro.CSV {
+			return gnfmt.CSV, nil
+		}
+		return gnfmt.FormatNone, nil
+	case "json":
+		return gnfmt.CompactJSON, nil
+	}
+	f, err := gnfmt.NewFormat(ro.Format)
+	if err != nil {
+		return f, fmt.Errorf(
+			"unknown format '%s', use 'csv', 'tsv', 'compact' or 'pretty'",
+			ro.Format,
+		)
+	}
+	return f, nil
+}
+
+func (ro RequestOptions) code() (nomcode.Code, error) {
+	if ro.Code == "" || ro.Code == "any" {
+		if ro.WithCultivars {
+			return nomcode.Cultivars, nil
+		}
+		return nomcode.Unknown, nil
+	}
+	code := nomcode.New(ro.Code)
+	if code == nomcode.Unknown {
+		return code, fmt.Errorf("unknown nomenclatural code '%s'", ro.Code)
+	}
+	return code, nil
+}
+
+// parseBool understands values sent by HTML checkboxes in addition to
+// values accepted by strconv.ParseBool.
+func parseBool(s string) (bool, error) {
+	switch strings.ToLower(s) {
+	case "", "off", "no":
+		return false, nil
+	case "on", "yes":
+		return true, nil
+	}
+	b, err := strconv.ParseBool(s)
+	if err != nil {
+		return false, fmt.Errorf("cannot convert '%s' to boolean", s)
+	}
+	return b, nil
+}
+
+func joinErrors(errs []error) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	msgs := make([]string, len(errs))
+	for i := range errs {
+		msgs[i] = errs[i].Error()
+	}
+	return errors.New(strings.Join(msgs, "; "))
+}
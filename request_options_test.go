@@ -0,0 +1,204 @@
+package gnparser_test
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/gnames/gnfmt"
+	"github.com/gnames/gnlib/ent/nomcode"
+	"github.com/gnames/gnparser"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRequestOptions(t *testing.T) {
+	assert := assert.New(t)
+	tests := []struct {
+		msg    string
+		query  string
+		skip   []string
+		res    gnparser.RequestOptions
+		hasErr bool
+	}{
+		{"empty", "", nil, gnparser.RequestOptions{}, false},
+		{
+			"all",
+			"format=tsv&code=bot&with_details=true&capitalize=on&ignore_tags=1" +
+				"&diaereses=true&compact_authors=true&flatten=true" +
+				"&species_group_cut=true&unordered=true",
+			nil,
+			gnparser.RequestOptions{
+				Format:            "tsv",
+				Code:              "bot",
+				WithDetails:       true,
+				Capitalize:        true,
+				IgnoreHTMLTags:    true,
+				PreserveDiaereses: true,
+				CompactAuthors:    true,
+				FlattenOutput:     true,
+				SpeciesGroupCut:   true,
+				Unordered:         true,
+			},
+			false,
+		},
+		{"off", "with_details=off", nil, gnparser.RequestOptions{}, false},
+		{
+			"skip", "names=Bubo&csv=true", []string{"names"},
+			gnparser.RequestOptions{CSV: true}, false,
+		},
+		{"unknown", "names=Bubo", nil, gnparser.RequestOptions{}, true},
+		{"bad bool", "flatten=maybe", nil, gnparser.RequestOptions{}, true},
+		{"bad code", "code=xyz", nil, gnparser.RequestOptions{Code: "xyz"}, true},
+		{
+			"bad format", "format=xml", nil,
+			gnparser.RequestOptions{Format: "xml"}, true,
+		},
+		{"lang", "lang=pt-BR", nil, gnparser.RequestOptions{Language: "pt-BR"}, false},
+		{"bad lang", "lang=ru", nil, gnparser.RequestOptions{Language: "ru"}, true},
+	}
+
+	for _, v := range tests {
+		q, err := url.ParseQuery(v.query)
+		assert.Nil(err)
+		res, err := gnparser.NewRequestOptions(q, v.skip...)
+		assert.Equal(v.hasErr, err != nil, v.msg)
+		assert.Equal(v.res, res, v.msg)
+	}
+}
+
+func TestRequestOptionsValues(t *testing.T) {
+	assert := assert.New(t)
+	ro := gnparser.RequestOptions{
+		Format:          "pretty",
+		Code:            "zoo",
+		WithDetails:     true,
+		SpeciesGroupCut: true,
+	}
+	vals := ro.Values()
+	assert.Equal(4, len(vals))
+	ro2, err := gnparser.NewRequestOptions(vals)
+	assert.Nil(err)
+	assert.Equal(ro, ro2)
+}
+
+func TestRequestOptionsOptions(t *testing.T) {
+	assert := assert.New(t)
+	ro := gnparser.RequestOptions{
+		Format:          "json",
+		WithCultivars:   true,
+		Capitalize:      true,
+		IgnoreHTMLTags:  true,
+		SpeciesGroupCut: true,
+		Unordered:       true,
+	}
+	opts, err := ro.Options()
+	assert.Nil(err)
+	cfg := gnparser.NewConfig(opts...)
+	assert.Equal(gnfmt.CompactJSON, cfg.Format)
+	assert.Equal(nomcode.Cultivars, cfg.Code)
+	assert.True(cfg.WithCapitalization)
+	assert.True(cfg.IgnoreHTMLTags)
+	assert.True(cfg.WithSpeciesGroupCut)
+	assert.True(cfg.WithNoOrder)
+
+	// code overrides cultivars
+	ro = gnparser.RequestOptions{Code: "zoo", WithCultivars: true, CSV: true}
+	opts, err = ro.Options()
+	assert.Nil(err)
+	cfg = gnparser.NewConfig(opts...)
+	assert.Equal(nomcode.Zoological, cfg.Code)
+	assert.Equal(gnfmt.CSV, cfg.Format)
+
+	// format is not changed if not given
+	ro = gnparser.RequestOptions{}
+	opts, err = ro.Options()
+	assert.Nil(err)
+	cfg = gnparser.NewConfig(append(
+		[]gnparser.Option{gnparser.OptFormat(gnfmt.PrettyJSON)}, opts...,
+	)...)
+	assert.Equal(gnfmt.PrettyJSON, cfg.Format)
+
+	ro = gnparser.RequestOptions{Code: "xyz"}
+	_, err = ro.Options()
+	assert.NotNil(err)
+
+	ro = gnparser.RequestOptions{QualityProfile: "zoo"}
+	opts, err = ro.Options()
+	assert.Nil(err)
+	cfg = gnparser.NewConfig(opts...)
+	assert.Equal("zoological", cfg.QualityProfile.Name)
+
+	ro = gnparser.RequestOptions{QualityProfile: "viral"}
+	_, err = ro.Options()
+	assert.NotNil(err)
+}
+
+func TestNewRequestOptionsFromConfig(t *testing.T) {
+	assert := assert.New(t)
+	cfg := gnparser.NewConfig(
+		gnparser.OptFormat(gnfmt.PrettyJSON),
+		gnparser.OptCode(nomcode.Botanical),
+		gnparser.OptWithDetails(true),
+		gnparser.OptWithCompactAuthors(true),
+	)
+	ro := gnparser.NewRequestOptionsFromConfig(cfg)
+	assert.Equal(gnparser.RequestOptions{
+		Format:         "pretty",
+		Code:           "botanical",
+		WithDetails:    true,
+		CompactAuthors: true,
+	}, ro)
+
+	// conversion back restores the same settings
+	opts, err := ro.Options()
+	assert.Nil(err)
+	assert.Equal(cfg, gnparser.NewConfig(opts...))
+
+	qp, err := gnparser.LoadQualityProfile("botanical")
+	assert.Nil(err)
+	cfg = gnparser.NewConfig(gnparser.OptQualityProfile(qp))
+	ro = gnparser.NewRequestOptionsFromConfig(cfg)
+	assert.Equal("botanical", ro.QualityProfile)
+	opts, err = ro.Options()
+	assert.Nil(err)
+	assert.Equal(cfg, gnparser.NewConfig(opts...))
+}
+
+// featureOpts are options that add optional parts to the output. They
+// are off by default, and can be set by query parameters and environment
+// variables.
+var featureOpts = []struct {
+	param, env string
+	isSet      func(gnparser.Config) bool
+}{
+	{"suggestions", "GNPARSER_WITH_SUGGESTIONS",
+		func(cfg gnparser.Config) bool { return cfg.WithSuggestions }},
+	{"gender_agreement", "GNPARSER_WITH_GENDER_AGREEMENT",
+		func(cfg gnparser.Config) bool { return cfg.WithGenderAgreement }},
+	{"hierarchy", "GNPARSER_WITH_HIERARCHY",
+		func(cfg gnparser.Config) bool { return cfg.WithHierarchy }},
+	{"infer_code", "GNPARSER_WITH_CODE_INFERENCE",
+		func(cfg gnparser.Config) bool { return cfg.WithCodeInference }},
+	{"infer_rank", "GNPARSER_WITH_RANK_INFERENCE",
+		func(cfg gnparser.Config) bool { return cfg.WithRankInference }},
+}
+
+func TestFeatureOptions(t *testing.T) {
+	assert := assert.New(t)
+	for _, v := range featureOpts {
+		assert.False(v.isSet(gnparser.NewConfig()), v.param)
+
+		ro, err := gnparser.NewRequestOptions(url.Values{v.param: {"true"}})
+		assert.Nil(err, v.param)
+		opts, err := ro.Options()
+		assert.Nil(err, v.param)
+		assert.True(v.isSet(gnparser.NewConfig(opts...)), v.param)
+		ro = gnparser.NewRequestOptionsFromConfig(gnparser.NewConfig(opts...))
+		assert.Equal("true", ro.Values().Get(v.param), v.param)
+
+		s, err := gnparser.NewSettingsFromEnv([]string{v.env + "=true"})
+		assert.Nil(err, v.env)
+		opts, err = s.Options()
+		assert.Nil(err, v.env)
+		assert.True(v.isSet(gnparser.NewConfig(opts...)), v.env)
+	}
+}
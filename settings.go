@@ -0,0 +1,334 @@
+package gnparser
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/gnames/gnfmt"
+	"github.com/gnames/gnparser/ent/parsed"
+	"gopkg.in/yaml.v3"
+)
+
+// EnvPrefix starts names of environment variables with gnparser settings.
+const EnvPrefix = "GNPARSER_"
+
+// Settings are persistent gnparser settings that come from a config file
+// or environment variables. Only settings that are given are not nil, so
+// settings from several sources can be applied one after another:
+//
+//	defaults < config file < environment variables < command line flags
+type Settings struct {
+	// Format is the output format: 'csv', 'tsv', 'compact' or 'pretty'.
+	// Command line app also accepts 'parquet'.
+	Format *string `yaml:"format"`
+
+	// Code is a nomenclatural code ('zoological', 'botanical', 'bacterial',
+	// 'cultivar', 'viral' or their abbreviations).
+	Code *string `yaml:"code"`
+
+	// QualityProfile is the name of a built-in quality profile ('default'
+	// or a nomenclatural code), or a path to a YAML file with a profile.
+	QualityProfile *string `yaml:"qualityProfile"`
+
+	// Language is the language of warning messages and labels of word
+	// types.
+	Language *string `yaml:"language"`
+
+	// WithDetails adds details and words to the output.
+	WithDetails *bool `yaml:"withDetails"`
+
+	// Capitalize capitalizes the first letter of a name-string.
+	Capitalize *bool `yaml:"capitalize"`
+
+	// IgnoreHTMLTags skips removal of HTML tags and entities.
+	IgnoreHTMLTags *bool `yaml:"ignoreHTMLTags"`
+
+	// PreserveDiaereses keeps diaereses in canonical forms.
+	PreserveDiaereses *bool `yaml:"preserveDiaereses"`
+
+	// CompactAuthors removes spaces between authors' initials.
+	CompactAuthors *bool `yaml:"compactAuthors"`
+
+	// FlattenOutput converts nested JSON output to a flat one.
+	FlattenOutput *bool `yaml:"flattenOutput"`
+
+	// SpeciesGroupCut truncates stemmed autonyms and species group names
+	// to species.
+	SpeciesGroupCut *bool `yaml:"speciesGroupCut"`
+
+	// Unordered allows results to come in a different order than input.
+	Unordered *bool `yaml:"unordered"`
+
+	// WithSuggestions adds corrected canonical forms according to
+	// orthographic rules of nomenclatural codes.
+	WithSuggestions *bool `yaml:"withSuggestions"`
+
+	// WithGenderAgreement adds warnings for epithets that do not agree in
+	// gender with their genus.
+	WithGenderAgreement *bool `yaml:"withGenderAgreement"`
+
+	// WithHierarchy adds names implied by a name (genus, species,
+	// autonyms) to the output.
+	WithHierarchy *bool `yaml:"withHierarchy"`
+
+	// WithCodeInference adds a nomenclatural code the name probably
+	// belongs to, with the evidence for it.
+	WithCodeInference *bool `yaml:"withCodeInference"`
+
+	// WithRankInference adds ranks to uninomials with standard endings of
+	// ranks.
+	WithRankInference *bool `yaml:"withRankInference"`
+
+	// GenderDict is a path to a file with genders of genera. They are
+	// added to the built-in dictionary of the gender agreement check.
+	GenderDict *string `yaml:"genderDict"`
+
+	// Stream parses one name at a time instead of batches.
+	Stream *bool `yaml:"stream"`
+
+	// JobsNum is the number of concurrent parsing jobs.
+	JobsNum *int `yaml:"jobsNum"`
+
+	// BatchSize is the maximum number of names in a batch.
+	BatchSize *int `yaml:"batchSize"`
+
+	// Port of the web-service.
+	Port *int `yaml:"port"`
+
+	// GRPCPort of the gRPC service.
+	GRPCPort *int `yaml:"grpcPort"`
+
+	// WebMaxNames is the maximum number of names in a request.
+	WebMaxNames *int `yaml:"webMaxNames"`
+
+	// WebMaxNameLength is the maximum length of a name in bytes.
+	WebMaxNameLength *int `yaml:"webMaxNameLength"`
+
+	// WebMaxBodySize is the maximum size of a request body in bytes.
+	WebMaxBodySize *int64 `yaml:"webMaxBodySize"`
+
+	// WebRateLimit is the number of requests per second from one client.
+	WebRateLimit *float64 `yaml:"webRateLimit"`
+
+	// WebRateBurst is the number of requests a client can send at once.
+	WebRateBurst *int `yaml:"webRateBurst"`
+}
+
+// settingsEnv maps names of environment variables (without EnvPrefix) to
+// fields of Settings.
+var settingsEnv = map[string]func(*Settings) any{
+	"FORMAT":                func(s *Settings) any { return &s.Format },
+	"CODE":                  func(s *Settings) any { return &s.Code },
+	"QUALITY_PROFILE":       func(s *Settings) any { return &s.QualityProfile },
+	"LANGUAGE":              func(s *Settings) any { return &s.Language },
+	"WITH_DETAILS":          func(s *Settings) any { return &s.WithDetails },
+	"CAPITALIZE":            func(s *Settings) any { return &s.Capitalize },
+	"IGNORE_HTML_TAGS":      func(s *Settings) any { return &s.IgnoreHTMLTags },
+	"PRESERVE_DIAERESES":    func(s *Settings) any { return &s.PreserveDiaereses },
+	"COMPACT_AUTHORS":       func(s *Settings) any { return &s.CompactAuthors },
+	"FLATTEN_OUTPUT":        func(s *Settings) any { return &s.FlattenOutput },
+	"SPECIES_GROUP_CUT":     func(s *Settings) any { return &s.SpeciesGroupCut },
+	"UNORDERED":             func(s *Settings) any { return &s.Unordered },
+	"WITH_SUGGESTIONS":      func(s *Settings) any { return &s.WithSuggestions },
+	"WITH_GENDER_AGREEMENT": func(s *Settings) any { return &s.WithGenderAgreement },
+	"WITH_HIERARCHY":        func(s *Settings) any { return &s.WithHierarchy },
+	"WITH_CODE_INFERENCE":   func(s *Settings) any { return &s.WithCodeInference },
+	"WITH_RANK_INFERENCE":   func(s *Settings) any { return &s.WithRankInference },
+	"GENDER_DICT":           func(s *Settings) any { return &s.GenderDict },
+	"STREAM":                func(s *Settings) any { return &s.Stream },
+	"JOBS_NUM":              func(s *Settings) any { return &s.JobsNum },
+	"BATCH_SIZE":            func(s *Settings) any { return &s.BatchSize },
+	"PORT":                  func(s *Settings) any { return &s.Port },
+	"GRPC_PORT":             func(s *Settings) any { return &s.GRPCPort },
+	"WEB_MAX_NAMES":         func(s *Settings) any { return &s.WebMaxNames },
+	"WEB_MAX_NAME_LENGTH":   func(s *Settings) any { return &s.WebMaxNameLength },
+	"WEB_MAX_BODY_SIZE":     func(s *Settings) any { return &s.WebMaxBodySize },
+	"WEB_RATE_LIMIT":        func(s *Settings) any { return &s.WebRateLimit },
+	"WEB_RATE_BURST":        func(s *Settings) any { return &s.WebRateBurst },
+}
+
+// ConfigPath returns the default path of the config file:
+// 'gnparser/gnparser.yaml' in the user's config directory
+// ($XDG_CONFIG_HOME or ~/.config on Linux).
+func ConfigPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "gnparser", "gnparser.yaml"), nil
+}
+
+// NewSettingsFromYAML reads Settings from a YAML document. Unknown keys
+// are reported as errors.
+func NewSettingsFromYAML(r io.Reader) (Settings, error) {
+	var res Settings
+	dec := yaml.NewDecoder(r)
+	dec.KnownFields(true)
+	if err := dec.Decode(&res); err != nil && !errors.Is(err, io.EOF) {
+		return res, fmt.Errorf("cannot read settings: %w", err)
+	}
+	return res, nil
+}
+
+// NewSettingsFromFile reads Settings from a YAML config file.
+func NewSettingsFromFile(path string) (Settings, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return Settings{}, err
+	}
+	defer f.Close()
+
+	res, err := NewSettingsFromYAML(f)
+	if err != nil {
+		return res, fmt.Errorf("%s: %w", path, err)
+	}
+	return res, nil
+}
+
+// NewSettingsFromEnv reads Settings from environment variables in
+// 'KEY=value' form (as returned by os.Environ). Only variables that start
+// with EnvPrefix and match a setting are used, values that cannot be
+// converted are reported as errors.
+func NewSettingsFromEnv(environ []string) (Settings, error) {
+	var res Settings
+	var errs []error
+	for _, kv := range environ {
+		k, v, _ := strings.Cut(kv, "=")
+		name, ok := strings.CutPrefix(k, EnvPrefix)
+		if !ok {
+			continue
+		}
+		field, ok := settingsEnv[name]
+		if !ok {
+			continue
+		}
+		if err := setEnvValue(field(&res), strings.TrimSpace(v)); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", k, err))
+		}
+	}
+	return res, joinErrors(errs)
+}
+
+func setEnvValue(field any, v string) error {
+	switch f := field.(type) {
+	case **string:
+		*f = &v
+	case **bool:
+		b, err := parseBool(v)
+		if err != nil {
+			return err
+		}
+		*f = &b
+	case **int:
+		i, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("cannot convert '%s' to integer", v)
+		}
+		*f = &i
+	case **int64:
+		i, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return fmt.Errorf("cannot convert '%s' to integer", v)
+		}
+		*f = &i
+	case **float64:
+		fl, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return fmt.Errorf("cannot convert '%s' to number", v)
+		}
+		*f = &fl
+	}
+	return nil
+}
+
+// Options converts given settings to a slice of Option functions. Format,
+// Code and Language are validated the same way as RequestOptions,
+// QualityProfile is loaded by LoadQualityProfile, GenderDict is loaded by
+// LoadGenusGenders. Web* settings are limits of the web service, they are
+// not a part of Config and are converted by the io/web package.
+func (s Settings) Options() ([]Option, error) {
+	var res []Option
+	if s.Format != nil {
+		f, err := RequestOptions{Format: *s.Format}.format()
+		if err != nil {
+			return nil, err
+		}
+		if f != gnfmt.FormatNone {
+			res = append(res, OptFormat(f))
+		}
+	}
+	if s.Code != nil {
+		code, err := RequestOptions{Code: *s.Code}.code()
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, OptCode(code))
+	}
+	if s.QualityProfile != nil {
+		qp, err := LoadQualityProfile(*s.QualityProfile)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, OptQualityProfile(qp))
+	}
+	if s.Language != nil {
+		lang, err := parsed.NewLanguage(*s.Language)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, OptLanguage(lang))
+	}
+	if s.GenderDict != nil {
+		genders, err := LoadGenusGenders(*s.GenderDict)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, OptGenusGenders(genders))
+	}
+
+	bools := []struct {
+		v   *bool
+		opt func(bool) Option
+	}{
+		{s.WithDetails, OptWithDetails},
+		{s.Capitalize, OptWithCapitaliation},
+		{s.IgnoreHTMLTags, OptIgnoreHTMLTags},
+		{s.PreserveDiaereses, OptWithPreserveDiaereses},
+		{s.CompactAuthors, OptWithCompactAuthors},
+		{s.FlattenOutput, OptWithFlatOutput},
+		{s.SpeciesGroupCut, OptWithSpeciesGroupCut},
+		{s.Unordered, OptWithNoOrder},
+		{s.WithSuggestions, OptWithSuggestions},
+		{s.WithGenderAgreement, OptWithGenderAgreement},
+		{s.WithHierarchy, OptWithHierarchy},
+		{s.WithCodeInference, OptWithCodeInference},
+		{s.WithRankInference, OptWithRankInference},
+		{s.Stream, OptWithStream},
+	}
+	for _, v := range bools {
+		if v.v != nil {
+			res = append(res, v.opt(*v.v))
+		}
+	}
+
+	ints := []struct {
+		v   *int
+		opt func(int) Option
+	}{
+		{s.JobsNum, OptJobsNum},
+		{s.BatchSize, OptBatchSize},
+		{s.Port, OptPort},
+		{s.GRPCPort, OptGRPCPort},
+	}
+	for _, v := range ints {
+		if v.v != nil {
+			res = append(res, v.opt(*v.v))
+		}
+	}
+	return res, nil
+}
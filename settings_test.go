@@ -0,0 +1,159 @@
+package gnparser_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gnames/gnfmt"
+	"github.com/gnames/gnlib/ent/nomcode"
+	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/ent/gender"
+	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSettingsYAML(t *testing.T) {
+	assert := assert.New(t)
+	doc := `
+format: pretty
+code: bot
+withDetails: true
+jobsNum: 3
+webRateLimit: 2.5
+`
+	s, err := gnparser.NewSettingsFromYAML(strings.NewReader(doc))
+	require.Nil(t, err)
+	assert.Nil(s.Capitalize)
+	opts, err := s.Options()
+	require.Nil(t, err)
+	cfg := gnparser.NewConfig(opts...)
+	assert.Equal(gnfmt.PrettyJSON, cfg.Format)
+	assert.Equal(nomcode.Botanical, cfg.Code)
+	assert.True(cfg.WithDetails)
+	assert.Equal(3, cfg.JobsNum)
+	assert.Equal(2.5, *s.WebRateLimit)
+	assert.Equal(50_000, cfg.BatchSize)
+
+	_, err = gnparser.NewSettingsFromYAML(strings.NewReader("details: true"))
+	assert.NotNil(err)
+
+	s, err = gnparser.NewSettingsFromYAML(strings.NewReader(""))
+	require.Nil(t, err)
+	opts, err = s.Options()
+	require.Nil(t, err)
+	assert.Len(opts, 0)
+
+	s, err = gnparser.NewSettingsFromYAML(strings.NewReader("code: nocode"))
+	require.Nil(t, err)
+	_, err = s.Options()
+	assert.NotNil(err)
+}
+
+func TestSettingsEnv(t *testing.T) {
+	assert := assert.New(t)
+	env := []string{
+		"HOME=/home/user",
+		"GNPARSER_FORMAT=tsv",
+		"GNPARSER_WITH_DETAILS=yes",
+		"GNPARSER_IGNORE_HTML_TAGS=true",
+		"GNPARSER_BATCH_SIZE=10",
+		"GNPARSER_WEB_MAX_BODY_SIZE=1000",
+		"GNPARSER_CONFIG=/tmp/gnparser.yaml",
+		"GNPARSER_LANGUAGE=es",
+	}
+	s, err := gnparser.NewSettingsFromEnv(env)
+	require.Nil(t, err)
+	opts, err := s.Options()
+	require.Nil(t, err)
+	cfg := gnparser.NewConfig(opts...)
+	assert.Equal(gnfmt.TSV, cfg.Format)
+	assert.True(cfg.WithDetails)
+	assert.True(cfg.IgnoreHTMLTags)
+	assert.Equal(10, cfg.BatchSize)
+	assert.Equal(int64(1000), *s.WebMaxBodySize)
+	assert.Equal("es", cfg.Language)
+
+	_, err = gnparser.NewSettingsFromEnv([]string{"GNPARSER_JOBS_NUM=many"})
+	assert.NotNil(err)
+}
+
+func TestSettingsPrecedence(t *testing.T) {
+	file, err := gnparser.NewSettingsFromYAML(
+		strings.NewReader("withDetails: true\nformat: compact"),
+	)
+	require.Nil(t, err)
+	env, err := gnparser.NewSettingsFromEnv([]string{"GNPARSER_WITH_DETAILS=false"})
+	require.Nil(t, err)
+
+	fileOpts, _ := file.Options()
+	envOpts, _ := env.Options()
+	cfg := gnparser.NewConfig(append(fileOpts, envOpts...)...)
+	assert.False(t, cfg.WithDetails)
+	assert.Equal(t, gnfmt.CompactJSON, cfg.Format)
+}
+
+func TestSettingsQualityProfile(t *testing.T) {
+	assert := assert.New(t)
+	path := filepath.Join(t.TempDir(), "lenient.yaml")
+	doc := `
+base: zoological
+warnings:
+  Year with page info: 1
+`
+	err := os.WriteFile(path, []byte(doc), 0644)
+	require.Nil(t, err)
+
+	for _, v := range []string{path, "bot"} {
+		s, err := gnparser.NewSettingsFromYAML(
+			strings.NewReader("qualityProfile: " + v),
+		)
+		require.Nil(t, err)
+		opts, err := s.Options()
+		require.Nil(t, err)
+		cfg := gnparser.NewConfig(opts...)
+		assert.False(cfg.QualityProfile.IsDefault())
+	}
+
+	qp, err := gnparser.LoadQualityProfile(path)
+	require.Nil(t, err)
+	assert.Equal("lenient", qp.Name)
+	assert.Equal(1, qp.Quality(parsed.YearPageWarn))
+	assert.Equal(3, qp.Quality(parsed.AuthExWarn))
+
+	_, err = gnparser.LoadQualityProfile("nofile.yaml")
+	assert.NotNil(err)
+
+	qp, err = gnparser.NewQualityProfileFromYAML(
+		strings.NewReader("name: strict\nwarnings:\n  Year with page info: 4\n"),
+	)
+	require.Nil(t, err)
+	assert.Equal("strict", qp.Name)
+	assert.Equal(4, qp.Quality(parsed.YearPageWarn))
+
+	_, err = gnparser.NewQualityProfileFromYAML(strings.NewReader("level: 1"))
+	assert.NotNil(err)
+}
+
+func TestSettingsGenderDict(t *testing.T) {
+	assert := assert.New(t)
+	path := filepath.Join(t.TempDir(), "genders.txt")
+	err := os.WriteFile(path, []byte("Aus\tf\nQuercus\tm\n"), 0644)
+	require.Nil(t, err)
+
+	s, err := gnparser.NewSettingsFromYAML(
+		strings.NewReader("genderDict: " + path),
+	)
+	require.Nil(t, err)
+	opts, err := s.Options()
+	require.Nil(t, err)
+	cfg := gnparser.NewConfig(opts...)
+	assert.Equal(gender.Feminine, cfg.GenusGenders["Aus"])
+	assert.Equal(gender.Masculine, cfg.GenusGenders["Quercus"])
+	assert.Equal(gender.Neuter, cfg.GenusGenders["Acer"])
+
+	_, err = gnparser.LoadGenusGenders("nofile.txt")
+	assert.NotNil(err)
+}
@@ -0,0 +1,81 @@
+// JavaScript API for WebAssembly build of GNparser.
+//
+// Node.js:
+//
+//   const { load } = require("./gnparser.js");
+//   const gnp = await load();
+//   gnp.parseName("Aus bus L.", { withDetails: true });
+//
+// Browser (after loading wasm_exec.js and this file with script tags):
+//
+//   const gnp = await gnparser.load("gnparser.wasm");
+//
+// Options are the same as options of REST API POST requests. Results are
+// plain objects decoded from JSON output of GNparser.
+"use strict";
+
+(function (root) {
+  async function instantiate(source, imports) {
+    if (source === undefined && typeof require === "function") {
+      const fs = require("fs");
+      const path = require("path");
+      source = fs.readFileSync(path.join(__dirname, "gnparser.wasm"));
+    }
+    if (typeof source === "string" || source instanceof URL) {
+      const resp = fetch(source);
+      if (WebAssembly.instantiateStreaming) {
+        return WebAssembly.instantiateStreaming(resp, imports);
+      }
+      source = await (await resp).arrayBuffer();
+    }
+    return WebAssembly.instantiate(source, imports);
+  }
+
+  function unwrap(res) {
+    if (res instanceof Error) {
+      throw res;
+    }
+    return res;
+  }
+
+  // load instantiates WebAssembly module. The source is a path or URL of
+  // gnparser.wasm, or its bytes. In Node.js the default source is
+  // gnparser.wasm next to this file.
+  async function load(source) {
+    if (typeof root.Go === "undefined") {
+      if (typeof require === "function") {
+        require("./wasm_exec.js");
+      } else {
+        throw new Error("wasm_exec.js has to be loaded before gnparser.js");
+      }
+    }
+    const go = new root.Go();
+    const { instance } = await instantiate(source, go.importObject);
+    go.run(instance);
+    const wasm = root.gnparserWasm;
+
+    return {
+      // parseName parses one name-string.
+      parseName(name, options) {
+        return JSON.parse(unwrap(wasm.parseName(name, options)));
+      },
+
+      // parseNames parses an array of name-strings, results are in the
+      // same order as the input.
+      parseNames(names, options) {
+        return JSON.parse(unwrap(wasm.parseNames(names, options)));
+      },
+
+      // version returns the version of GNparser.
+      version() {
+        return wasm.version();
+      },
+    };
+  }
+
+  if (typeof module === "object" && module.exports) {
+    module.exports = { load };
+  } else {
+    root.gnparser = { load };
+  }
+})(globalThis);
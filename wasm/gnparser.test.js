@@ -0,0 +1,62 @@
+// Tests of WebAssembly build of GNparser. Run `just wasm-test`, or build
+// the module with `just wasm` and run `node --test wasm/`.
+"use strict";
+
+const { test, before } = require("node:test");
+const assert = require("node:assert/strict");
+const { load } = require("./gnparser.js");
+
+let gnp;
+
+before(async () => {
+  gnp = await load();
+});
+
+test("parses one name", () => {
+  const res = gnp.parseName("Pleurosigma vitrea var. kjellmanii H.Peragallo, 1891");
+  assert.equal(res.parsed, true);
+  assert.equal(res.cardinality, 3);
+  assert.equal(res.canonical.simple, "Pleurosigma vitrea kjellmanii");
+  assert.equal(res.details, undefined);
+});
+
+test("takes options as an object or a JSON string", () => {
+  let res = gnp.parseName("Aus bus L.", { withDetails: true, code: "bot" });
+  assert.equal(res.nomenclaturalCodeSetting, "ICN");
+  assert.ok(res.details);
+  assert.ok(res.words);
+
+  res = gnp.parseName("Aus bus L.", '{"flattenOutput": true}');
+  assert.equal(res.canonicalSimple, "Aus bus");
+
+  // options of previous calls are not kept
+  res = gnp.parseName("Aus bus L.");
+  assert.equal(res.details, undefined);
+  assert.equal(res.nomenclaturalCodeSetting, undefined);
+});
+
+test("parses many names in order", () => {
+  const names = ["Aus bus", "Cus dus L.", "<i>Eus fus</i>"];
+  const res = gnp.parseNames(names, { speciesGroupCut: true });
+  assert.equal(res.length, 3);
+  res.forEach((r, i) => assert.equal(r.verbatim, names[i]));
+  assert.equal(res[2].canonical.simple, "Eus fus");
+
+  const flat = gnp.parseNames(names, { flattenOutput: true });
+  assert.equal(flat[1].authorship, "L.");
+});
+
+test("reports errors", () => {
+  assert.throws(() => gnp.parseName("Aus bus", { code: "xyz" }),
+    /unknown nomenclatural code/);
+  assert.throws(() => gnp.parseName("Aus bus", { colour: "red" }),
+    /unknown field "colour"/);
+  assert.throws(() => gnp.parseName("Aus bus", { format: "csv" }),
+    /not supported/);
+  assert.throws(() => gnp.parseName(42), /name-string is required/);
+  assert.throws(() => gnp.parseNames(["Aus bus", 1]), /element 1/);
+});
+
+test("returns version", () => {
+  assert.match(gnp.version().version, /^v\d+\.\d+\.\d+/);
+});
@@ -0,0 +1,160 @@
+//go:build js && wasm
+
+// Package main provides WebAssembly build of GNparser for browsers and
+// Node.js. It registers a global 'gnparserWasm' object with functions that
+// take the same options as REST API, and return results as JSON strings.
+// Use gnparser.js to load the module.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"syscall/js"
+
+	"github.com/gnames/gnfmt"
+	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/ent/parsed"
+)
+
+// gnp keeps the parsing engine. WebAssembly runs in one thread, so the
+// same engine is reused by all calls.
+var gnp = gnparser.New(gnparser.NewConfig(gnparser.OptFormat(gnfmt.CompactJSON)))
+
+func main() {
+	api := map[string]any{
+		"parseName":  js.FuncOf(parseName),
+		"parseNames": js.FuncOf(parseNames),
+		"version":    js.FuncOf(version),
+	}
+	js.Global().Set("gnparserWasm", js.ValueOf(api))
+
+	// keeps the module alive, so functions can be called.
+	select {}
+}
+
+// parseName takes a name-string and optional options, and returns
+// a parsing result as a JSON string, or an Error object.
+func parseName(_ js.Value, args []js.Value) any {
+	if len(args) == 0 || args[0].Type() != js.TypeString {
+		return jsError("name-string is required")
+	}
+	p, err := parser(args[1:])
+	if err != nil {
+		return jsError(err.Error())
+	}
+	res := p.ParseName(args[0].String())
+	return strings.TrimSpace(res.Output(gnfmt.CompactJSON, p.WithFlatOutput()))
+}
+
+// parseNames takes an array of name-strings and optional options, and
+// returns parsing results as a JSON array, or an Error object.
+func parseNames(_ js.Value, args []js.Value) any {
+	if len(args) == 0 || !isArray(args[0]) {
+		return jsError("an array of name-strings is required")
+	}
+	l := args[0].Length()
+	names := make([]string, l)
+	for i := range l {
+		v := args[0].Index(i)
+		if v.Type() != js.TypeString {
+			return jsError(fmt.Sprintf("element %d is not a string", i))
+		}
+		names[i] = v.String()
+	}
+
+	p, err := parser(args[1:])
+	if err != nil {
+		return jsError(err.Error())
+	}
+	res, err := output(p.ParseNames(names), p.WithFlatOutput())
+	if err != nil {
+		return jsError(err.Error())
+	}
+	return res
+}
+
+// version returns the version of GNparser.
+func version(js.Value, []js.Value) any {
+	ver := gnp.GetVersion()
+	return js.ValueOf(map[string]any{
+		"version": ver.Version,
+		"build":   ver.Build,
+	})
+}
+
+// parser returns GNparser with settings from options. Options are either
+// a JavaScript object or a JSON string with the same fields as options of
+// REST API POST requests.
+func parser(args []js.Value) (gnparser.GNparser, error) {
+	if len(args) == 0 {
+		return gnp.ChangeConfig(defaultOptions()...), nil
+	}
+
+	var optsJSON string
+	switch opts := args[0]; opts.Type() {
+	case js.TypeUndefined, js.TypeNull:
+		return gnp.ChangeConfig(defaultOptions()...), nil
+	case js.TypeString:
+		optsJSON = opts.String()
+	case js.TypeObject:
+		optsJSON = js.Global().Get("JSON").Call("stringify", opts).String()
+	default:
+		return nil, fmt.Errorf("options must be an object or a JSON string")
+	}
+
+	var ro gnparser.RequestOptions
+	dec := json.NewDecoder(bytes.NewReader([]byte(optsJSON)))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&ro); err != nil {
+		return nil, fmt.Errorf("cannot decode options: %w", err)
+	}
+	switch ro.Format {
+	case "", "json", "compact", "pretty":
+	default:
+		return nil, fmt.Errorf("format '%s' is not supported, results are JSON", ro.Format)
+	}
+	if ro.CSV {
+		return nil, fmt.Errorf("csv is not supported, results are JSON")
+	}
+
+	opts, err := ro.Options()
+	if err != nil {
+		return nil, err
+	}
+	return gnp.ChangeConfig(opts...), nil
+}
+
+// defaultOptions resets parsing settings to their defaults.
+func defaultOptions() []gnparser.Option {
+	opts, _ := gnparser.RequestOptions{}.Options()
+	return opts
+}
+
+func output(res []parsed.Parsed, flat bool) (string, error) {
+	var out []byte
+	var err error
+	enc := gnfmt.GNjson{}
+	if flat {
+		fl := make([]parsed.ParsedFlat, len(res))
+		for i := range res {
+			fl[i] = res[i].Flatten()
+		}
+		out, err = enc.Encode(fl)
+	} else {
+		out, err = enc.Encode(res)
+	}
+	if err != nil {
+		return "", fmt.Errorf("cannot encode results: %w", err)
+	}
+	return string(out), nil
+}
+
+func isArray(v js.Value) bool {
+	return js.Global().Get("Array").Call("isArray", v).Bool()
+}
+
+func jsError(msg string) js.Value {
+	return js.Global().Get("Error").New(msg)
+}